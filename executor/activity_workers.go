@@ -0,0 +1,261 @@
+package executor
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/store"
+)
+
+// stalledActivitiesPageSize is the number of running workflows read at a time to find stalled activities.
+const stalledActivitiesPageSize = 100
+
+// activityRefreshInterval is how often the update loop refreshes the jobs of a running workflow
+// that uses activities. Each refresh reads the whole execution history, so it isn't done at every
+// update, but it's often enough that workers running tasks are seen well within the default
+// worker timeout of stalled activities.
+const activityRefreshInterval = 2 * time.Minute
+
+// activityRun is a task of an activity picked up by a worker. A worker starts each of its tasks
+// at a different time, so together they identify the task across refreshes of a workflow.
+type activityRun struct {
+	stateResourceName string
+	worker            string
+	startedAt         time.Time
+}
+
+type activityRunOutcome struct {
+	lastSeen  time.Time
+	running   bool
+	succeeded bool
+	failed    bool
+}
+
+// activityRuns collects the tasks that workers picked up for the jobs of a workflow, including
+// the tasks of failed attempts.
+func activityRuns(jobs []*models.Job) map[activityRun]activityRunOutcome {
+	runs := map[activityRun]activityRunOutcome{}
+	for _, job := range jobs {
+		if job.StateResource == nil || job.StateResource.Type != models.StateResourceTypeActivityARN {
+			continue
+		}
+		for _, attempt := range job.Attempts {
+			if attempt.WorkerName == "" {
+				continue
+			}
+			run := activityRun{job.StateResource.Name, attempt.WorkerName, time.Time(attempt.StartedAt)}
+			runs[run] = activityRunOutcome{
+				lastSeen: latestTime(attempt.StartedAt, attempt.StoppedAt),
+				failed:   true,
+			}
+		}
+		if job.Container == "" {
+			continue
+		}
+		run := activityRun{job.StateResource.Name, job.Container, time.Time(job.StartedAt)}
+		runs[run] = activityRunOutcome{
+			lastSeen:  latestTime(job.StartedAt, job.StoppedAt),
+			running:   job.Status == models.JobStatusRunning,
+			succeeded: job.Status == models.JobStatusSucceeded,
+			failed:    job.Status == models.JobStatusFailed,
+		}
+	}
+	return runs
+}
+
+// usesActivities returns whether any Task state of a workflow definition is run by activity
+// workers rather than a lambda function.
+func usesActivities(def *models.WorkflowDefinition) bool {
+	if def == nil || def.StateMachine == nil {
+		return false
+	}
+	for _, state := range def.StateMachine.States {
+		if state.Type == models.SLStateTypeTask && !strings.HasPrefix(state.Resource, "lambda:") {
+			return true
+		}
+	}
+	return false
+}
+
+// activityRefreshDue returns whether the jobs of a running workflow that uses activities are due
+// to be refreshed at an update at now, given when the workflow was last updated. They're refreshed
+// at its first update, and then whenever its age passes another activityRefreshInterval.
+func activityRefreshDue(workflow models.Workflow, lastUpdated, now time.Time) bool {
+	createdAt := time.Time(workflow.CreatedAt)
+	if lastUpdated.Sub(createdAt) < time.Second {
+		return true
+	}
+	return lastUpdated.Sub(createdAt)/activityRefreshInterval != now.Sub(createdAt)/activityRefreshInterval
+}
+
+func latestTime(a, b strfmt.DateTime) time.Time {
+	if time.Time(b).After(time.Time(a)) {
+		return time.Time(b)
+	}
+	return time.Time(a)
+}
+
+// activityWorkerUpdates returns the worker activity in the jobs of a workflow that isn't in its
+// previous jobs yet, so that refreshing the history of a workflow never counts a task twice.
+// Step Functions fails a task whose worker stops heartbeating, so a worker still running a task
+// is seen at the time of the refresh rather than only when it picked up the task.
+func activityWorkerUpdates(namespace string, previousJobs, jobs []*models.Job, now time.Time) []models.ActivityWorker {
+	previous := activityRuns(previousJobs)
+	updates := map[[2]string]*models.ActivityWorker{}
+	for run, outcome := range activityRuns(jobs) {
+		if outcome.running && now.After(outcome.lastSeen) {
+			outcome.lastSeen = now
+		}
+		before, ok := previous[run]
+		if ok && !outcome.running && !outcome.lastSeen.After(before.lastSeen) &&
+			outcome.succeeded == before.succeeded && outcome.failed == before.failed {
+			continue
+		}
+
+		key := [2]string{run.stateResourceName, run.worker}
+		update, ok := updates[key]
+		if !ok {
+			update = &models.ActivityWorker{
+				Namespace:         namespace,
+				StateResourceName: run.stateResourceName,
+				Name:              run.worker,
+			}
+			updates[key] = update
+		}
+		if outcome.lastSeen.After(time.Time(update.LastSeen)) {
+			update.LastSeen = strfmt.DateTime(outcome.lastSeen)
+		}
+		if outcome.succeeded && !before.succeeded {
+			update.Succeeded++
+		}
+		if outcome.failed && !before.failed {
+			update.Failed++
+		}
+	}
+
+	workers := []models.ActivityWorker{}
+	for _, update := range updates {
+		workers = append(workers, *update)
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		if workers[i].StateResourceName != workers[j].StateResourceName {
+			return workers[i].StateResourceName < workers[j].StateResourceName
+		}
+		return workers[i].Name < workers[j].Name
+	})
+	return workers
+}
+
+// recordActivityWorkers saves the worker activity found by refreshing the jobs of a workflow.
+// Worker liveness is informational, so failures are logged rather than failing the refresh.
+func recordActivityWorkers(ctx context.Context, thestore store.Store, workflow models.Workflow, jobs []*models.Job) {
+	for _, worker := range activityWorkerUpdates(workflow.Namespace, workflow.Jobs, jobs, time.Now()) {
+		if err := thestore.RecordActivityWorker(ctx, worker); err != nil {
+			log.ErrorD("record-activity-worker", logger.M{
+				"namespace":      worker.Namespace,
+				"state-resource": worker.StateResourceName,
+				"worker":         worker.Name,
+				"error":          err.Error(),
+			})
+		}
+	}
+}
+
+// StalledActivities returns the activities with jobs of running workflows queued on them in a
+// namespace, or in every namespace if it is empty, but no worker seen within workerTimeout. It only
+// reads the stored jobs, which the update loop keeps refreshing for workflows that use activities.
+func StalledActivities(
+	ctx context.Context, thestore store.Store, namespace string, workerTimeout time.Duration, now time.Time,
+) ([]models.StalledActivity, error) {
+	defs, err := thestore.GetWorkflowDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queued := map[[2]string]*models.StalledActivity{}
+	seen := map[string]bool{}
+	for _, def := range defs {
+		if seen[def.Name] {
+			continue
+		}
+		seen[def.Name] = true
+
+		query := &models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(def.Name),
+			Status:                 models.WorkflowStatusRunning,
+			Limit:                  stalledActivitiesPageSize,
+		}
+		for {
+			workflows, nextPageToken, err := thestore.GetWorkflows(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+			for _, workflow := range workflows {
+				if namespace != "" && workflow.Namespace != namespace {
+					continue
+				}
+				addQueuedJobs(queued, workflow)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			query.PageToken = nextPageToken
+		}
+	}
+
+	stalled := []models.StalledActivity{}
+	for _, activity := range queued {
+		workers, err := thestore.GetActivityWorkers(ctx, activity.Namespace, activity.StateResourceName)
+		if err != nil {
+			return nil, err
+		}
+		// workers are sorted from the most recently seen
+		if len(workers) > 0 {
+			activity.LastWorkerSeen = workers[0].LastSeen
+			if now.Sub(time.Time(activity.LastWorkerSeen)) < workerTimeout {
+				continue
+			}
+		}
+		stalled = append(stalled, *activity)
+	}
+	sort.Slice(stalled, func(i, j int) bool {
+		return time.Time(stalled[i].OldestQueuedAt).Before(time.Time(stalled[j].OldestQueuedAt))
+	})
+	return stalled, nil
+}
+
+// addQueuedJobs adds the jobs of a workflow that are queued on an activity to the StalledActivity
+// of their StateResource.
+func addQueuedJobs(queued map[[2]string]*models.StalledActivity, workflow models.Workflow) {
+	for _, job := range workflow.Jobs {
+		if job.Status != models.JobStatusQueued || job.StateResource == nil ||
+			job.StateResource.Type != models.StateResourceTypeActivityARN {
+			continue
+		}
+		key := [2]string{workflow.Namespace, job.StateResource.Name}
+		activity, ok := queued[key]
+		if !ok {
+			activity = &models.StalledActivity{
+				Namespace:         workflow.Namespace,
+				StateResourceName: job.StateResource.Name,
+				OldestQueuedAt:    job.CreatedAt,
+				WorkflowIDs:       []string{},
+			}
+			queued[key] = activity
+		}
+		activity.QueuedJobs++
+		if time.Time(job.CreatedAt).Before(time.Time(activity.OldestQueuedAt)) {
+			activity.OldestQueuedAt = job.CreatedAt
+		}
+		if n := len(activity.WorkflowIDs); n == 0 || activity.WorkflowIDs[n-1] != workflow.ID {
+			activity.WorkflowIDs = append(activity.WorkflowIDs, workflow.ID)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package executor
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store/memory"
+)
+
+func TestActivityWorkerUpdates(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	at := func(minutes int) strfmt.DateTime {
+		return strfmt.DateTime(start.Add(time.Duration(minutes) * time.Minute))
+	}
+	echo := &models.StateResource{Name: "echo", Type: models.StateResourceTypeActivityARN}
+	resize := &models.StateResource{Name: "resize", Type: models.StateResourceTypeLambdaFunctionARN}
+
+	running := []*models.Job{
+		{StateResource: echo, Status: models.JobStatusRunning, Container: "worker-1", StartedAt: at(1)},
+		{StateResource: resize, Status: models.JobStatusRunning, StartedAt: at(1)},
+	}
+	t.Log("A worker running a task is seen at every refresh")
+	assert.Equal(t, []models.ActivityWorker{
+		{Namespace: "staging", StateResourceName: "echo", Name: "worker-1", LastSeen: at(2)},
+	}, activityWorkerUpdates("staging", nil, running, time.Time(at(2))))
+	assert.Equal(t, []models.ActivityWorker{
+		{Namespace: "staging", StateResourceName: "echo", Name: "worker-1", LastSeen: at(3)},
+	}, activityWorkerUpdates("staging", running, running, time.Time(at(3))))
+
+	t.Log("Finished tasks are counted once for the worker that ran them")
+	retried := []*models.Job{{
+		StateResource: echo,
+		Status:        models.JobStatusSucceeded,
+		Container:     "worker-2",
+		StartedAt:     at(5),
+		StoppedAt:     at(6),
+		Attempts:      []*models.JobAttempt{{WorkerName: "worker-1", StartedAt: at(1), StoppedAt: at(2)}},
+	}}
+	assert.Equal(t, []models.ActivityWorker{
+		{Namespace: "staging", StateResourceName: "echo", Name: "worker-1", LastSeen: at(2), Failed: 1},
+		{Namespace: "staging", StateResourceName: "echo", Name: "worker-2", LastSeen: at(6), Succeeded: 1},
+	}, activityWorkerUpdates("staging", running, retried, time.Time(at(7))))
+	assert.Empty(t, activityWorkerUpdates("staging", retried, retried, time.Time(at(8))))
+}
+
+func TestActivityRefreshDue(t *testing.T) {
+	createdAt := time.Now().Add(-time.Hour)
+	workflow := models.Workflow{WorkflowSummary: models.WorkflowSummary{CreatedAt: strfmt.DateTime(createdAt)}}
+	at := func(seconds int) time.Time {
+		return createdAt.Add(time.Duration(seconds) * time.Second)
+	}
+
+	t.Log("Jobs are refreshed at the first update")
+	assert.True(t, activityRefreshDue(workflow, createdAt, at(30)))
+
+	t.Log("And then once per interval rather than at every update")
+	assert.False(t, activityRefreshDue(workflow, at(30), at(60)))
+	assert.False(t, activityRefreshDue(workflow, at(60), at(90)))
+	assert.True(t, activityRefreshDue(workflow, at(90), at(120)))
+	assert.False(t, activityRefreshDue(workflow, at(120), at(150)))
+}
+
+func TestStalledActivities(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	def := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *def))
+
+	now := time.Now()
+	queuedJob := func(resource string, queuedFor time.Duration) *models.Job {
+		return &models.Job{
+			Status:        models.JobStatusQueued,
+			CreatedAt:     strfmt.DateTime(now.Add(-queuedFor)),
+			StateResource: &models.StateResource{Name: resource, Type: models.StateResourceTypeActivityARN},
+		}
+	}
+	for _, workflow := range []models.Workflow{
+		{
+			WorkflowSummary: models.WorkflowSummary{ID: "wf-1", Namespace: "staging"},
+			Jobs:            []*models.Job{queuedJob("echo", time.Hour)},
+		},
+		{
+			WorkflowSummary: models.WorkflowSummary{ID: "wf-2", Namespace: "staging"},
+			Jobs:            []*models.Job{queuedJob("echo", time.Minute), queuedJob("resize", time.Hour)},
+		},
+		{
+			WorkflowSummary: models.WorkflowSummary{ID: "wf-3", Namespace: "production"},
+			Jobs:            []*models.Job{queuedJob("echo", time.Hour)},
+		},
+	} {
+		workflow.WorkflowDefinition = def
+		workflow.Status = models.WorkflowStatusRunning
+		require.NoError(t, store.SaveWorkflow(ctx, workflow))
+	}
+	require.NoError(t, store.RecordActivityWorker(ctx, models.ActivityWorker{
+		Namespace: "staging", StateResourceName: "echo", Name: "worker-1", LastSeen: strfmt.DateTime(now.Add(-time.Hour)),
+	}))
+	require.NoError(t, store.RecordActivityWorker(ctx, models.ActivityWorker{
+		Namespace: "staging", StateResourceName: "resize", Name: "worker-2", LastSeen: strfmt.DateTime(now.Add(-time.Minute)),
+	}))
+
+	stalled, err := StalledActivities(ctx, store, "staging", 10*time.Minute, now)
+	require.NoError(t, err)
+	require.Len(t, stalled, 1)
+	assert.Equal(t, "echo", stalled[0].StateResourceName)
+	assert.Equal(t, int64(2), stalled[0].QueuedJobs)
+	assert.WithinDuration(t, now.Add(-time.Hour), time.Time(stalled[0].OldestQueuedAt), time.Second)
+	assert.WithinDuration(t, now.Add(-time.Hour), time.Time(stalled[0].LastWorkerSeen), time.Second)
+	sort.Strings(stalled[0].WorkflowIDs)
+	assert.Equal(t, []string{"wf-1", "wf-2"}, stalled[0].WorkflowIDs)
+
+	t.Log("Activities that never had a worker are stalled too")
+	stalled, err = StalledActivities(ctx, store, "production", 10*time.Minute, now)
+	require.NoError(t, err)
+	require.Len(t, stalled, 1)
+	assert.Equal(t, "production", stalled[0].Namespace)
+	assert.True(t, time.Time(stalled[0].LastWorkerSeen).IsZero())
+}
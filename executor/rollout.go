@@ -0,0 +1,110 @@
+package executor
+
+import (
+	"context"
+	"time"
+
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store"
+)
+
+// PollForRollouts judges the running rollouts of every alias at each interval.
+// It will stop polling when the context is done.
+func PollForRollouts(ctx context.Context, thestore store.Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("poll-for-rollouts-done")
+			return
+		case <-ticker.C:
+			if err := UpdateRollouts(ctx, thestore, time.Now()); err != nil {
+				log.ErrorD("update-rollouts", logger.M{"error": err.Error()})
+			}
+		}
+	}
+}
+
+// UpdateRollouts advances or rolls back every running rollout based on the workflows that the
+// old and new versions finished since the rollout started.
+func UpdateRollouts(ctx context.Context, thestore store.Store, now time.Time) error {
+	defs, err := thestore.GetWorkflowDefinitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, def := range defs {
+		if seen[def.Name] {
+			continue
+		}
+		seen[def.Name] = true
+
+		aliases, err := thestore.GetWorkflowDefinitionAliases(ctx, def.Name)
+		if err != nil {
+			return err
+		}
+		for _, alias := range aliases {
+			if alias.Rollout == nil || alias.Rollout.Status != models.RolloutStatusRunning {
+				continue
+			}
+			if err := updateRollout(ctx, thestore, alias, now); err != nil {
+				log.ErrorD("update-rollout", logger.M{"name": alias.Name, "alias": alias.Alias, "error": err.Error()})
+			}
+		}
+	}
+	return nil
+}
+
+func updateRollout(ctx context.Context, thestore store.Store, alias models.WorkflowDefinitionAlias, now time.Time) error {
+	from, to, err := rolloutStats(ctx, thestore, alias.Name, alias.Alias, *alias.Rollout, now)
+	if err != nil {
+		return err
+	}
+	if !resources.EvaluateRollout(&alias, from, to, now) {
+		return nil
+	}
+
+	decision := alias.Rollout.Decisions[len(alias.Rollout.Decisions)-1]
+	fields := logger.M{
+		"name":          alias.Name,
+		"alias":         alias.Alias,
+		"action":        string(decision.Action),
+		"canary-weight": decision.CanaryWeight,
+		"reason":        decision.Reason,
+	}
+	// the alias may have been changed since it was read, e.g. by cancelling the rollout, in which
+	// case the decision is dropped and the rollout is judged again at the next poll
+	if err := thestore.UpdateWorkflowDefinitionAlias(ctx, alias); err != nil {
+		if _, ok := err.(models.Conflict); ok {
+			log.InfoD("rollout-decision-dropped", fields)
+			return nil
+		}
+		return err
+	}
+	log.InfoD("rollout-decision", fields)
+	return nil
+}
+
+// rolloutStats counts the workflows started through an alias with the old and new versions of
+// its rollout that succeeded or failed since it started. Workflows started by version, or through
+// other aliases, aren't counted. The counts are kept by WorkflowStatsPeriod, so they include the
+// workflows created earlier in the period that the rollout started in.
+func rolloutStats(ctx context.Context, thestore store.Store, name, alias string, rollout models.Rollout, now time.Time) (models.VersionStats, models.VersionStats, error) {
+	periods, err := thestore.GetWorkflowStatsCounts(ctx, name, time.Time(rollout.StartedAt), now)
+	if err != nil {
+		return models.VersionStats{}, models.VersionStats{}, err
+	}
+	total := resources.NewWorkflowStatsCounts(name, time.Time(rollout.StartedAt))
+	for _, period := range periods {
+		total.Add(period)
+	}
+
+	return resources.NewVersionStatsFromCounts(alias, rollout.FromVersion, total),
+		resources.NewVersionStatsFromCounts(alias, rollout.ToVersion, total),
+		nil
+}
@@ -0,0 +1,67 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store/memory"
+)
+
+func TestUpdateRollouts(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	def := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *def))
+	newDef, err := store.UpdateWorkflowDefinition(ctx, *def)
+	require.NoError(t, err)
+
+	start := time.Now().Add(-time.Hour)
+	alias := models.WorkflowDefinitionAlias{Name: def.Name, Alias: "stable", Version: def.Version}
+	require.NoError(t, resources.StartRollout(&alias, models.RolloutRequest{
+		ToVersion:    swag.Int64(newDef.Version),
+		MinWorkflows: 4,
+	}, start))
+	require.NoError(t, store.SaveWorkflowDefinitionAlias(ctx, alias))
+
+	saveWorkflows := func(wfd models.WorkflowDefinition, alias string, status models.WorkflowStatus, count int) {
+		for i := 0; i < count; i++ {
+			workflow := resources.NewWorkflow(&wfd, "{}", "namespace", "queue", map[string]interface{}{})
+			workflow.WorkflowDefinitionAlias = alias
+			workflow.Status = status
+			require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+		}
+	}
+	saveWorkflows(*def, "stable", models.WorkflowStatusSucceeded, 10)
+	saveWorkflows(newDef, "stable", models.WorkflowStatusSucceeded, 4)
+	saveWorkflows(newDef, "stable", models.WorkflowStatusRunning, 5)
+	// workflows started by version or through other aliases aren't part of the rollout
+	saveWorkflows(newDef, "", models.WorkflowStatusFailed, 3)
+	saveWorkflows(newDef, "testing", models.WorkflowStatusFailed, 3)
+
+	t.Log("Healthy rollouts move to the next step")
+	require.NoError(t, UpdateRollouts(ctx, store, time.Now()))
+	alias, err = store.GetWorkflowDefinitionAlias(ctx, def.Name, "stable")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), alias.Rollout.CurrentStep)
+	assert.Equal(t, int64(25), *alias.CanaryWeight)
+	advance := alias.Rollout.Decisions[1]
+	assert.Equal(t, models.RolloutActionAdvance, advance.Action)
+	assert.Equal(t, int64(10), advance.FromStats.Succeeded)
+	assert.Equal(t, int64(4), advance.ToStats.Succeeded)
+
+	t.Log("Failing rollouts are rolled back without waiting for the step interval")
+	saveWorkflows(newDef, "stable", models.WorkflowStatusFailed, 2)
+	require.NoError(t, UpdateRollouts(ctx, store, time.Now()))
+	alias, err = store.GetWorkflowDefinitionAlias(ctx, def.Name, "stable")
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusRolledBack, alias.Rollout.Status)
+	assert.Equal(t, def.Version, alias.Version)
+	assert.Nil(t, alias.CanaryVersion)
+}
@@ -1,6 +1,7 @@
 package sfncache
 
 import (
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	lru "github.com/hashicorp/golang-lru"
@@ -37,3 +38,24 @@ func (s *SFNCache) DescribeStateMachine(i *sfn.DescribeStateMachineInput) (*sfn.
 	s.describeStateMachineCache.Add(cacheKey, out)
 	return out, nil
 }
+
+// DeleteStateMachine evicts the state machine from the cache, so that it is created again if a
+// workflow needs it later.
+func (s *SFNCache) DeleteStateMachine(i *sfn.DeleteStateMachineInput) (*sfn.DeleteStateMachineOutput, error) {
+	s.evictStateMachine(i.StateMachineArn)
+	return s.SFNAPI.DeleteStateMachine(i)
+}
+
+// StartExecution evicts state machines that no longer exist from the cache, since they can be
+// deleted by other processes than the one that cached them.
+func (s *SFNCache) StartExecution(i *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error) {
+	out, err := s.SFNAPI.StartExecution(i)
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == sfn.ErrCodeStateMachineDoesNotExist {
+		s.evictStateMachine(i.StateMachineArn)
+	}
+	return out, err
+}
+
+func (s *SFNCache) evictStateMachine(stateMachineArn *string) {
+	s.describeStateMachineCache.Remove((&sfn.DescribeStateMachineInput{StateMachineArn: stateMachineArn}).String())
+}
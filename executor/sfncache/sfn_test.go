@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/Clever/workflow-manager/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -26,3 +28,49 @@ func TestDescribeStateMachineCache(t *testing.T) {
 		require.Equal(t, expectedOutput, output)
 	}
 }
+
+func TestDeleteStateMachineEvictsCache(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	arn := aws.String("arn:aws:states:us-west-2:111111111111:stateMachine:state-machine")
+	mockSFNAPI.EXPECT().
+		DescribeStateMachine(gomock.Any()).
+		Return(&sfn.DescribeStateMachineOutput{}, nil).
+		Times(2)
+	mockSFNAPI.EXPECT().
+		DeleteStateMachine(&sfn.DeleteStateMachineInput{StateMachineArn: arn}).
+		Return(&sfn.DeleteStateMachineOutput{}, nil)
+	cachedSFN, err := New(mockSFNAPI)
+	require.Nil(t, err)
+
+	_, err = cachedSFN.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: arn})
+	require.Nil(t, err)
+	_, err = cachedSFN.DeleteStateMachine(&sfn.DeleteStateMachineInput{StateMachineArn: arn})
+	require.Nil(t, err)
+	_, err = cachedSFN.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: arn})
+	require.Nil(t, err)
+}
+
+func TestStartExecutionEvictsDeletedStateMachines(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	arn := aws.String("arn:aws:states:us-west-2:111111111111:stateMachine:state-machine")
+	mockSFNAPI.EXPECT().
+		DescribeStateMachine(gomock.Any()).
+		Return(&sfn.DescribeStateMachineOutput{}, nil).
+		Times(2)
+	mockSFNAPI.EXPECT().
+		StartExecution(&sfn.StartExecutionInput{StateMachineArn: arn}).
+		Return(nil, awserr.New(sfn.ErrCodeStateMachineDoesNotExist, "deleted", nil))
+	cachedSFN, err := New(mockSFNAPI)
+	require.Nil(t, err)
+
+	_, err = cachedSFN.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: arn})
+	require.Nil(t, err)
+	_, err = cachedSFN.StartExecution(&sfn.StartExecutionInput{StateMachineArn: arn})
+	require.Error(t, err)
+	_, err = cachedSFN.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: arn})
+	require.Nil(t, err)
+}
@@ -18,11 +18,15 @@ import (
 
 // WorkflowManager is the interface for creating, stopping and checking status for Workflows
 type WorkflowManager interface {
-	CreateWorkflow(ctx context.Context, def models.WorkflowDefinition, input string, namespace string, queue string, tags map[string]interface{}) (*models.Workflow, error)
+	CreateWorkflow(ctx context.Context, def models.WorkflowDefinition, alias string, input string, namespace string, queue string, tags map[string]interface{}) (*models.Workflow, error)
 	RetryWorkflow(ctx context.Context, workflow models.Workflow, startAt, input string) (*models.Workflow, error)
 	CancelWorkflow(ctx context.Context, workflow *models.Workflow, reason string) error
 	UpdateWorkflowSummary(ctx context.Context, workflow *models.Workflow) error
 	UpdateWorkflowHistory(ctx context.Context, workflow *models.Workflow) error
+	ExportStateMachine(ctx context.Context, def models.WorkflowDefinition, namespace string) (*models.StateMachineExport, error)
+	DeleteStateMachines(ctx context.Context, def models.WorkflowDefinition) error
+	CreateActivity(ctx context.Context, namespace, name string) (string, error)
+	DeleteActivity(ctx context.Context, namespace, arn string) error
 }
 
 var backoffDuration = time.Second * 5
@@ -75,6 +79,10 @@ func PollForPendingWorkflowsAndUpdateStore(ctx context.Context, wm WorkflowManag
 // workflow's state. State is sync'd from workflow manager's backend, Step Functions.
 const updateLoopDelay = 30
 
+// durationToRetryStoppedWorkflowHistory is how long after a workflow stopped the update loop keeps
+// trying to read its final jobs. After that, its jobs aren't counted in the stats of its states.
+var durationToRetryStoppedWorkflowHistory = 30 * time.Minute
+
 func createPendingWorkflow(ctx context.Context, workflowID string, sqsapi sqsiface.SQSAPI, sqsQueueURL string) error {
 	_, err := sqsapi.SendMessageWithContext(ctx, &sqs.SendMessageInput{
 		MessageBody:  aws.String(workflowID),
@@ -92,14 +100,41 @@ func updatePendingWorkflow(ctx context.Context, m *sqs.Message, wm WorkflowManag
 	}
 
 	logPendingWorkflowUpdateLag(wf)
+	lastUpdated := time.Time(wf.LastUpdated)
 
 	err = wm.UpdateWorkflowSummary(ctx, &wf)
 	if err != nil {
 		return "", err
 	}
 
-	// If workflow is not yet complete, send message to SQS to request a future update.
-	if !resources.WorkflowIsDone(&wf) {
+	// Refreshing the jobs of running workflows records the activity workers seen running them, and
+	// the jobs of stopped workflows are refreshed once more to count them in the stats of their states.
+	stopped := wf.Status == models.WorkflowStatusSucceeded || wf.Status == models.WorkflowStatusFailed ||
+		wf.Status == models.WorkflowStatusCancelled
+	refreshActivities := wf.Status == models.WorkflowStatusRunning && usesActivities(wf.WorkflowDefinition) &&
+		activityRefreshDue(wf, lastUpdated, time.Now())
+	var historyErr error
+	if stopped || refreshActivities {
+		if historyErr = wm.UpdateWorkflowHistory(ctx, &wf); historyErr != nil {
+			log.ErrorD("update-pending-workflow-history", logger.M{"id": wfID, "error": historyErr.Error()})
+		}
+	}
+
+	// If workflow is not yet complete, or its final jobs couldn't be read, send message to SQS to
+	// request a future update. Otherwise this is its last update, so its final jobs are counted.
+	stoppedAt := time.Time(wf.StoppedAt)
+	if stoppedAt.IsZero() {
+		stoppedAt = lastUpdated
+	}
+	if historyErr == nil && resources.WorkflowIsDone(&wf) {
+		recordStateStats(ctx, thestore, wf)
+	} else if historyErr != nil && stopped && time.Since(stoppedAt) > durationToRetryStoppedWorkflowHistory {
+		log.ErrorD("update-pending-workflow-history-abandoned", logger.M{
+			"id":         wfID,
+			"stopped-at": stoppedAt.String(),
+			"error":      historyErr.Error(),
+		})
+	} else {
 		_, err = sqsapi.SendMessageWithContext(ctx, &sqs.SendMessageInput{
 			MessageBody:  aws.String(wfID),
 			QueueUrl:     aws.String(sqsQueueURL),
@@ -118,3 +153,23 @@ func updatePendingWorkflow(ctx context.Context, m *sqs.Message, wm WorkflowManag
 
 	return wfID, nil
 }
+
+// recordStateStats adds the jobs of a stopped workflow to the stats of the states of its
+// definition. The stats are informational, so failures are logged rather than failing the update.
+// The workflow is marked first, so that its jobs aren't counted again if its last update is
+// delivered twice, at the cost of not counting them if adding them fails.
+func recordStateStats(ctx context.Context, thestore store.Store, workflow models.Workflow) {
+	change, ok := resources.StateStatsChange(workflow)
+	if !ok {
+		return
+	}
+	if err := thestore.MarkWorkflowStateStatsRecorded(ctx, workflow.ID); err != nil {
+		if _, ok := err.(models.Conflict); !ok {
+			log.ErrorD("record-state-stats", logger.M{"id": workflow.ID, "error": err.Error()})
+		}
+		return
+	}
+	if err := thestore.AddWorkflowStatsCounts(ctx, change); err != nil {
+		log.ErrorD("record-state-stats", logger.M{"id": workflow.ID, "error": err.Error()})
+	}
+}
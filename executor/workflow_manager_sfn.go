@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -36,27 +38,156 @@ var defaultSFNCLICommandTerminatedRetrier = &models.SLRetrier{
 	MaxAttempts:     swag.Int64(10),
 }
 
+// SFNAPIFactory creates a Step Functions client for a region. If assumeRoleARN is non-empty
+// the client calls Step Functions with the credentials of that role.
+type SFNAPIFactory func(region, assumeRoleARN string) (sfniface.SFNAPI, error)
+
 // SFNWorkflowManager manages workflows run through AWS Step Functions.
+// The region, accountID and roleARN are the defaults for namespaces without a NamespaceConfig.
 type SFNWorkflowManager struct {
 	sfnapi      sfniface.SFNAPI
+	newSFNAPI   SFNAPIFactory
 	sqsapi      sqsiface.SQSAPI
 	store       store.Store
 	region      string
 	roleARN     string
 	accountID   string
 	sqsQueueURL string
+
+	// sfnapis holds one client per region + assumed role created by newSFNAPI
+	sfnapis     map[string]sfniface.SFNAPI
+	sfnapisLock sync.Mutex
 }
 
-func NewSFNWorkflowManager(sfnapi sfniface.SFNAPI, sqsapi sqsiface.SQSAPI, store store.Store, roleARN, region, accountID, sqsQueueURL string) *SFNWorkflowManager {
+// NewSFNWorkflowManager creates a SFNWorkflowManager. newSFNAPI is used to create clients for
+// namespaces configured to run outside of the default region or account, and may be nil if
+// there are no such namespaces.
+func NewSFNWorkflowManager(sfnapi sfniface.SFNAPI, newSFNAPI SFNAPIFactory, sqsapi sqsiface.SQSAPI, store store.Store, roleARN, region, accountID, sqsQueueURL string) *SFNWorkflowManager {
 	return &SFNWorkflowManager{
 		sfnapi:      sfnapi,
+		newSFNAPI:   newSFNAPI,
 		sqsapi:      sqsapi,
 		store:       store,
 		roleARN:     roleARN,
 		region:      region,
 		accountID:   accountID,
 		sqsQueueURL: sqsQueueURL,
+		sfnapis:     map[string]sfniface.SFNAPI{},
+	}
+}
+
+// sfnTarget is where the workflows of a namespace run: the client to use, the region,
+// account and execution role of its state machines, and the role assumed to reach them.
+type sfnTarget struct {
+	sfnapi        sfniface.SFNAPI
+	region        string
+	accountID     string
+	roleARN       string
+	assumeRoleARN string
+}
+
+// namespaceConfig looks up the NamespaceConfig of a namespace. Namespaces without a
+// config get an empty one.
+func (wm *SFNWorkflowManager) namespaceConfig(ctx context.Context, namespace string) (models.NamespaceConfig, error) {
+	config, err := wm.store.GetNamespaceConfig(ctx, namespace)
+	if err != nil {
+		if _, ok := err.(models.NotFound); ok {
+			return models.NamespaceConfig{Namespace: namespace}, nil
+		}
+		return models.NamespaceConfig{}, err
+	}
+	return config, nil
+}
+
+// targetForNamespace looks up the NamespaceConfig of a namespace, falling back to the
+// manager's defaults for any field that isn't set.
+func (wm *SFNWorkflowManager) targetForNamespace(ctx context.Context, namespace string) (sfnTarget, error) {
+	config, err := wm.namespaceConfig(ctx, namespace)
+	if err != nil {
+		return sfnTarget{}, err
+	}
+	return wm.targetForConfig(config)
+}
+
+// targetForConfig resolves the client and settings for a NamespaceConfig.
+func (wm *SFNWorkflowManager) targetForConfig(config models.NamespaceConfig) (sfnTarget, error) {
+	target := sfnTarget{
+		sfnapi:    wm.sfnapi,
+		region:    wm.region,
+		accountID: wm.accountID,
+		roleARN:   wm.roleARN,
+	}
+
+	if config.Region != "" {
+		target.region = config.Region
+	}
+	if config.AccountID != "" {
+		target.accountID = config.AccountID
+	}
+	if config.RoleARN != "" {
+		target.roleARN = config.RoleARN
+	}
+	target.assumeRoleARN = config.AssumeRoleARN
+	return wm.withClient(target)
+}
+
+// targetForWorkflow resolves where the execution of a workflow runs from the region and
+// account recorded when it started, so that later changes to its namespace's config don't
+// lose track of it. Workflows started before these were recorded use their namespace's
+// current config.
+func (wm *SFNWorkflowManager) targetForWorkflow(ctx context.Context, workflow *models.Workflow) (sfnTarget, error) {
+	if workflow.Region == "" || workflow.AccountID == "" {
+		return wm.targetForNamespace(ctx, workflow.Namespace)
+	}
+	return wm.withClient(sfnTarget{
+		region:        workflow.Region,
+		accountID:     workflow.AccountID,
+		roleARN:       wm.roleARN,
+		assumeRoleARN: workflow.AssumeRoleARN,
+	})
+}
+
+// withClient sets the client that reaches the region and assumed role of a target.
+func (wm *SFNWorkflowManager) withClient(target sfnTarget) (sfnTarget, error) {
+	if target.region == wm.region && target.assumeRoleARN == "" {
+		target.sfnapi = wm.sfnapi
+		return target, nil
+	}
+
+	sfnapi, err := wm.sfnapiFor(target.region, target.assumeRoleARN)
+	if err != nil {
+		return sfnTarget{}, err
+	}
+	target.sfnapi = sfnapi
+	return target, nil
+}
+
+// recordTarget stores where the execution of a workflow runs on the workflow.
+func (target sfnTarget) recordTarget(workflow *models.Workflow) {
+	workflow.Region = target.region
+	workflow.AccountID = target.accountID
+	workflow.AssumeRoleARN = target.assumeRoleARN
+}
+
+// sfnapiFor returns the client for a region and assumed role, creating it on first use.
+func (wm *SFNWorkflowManager) sfnapiFor(region, assumeRoleARN string) (sfniface.SFNAPI, error) {
+	wm.sfnapisLock.Lock()
+	defer wm.sfnapisLock.Unlock()
+
+	key := fmt.Sprintf("%s--%s", region, assumeRoleARN)
+	if sfnapi, ok := wm.sfnapis[key]; ok {
+		return sfnapi, nil
+	}
+	if wm.newSFNAPI == nil {
+		return nil, fmt.Errorf("no Step Functions client available for region %s, role %s", region, assumeRoleARN)
+	}
+
+	sfnapi, err := wm.newSFNAPI(region, assumeRoleARN)
+	if err != nil {
+		return nil, err
 	}
+	wm.sfnapis[key] = sfnapi
+	return sfnapi, nil
 }
 
 func wdResourceToSLResource(wdResource, region, accountID, namespace string) string {
@@ -117,6 +248,86 @@ func stateMachineWithDefaultRetriers(oldSM models.SLStateMachine) *models.SLStat
 	return &sm
 }
 
+// StateMachineFromAWS converts a state machine as submitted to Step Functions back to the
+// shorthand used by workflow definitions. It reverses stateMachineWithFullActivityARNs and drops
+// the retriers added by stateMachineWithDefaultRetriers. Resources that aren't ARNs are kept
+// as-is, and every ARN must belong to the same namespace.
+func StateMachineFromAWS(awsSM models.SLStateMachine) (*models.SLStateMachine, error) {
+	sm := deepcopy.Copy(awsSM).(models.SLStateMachine)
+	namespace := ""
+	for stateName, s := range sm.States {
+		state := deepcopy.Copy(s).(models.SLState)
+
+		retriers := []*models.SLRetrier{}
+		for _, retry := range state.Retry {
+			if !reflect.DeepEqual(retry, defaultSFNCLICommandTerminatedRetrier) {
+				retriers = append(retriers, retry)
+			}
+		}
+		state.Retry = nil
+		if len(retriers) > 0 {
+			state.Retry = retriers
+		}
+
+		if state.Type == models.SLStateTypeTask && strings.HasPrefix(state.Resource, "arn:") {
+			resource, resourceNamespace, err := slResourceToWDResource(state.Resource)
+			if err != nil {
+				return nil, models.BadRequest{Message: fmt.Sprintf("state %s: %s", stateName, err)}
+			}
+			if namespace != "" && resourceNamespace != namespace {
+				return nil, models.BadRequest{
+					Message: fmt.Sprintf("resources belong to more than one namespace: %s and %s", namespace, resourceNamespace),
+				}
+			}
+			namespace = resourceNamespace
+			state.Resource = resource
+		}
+		sm.States[stateName] = state
+	}
+	return &sm, nil
+}
+
+// slResourceToWDResource reverses wdResourceToSLResource and wdResourceToSLResourceLambda,
+// returning the shorthand for an activity or lambda function ARN and its namespace.
+func slResourceToWDResource(slResource string) (string, string, error) {
+	// arn:partition:service:region:account-id:resource-type:resource
+	parts := strings.SplitN(slResource, ":", 7)
+	if len(parts) != 7 {
+		return "", "", fmt.Errorf("invalid ARN %s", slResource)
+	}
+	service, resourceType, name := parts[2], parts[5], parts[6]
+
+	nameParts := strings.SplitN(name, "--", 2)
+	if len(nameParts) != 2 || nameParts[0] == "" || nameParts[1] == "" {
+		return "", "", fmt.Errorf("%s is not named <namespace>--<resource>", slResource)
+	}
+	namespace, resource := nameParts[0], nameParts[1]
+
+	switch {
+	case service == "states" && resourceType == "activity":
+		return resource, namespace, nil
+	case service == "lambda" && resourceType == "function":
+		if strings.Contains(resource, ":") {
+			return "", "", fmt.Errorf("lambda versions and aliases are not supported: %s", slResource)
+		}
+		return "lambda:" + resource, namespace, nil
+	default:
+		return "", "", fmt.Errorf("only activity and lambda function ARNs are supported: %s", slResource)
+	}
+}
+
+// awsStateMachineDefinition returns the States Language JSON submitted to Step Functions for a
+// workflow definition in a namespace.
+func awsStateMachineDefinition(target sfnTarget, wd models.WorkflowDefinition, namespace string) (string, error) {
+	awsStateMachine := stateMachineWithFullActivityARNs(*wd.StateMachine, target.region, target.accountID, namespace)
+	awsStateMachine = stateMachineWithDefaultRetriers(*awsStateMachine)
+	awsStateMachineDefBytes, err := json.MarshalIndent(awsStateMachine, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(awsStateMachineDefBytes), nil
+}
+
 // https://docs.aws.amazon.com/step-functions/latest/apireference/API_CreateStateMachine.html#StepFunctions-CreateStateMachine-request-name
 var stateMachineNameBadChars = []byte{' ', '<', '>', '{', '}', '[', ']', '?', '*', '"', '#', '%', '\\', '^', '|', '~', '`', '$', '&', ',', ';', ':', '/'}
 
@@ -132,9 +343,9 @@ func stateMachineARN(region, accountID, wdName string, wdVersion int64, namespac
 	return fmt.Sprintf("arn:aws:states:%s:%s:stateMachine:%s", region, accountID, stateMachineName(wdName, wdVersion, namespace, startAt))
 }
 
-func (wm *SFNWorkflowManager) describeOrCreateStateMachine(wd models.WorkflowDefinition, namespace, queue string) (*sfn.DescribeStateMachineOutput, error) {
-	describeOutput, err := wm.sfnapi.DescribeStateMachine(&sfn.DescribeStateMachineInput{
-		StateMachineArn: aws.String(stateMachineARN(wm.region, wm.accountID, wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)),
+func (wm *SFNWorkflowManager) describeOrCreateStateMachine(target sfnTarget, wd models.WorkflowDefinition, namespace, queue string) (*sfn.DescribeStateMachineOutput, error) {
+	describeOutput, err := target.sfnapi.DescribeStateMachine(&sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(stateMachineARN(target.region, target.accountID, wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)),
 	})
 	if err == nil {
 		return describeOutput, nil
@@ -148,30 +359,165 @@ func (wm *SFNWorkflowManager) describeOrCreateStateMachine(wd models.WorkflowDef
 	}
 
 	// state machine doesn't exist, create it
-	awsStateMachine := stateMachineWithFullActivityARNs(*wd.StateMachine, wm.region, wm.accountID, namespace)
-	awsStateMachine = stateMachineWithDefaultRetriers(*awsStateMachine)
-	awsStateMachineDefBytes, err := json.MarshalIndent(awsStateMachine, "", "  ")
+	awsStateMachineDef, err := awsStateMachineDefinition(target, wd, namespace)
 	if err != nil {
 		return nil, err
 	}
-	awsStateMachineDef := string(awsStateMachineDefBytes)
 	// the name must be unique. Use workflow definition name + version + namespace + queue to uniquely identify a state machine
 	// this effectively creates a new workflow definition in each namespace we deploy into
 	awsStateMachineName := stateMachineName(wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)
 	log.InfoD("create-state-machine", logger.M{"definition": awsStateMachineDef, "name": awsStateMachineName})
-	_, err = wm.sfnapi.CreateStateMachine(&sfn.CreateStateMachineInput{
+	_, err = target.sfnapi.CreateStateMachine(&sfn.CreateStateMachineInput{
 		Name:       aws.String(awsStateMachineName),
 		Definition: aws.String(awsStateMachineDef),
-		RoleArn:    aws.String(wm.roleARN),
+		RoleArn:    aws.String(target.roleARN),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("CreateStateMachine error: %s", err.Error())
 	}
 
-	return wm.describeOrCreateStateMachine(wd, namespace, queue)
+	return wm.describeOrCreateStateMachine(target, wd, namespace, queue)
+}
+
+// ExportStateMachine returns the state machine that workflows of a definition run as in a
+// namespace, exactly as it is submitted to Step Functions.
+func (wm *SFNWorkflowManager) ExportStateMachine(ctx context.Context, wd models.WorkflowDefinition, namespace string) (*models.StateMachineExport, error) {
+	target, err := wm.targetForNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	definition, err := awsStateMachineDefinition(target, wd, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &models.StateMachineExport{
+		Name:       stateMachineName(wd.Name, wd.Version, namespace, wd.StateMachine.StartAt),
+		Arn:        stateMachineARN(target.region, target.accountID, wd.Name, wd.Version, namespace, wd.StateMachine.StartAt),
+		RoleARN:    target.roleARN,
+		Definition: definition,
+	}, nil
 }
 
-func (wm *SFNWorkflowManager) startExecution(stateMachineArn *string, workflowID, input string) error {
+// DeleteStateMachines deletes the state machines created for a workflow definition version in
+// the default account and every account with a NamespaceConfig. State machines with running
+// executions are kept, since deleting them would stop the executions.
+func (wm *SFNWorkflowManager) DeleteStateMachines(ctx context.Context, wd models.WorkflowDefinition) error {
+	configs, err := wm.store.GetNamespaceConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	targets := []sfnTarget{}
+	seen := map[string]bool{}
+	for _, config := range append([]models.NamespaceConfig{{}}, configs...) {
+		target, err := wm.targetForConfig(config)
+		if err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s--%s", target.region, target.accountID)
+		if !seen[key] {
+			seen[key] = true
+			targets = append(targets, target)
+		}
+	}
+
+	for _, target := range targets {
+		if err := wm.deleteStateMachines(target, wd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wm *SFNWorkflowManager) deleteStateMachines(target sfnTarget, wd models.WorkflowDefinition) error {
+	stateMachineArns := []*string{}
+	input := &sfn.ListStateMachinesInput{}
+	for {
+		out, err := target.sfnapi.ListStateMachines(input)
+		if err != nil {
+			return err
+		}
+		for _, sm := range out.StateMachines {
+			if isStateMachineForDefinition(aws.StringValue(sm.Name), wd) {
+				stateMachineArns = append(stateMachineArns, sm.StateMachineArn)
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	for _, stateMachineArn := range stateMachineArns {
+		running, err := target.sfnapi.ListExecutions(&sfn.ListExecutionsInput{
+			StateMachineArn: stateMachineArn,
+			StatusFilter:    aws.String(sfn.ExecutionStatusRunning),
+			MaxResults:      aws.Int64(1),
+		})
+		if err != nil {
+			return err
+		}
+		if len(running.Executions) > 0 {
+			log.InfoD("delete-state-machine-skipped", logger.M{"arn": aws.StringValue(stateMachineArn), "reason": "running executions"})
+			continue
+		}
+		log.InfoD("delete-state-machine", logger.M{"arn": aws.StringValue(stateMachineArn)})
+		if _, err := target.sfnapi.DeleteStateMachine(&sfn.DeleteStateMachineInput{
+			StateMachineArn: stateMachineArn,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateActivity creates an activity in the account and region of a namespace and returns its
+// ARN. Creating an activity that already exists returns the ARN of the existing activity.
+func (wm *SFNWorkflowManager) CreateActivity(ctx context.Context, namespace, name string) (string, error) {
+	target, err := wm.targetForNamespace(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+	out, err := target.sfnapi.CreateActivity(&sfn.CreateActivityInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return "", err
+	}
+	log.InfoD("create-activity", logger.M{"arn": aws.StringValue(out.ActivityArn), "namespace": namespace})
+	return aws.StringValue(out.ActivityArn), nil
+}
+
+// DeleteActivity deletes an activity in the account and region of a namespace.
+func (wm *SFNWorkflowManager) DeleteActivity(ctx context.Context, namespace, arn string) error {
+	target, err := wm.targetForNamespace(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	log.InfoD("delete-activity", logger.M{"arn": arn, "namespace": namespace})
+	_, err = target.sfnapi.DeleteActivity(&sfn.DeleteActivityInput{
+		ActivityArn: aws.String(arn),
+	})
+	return err
+}
+
+// isStateMachineForDefinition checks whether a state machine name was created by
+// stateMachineName for a workflow definition version, in any namespace and starting at any
+// state (workflows can be retried from a state other than StartAt).
+func isStateMachineForDefinition(name string, wd models.WorkflowDefinition) bool {
+	if wd.StateMachine == nil {
+		return false
+	}
+	for startAt := range wd.StateMachine.States {
+		suffix := stateMachineName(wd.Name, wd.Version, "", startAt)
+		namespace := strings.TrimSuffix(name, suffix)
+		if namespace != name && namespace != "" && !strings.Contains(namespace, "--") {
+			return true
+		}
+	}
+	return false
+}
+
+func (wm *SFNWorkflowManager) startExecution(target sfnTarget, stateMachineArn *string, workflowID, input string) error {
 	executionName := aws.String(workflowID)
 
 	var inputJSON map[string]interface{}
@@ -193,7 +539,7 @@ func (wm *SFNWorkflowManager) startExecution(stateMachineArn *string, workflowID
 	// - aws.String(""): leads to InvalidExecutionInput AWS error
 	// - aws.String("[]"): leads to an input of an empty array "[]"
 	startExecutionInput := aws.String(string(marshaledInput))
-	_, err = wm.sfnapi.StartExecution(&sfn.StartExecutionInput{
+	_, err = target.sfnapi.StartExecution(&sfn.StartExecutionInput{
 		StateMachineArn: stateMachineArn,
 		Input:           startExecutionInput,
 		Name:            executionName,
@@ -202,13 +548,54 @@ func (wm *SFNWorkflowManager) startExecution(stateMachineArn *string, workflowID
 	return err
 }
 
+// startExecutionCreatingStateMachine starts an execution like startExecution, but creates the
+// state machine again if it was deleted after it was described, e.g. along with an unused
+// WorkflowDefinition version.
+func (wm *SFNWorkflowManager) startExecutionCreatingStateMachine(
+	target sfnTarget, wd models.WorkflowDefinition, namespace, queue string, stateMachineArn *string, workflowID, input string,
+) error {
+	err := wm.startExecution(target, stateMachineArn, workflowID, input)
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != sfn.ErrCodeStateMachineDoesNotExist {
+		return err
+	}
+
+	log.InfoD("recreate-state-machine", logger.M{"arn": aws.StringValue(stateMachineArn), "id": workflowID})
+	describeOutput, err := wm.describeOrCreateStateMachine(target, wd, namespace, queue)
+	if err != nil {
+		return err
+	}
+	return wm.startExecution(target, describeOutput.StateMachineArn, workflowID, input)
+}
+
+// CreateWorkflow starts a workflow of a definition version. alias is the alias that picked the
+// version, if the workflow was started through one.
 func (wm *SFNWorkflowManager) CreateWorkflow(ctx context.Context, wd models.WorkflowDefinition,
+	alias string,
 	input string,
 	namespace string,
 	queue string,
 	tags map[string]interface{}) (*models.Workflow, error) {
 
-	describeOutput, err := wm.describeOrCreateStateMachine(wd, namespace, queue)
+	config, err := wm.namespaceConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// the execution runs with the definition's defaults and the namespace's parameters
+	// merged under the caller's input
+	effectiveInput, err := resources.EffectiveWorkflowInput(wd.DefaultInput, config.Parameters, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := resources.ValidateWorkflowInput(wd, effectiveInput); err != nil {
+		return nil, err
+	}
+
+	target, err := wm.targetForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	describeOutput, err := wm.describeOrCreateStateMachine(target, wd, namespace, queue)
 	if err != nil {
 		return nil, err
 	}
@@ -217,12 +604,15 @@ func (wm *SFNWorkflowManager) CreateWorkflow(ctx context.Context, wd models.Work
 	// i.e. execution was started but we failed to save workflow
 	// If we fail starting the execution, we can resolve this out of band (TODO: should support cancelling)
 	workflow := resources.NewWorkflow(&wd, input, namespace, queue, tags)
+	workflow.EffectiveInput = effectiveInput
+	workflow.WorkflowDefinitionAlias = alias
+	target.recordTarget(workflow)
 	if err := wm.store.SaveWorkflow(ctx, *workflow); err != nil {
 		return nil, err
 	}
 
-	// submit an execution using input, set execution name == our workflow GUID
-	err = wm.startExecution(describeOutput.StateMachineArn, workflow.ID, input)
+	// submit an execution using the effective input, set execution name == our workflow GUID
+	err = wm.startExecutionCreatingStateMachine(target, wd, namespace, queue, describeOutput.StateMachineArn, workflow.ID, effectiveInput)
 	if err != nil {
 		// since we failed to start execution, remove Workflow from store
 		if delErr := wm.store.DeleteWorkflowByID(ctx, workflow.ID); delErr != nil {
@@ -258,13 +648,18 @@ func (wm *SFNWorkflowManager) RetryWorkflow(ctx context.Context, ogWorkflow mode
 	if err := resources.RemoveInactiveStates(newDef.StateMachine); err != nil {
 		return nil, err
 	}
-	describeOutput, err := wm.describeOrCreateStateMachine(newDef, ogWorkflow.Namespace, ogWorkflow.Queue)
+	target, err := wm.targetForNamespace(ctx, ogWorkflow.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	describeOutput, err := wm.describeOrCreateStateMachine(target, newDef, ogWorkflow.Namespace, ogWorkflow.Queue)
 	if err != nil {
 		return nil, err
 	}
 
 	workflow := resources.NewWorkflow(&newDef, input, ogWorkflow.Namespace, ogWorkflow.Queue, ogWorkflow.Tags)
 	workflow.RetryFor = ogWorkflow.ID
+	target.recordTarget(workflow)
 	ogWorkflow.Retries = append(ogWorkflow.Retries, workflow.ID)
 
 	// save the workflow before starting execution to ensure we don't have untracked executions
@@ -278,7 +673,7 @@ func (wm *SFNWorkflowManager) RetryWorkflow(ctx context.Context, ogWorkflow mode
 	}
 
 	// submit an execution using input, set execution name == our workflow GUID
-	err = wm.startExecution(describeOutput.StateMachineArn, workflow.ID, input)
+	err = wm.startExecutionCreatingStateMachine(target, newDef, ogWorkflow.Namespace, ogWorkflow.Queue, describeOutput.StateMachineArn, workflow.ID, input)
 	if err != nil {
 		return nil, err
 	}
@@ -297,9 +692,12 @@ func (wm *SFNWorkflowManager) CancelWorkflow(ctx context.Context, workflow *mode
 		return fmt.Errorf("Cancellation not allowed. Workflow %s is %s", workflow.ID, workflow.Status)
 	}
 
-	wd := workflow.WorkflowDefinition
-	execARN := wm.executionARN(workflow, wd)
-	if _, err := wm.sfnapi.StopExecution(&sfn.StopExecutionInput{
+	target, err := wm.targetForWorkflow(ctx, workflow)
+	if err != nil {
+		return err
+	}
+	execARN := target.executionARN(workflow, workflow.WorkflowDefinition)
+	if _, err := target.sfnapi.StopExecution(&sfn.StopExecutionInput{
 		ExecutionArn: aws.String(execARN),
 		Cause:        aws.String(reason),
 		// Error: aws.String(""), // TODO: Can we use this? "An arbitrary error code that identifies the cause of the termination."
@@ -312,13 +710,13 @@ func (wm *SFNWorkflowManager) CancelWorkflow(ctx context.Context, workflow *mode
 	return wm.store.UpdateWorkflow(ctx, *workflow)
 }
 
-func (wm *SFNWorkflowManager) executionARN(
+func (target sfnTarget) executionARN(
 	workflow *models.Workflow,
 	definition *models.WorkflowDefinition,
 ) string {
 	return executionARN(
-		wm.region,
-		wm.accountID,
+		target.region,
+		target.accountID,
 		stateMachineName(definition.Name, definition.Version, workflow.Namespace, definition.StateMachine.StartAt),
 		workflow.ID,
 	)
@@ -354,14 +752,12 @@ func (wm *SFNWorkflowManager) UpdateWorkflowSummary(ctx context.Context, workflo
 	}
 
 	// get execution from AWS, pull in all the data into the workflow object
-	wd := workflow.WorkflowDefinition
-	execARN := executionARN(
-		wm.region,
-		wm.accountID,
-		stateMachineName(wd.Name, wd.Version, workflow.Namespace, wd.StateMachine.StartAt),
-		workflow.ID,
-	)
-	describeOutput, err := wm.sfnapi.DescribeExecutionWithContext(context.TODO(), &sfn.DescribeExecutionInput{
+	target, err := wm.targetForWorkflow(ctx, workflow)
+	if err != nil {
+		return err
+	}
+	execARN := target.executionARN(workflow, workflow.WorkflowDefinition)
+	describeOutput, err := target.sfnapi.DescribeExecutionWithContext(context.TODO(), &sfn.DescribeExecutionInput{
 		ExecutionArn: aws.String(execARN),
 	})
 	if err != nil {
@@ -410,13 +806,11 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 	// Execution history events contain a "previous" event ID which is the "parent" event within the execution tree.
 	// E.g., if a state machine has two parallel Task states, the events for these states will overlap in the history, but the event IDs + previous event IDs will link together the parallel execution paths.
 	// In order to correctly associate events with the job they correspond to, maintain a map from event ID to job.
-	wd := workflow.WorkflowSummary.WorkflowDefinition
-	execARN := executionARN(
-		wm.region,
-		wm.accountID,
-		stateMachineName(wd.Name, wd.Version, workflow.Namespace, wd.StateMachine.StartAt),
-		workflow.ID,
-	)
+	target, err := wm.targetForWorkflow(ctx, workflow)
+	if err != nil {
+		return err
+	}
+	execARN := target.executionARN(workflow, workflow.WorkflowSummary.WorkflowDefinition)
 	jobs := []*models.Job{}
 	eventIDToJob := map[int64]*models.Job{}
 	eventToJob := func(evt *sfn.HistoryEvent) *models.Job {
@@ -466,7 +860,7 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 	ctx, cancel := context.WithTimeout(context.Background(), durationToFetchHistoryPages)
 	defer cancel()
 
-	if err := wm.sfnapi.GetExecutionHistoryPagesWithContext(ctx, &sfn.GetExecutionHistoryInput{
+	if err := target.sfnapi.GetExecutionHistoryPagesWithContext(ctx, &sfn.GetExecutionHistoryInput{
 		ExecutionArn: aws.String(execARN),
 	}, func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool {
 		// NOTE: if pulling the entire execution history becomes infeasible, we can:
@@ -520,11 +914,17 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 					*job = models.Job{}
 					job.ID = fmt.Sprintf("%d", aws.Int64Value(evt.Id))
 					job.Attempts = append(oldJobData.Attempts, &models.JobAttempt{
-						Reason:    oldJobData.StatusReason,
-						CreatedAt: oldJobData.CreatedAt,
-						StartedAt: oldJobData.StartedAt,
-						StoppedAt: oldJobData.StoppedAt,
-						TaskARN:   oldJobData.Container,
+						Reason:               oldJobData.StatusReason,
+						ErrorName:            oldJobData.ErrorName,
+						Cause:                oldJobData.Cause,
+						CreatedAt:            oldJobData.CreatedAt,
+						StartedAt:            oldJobData.StartedAt,
+						StoppedAt:            oldJobData.StoppedAt,
+						WorkerName:           oldJobData.Container,
+						ExitCode:             oldJobData.ExitCode,
+						ContainerInstanceARN: oldJobData.ContainerInstanceARN,
+						TaskARN:              oldJobData.TaskARN,
+						LogURL:               oldJobData.LogURL,
 					})
 					job.CreatedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
 					job.Input = oldJobData.Input
@@ -547,21 +947,22 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 				job.Status = models.JobStatusFailed
 				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
 				cause, errorName := causeAndErrorNameFromFailureEvent(evt)
-				// TODO: need more natural place to put error name...
+				resources.SetJobFailure(job, cause, errorName)
 				job.StatusReason = strings.TrimSpace(fmt.Sprintf(
 					"%s\n%s",
-					getLastFewLines(cause),
-					errorName,
+					getLastFewLines(job.Cause),
+					job.ErrorName,
 				))
 			case sfn.HistoryEventTypeActivityTimedOut, sfn.HistoryEventTypeLambdaFunctionTimedOut:
 				job.Status = models.JobStatusFailed
 				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
 				cause, errorName := causeAndErrorNameFromFailureEvent(evt)
+				resources.SetJobFailure(job, cause, errorName)
 				job.StatusReason = strings.TrimSpace(fmt.Sprintf(
 					"%s\n%s\n%s",
 					resources.StatusReasonJobTimedOut,
-					errorName,
-					getLastFewLines(cause),
+					job.ErrorName,
+					getLastFewLines(job.Cause),
 				))
 			case sfn.HistoryEventTypeActivitySucceeded, sfn.HistoryEventTypeLambdaFunctionSucceeded:
 				job.Status = models.JobStatusSucceeded
@@ -581,6 +982,8 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 						// do not update job status reason -- it should already be updated based on the ActivityTimedOut event
 					} else {
 						// set unknown errors to StatusReason
+						job.ErrorName = aws.StringValue(details.Error)
+						job.Cause = aws.StringValue(details.Cause)
 						job.StatusReason = strings.TrimSpace(fmt.Sprintf(
 							"%s\n%s",
 							getLastFewLines(aws.StringValue(details.Cause)),
@@ -592,6 +995,8 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 				job.Status = models.JobStatusFailed
 				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
 				if details := evt.ExecutionTimedOutEventDetails; details != nil {
+					job.ErrorName = aws.StringValue(details.Error)
+					job.Cause = aws.StringValue(details.Cause)
 					job.StatusReason = strings.TrimSpace(fmt.Sprintf(
 						"%s\n%s\n%s",
 						resources.StatusReasonWorkflowTimedOut,
@@ -621,6 +1026,7 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 	}); err != nil {
 		return err
 	}
+	recordActivityWorkers(ctx, wm.store, *workflow, jobs)
 	workflow.Jobs = jobs
 
 	return wm.store.UpdateWorkflow(ctx, *workflow)
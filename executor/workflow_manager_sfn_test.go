@@ -2,6 +2,7 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -9,7 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -166,6 +169,7 @@ func TestCreateWorkflow(t *testing.T) {
 			Return(&sqs.SendMessageOutput{}, nil)
 
 		workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+			"stable",
 			input,
 			"namespace",
 			"queue",
@@ -180,9 +184,10 @@ func TestCreateWorkflow(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, workflow.CreatedAt.String(), savedWorkflow.CreatedAt.String())
 		assert.Equal(t, workflow.ID, savedWorkflow.ID)
+		assert.Equal(t, "stable", savedWorkflow.WorkflowDefinitionAlias)
 
 		t.Log("Verify updatePendingWorkflow causes in-progress workflow to be put back into the update queue")
-		sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+		sfnExecutionARN := c.executionARN(workflow)
 		c.mockSFNAPI.EXPECT().
 			DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 				ExecutionArn: aws.String(sfnExecutionARN),
@@ -190,6 +195,11 @@ func TestCreateWorkflow(t *testing.T) {
 			Return(&sfn.DescribeExecutionOutput{
 				Status: aws.String(sfn.ExecutionStatusRunning),
 			}, nil)
+		c.mockSFNAPI.EXPECT().
+			GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
+				ExecutionArn: aws.String(sfnExecutionARN),
+			}, gomock.Any()).
+			Return(nil)
 
 		// These calls mean the message is processed and then put back into the queue
 		msg := &sqs.Message{
@@ -240,6 +250,7 @@ func TestCreateWorkflow(t *testing.T) {
 			Return(nil, awsError)
 
 		workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+			"",
 			input,
 			"namespace",
 			"queue",
@@ -250,6 +261,113 @@ func TestCreateWorkflow(t *testing.T) {
 		assert.IsType(t, awsError, err)
 		assert.Equal(t, "test", err.(awserr.Error).Code()) // ensure this error came from sfn api
 	})
+
+	t.Run("CreateWorkflow re-creates state machines deleted after they were described", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		stateMachineArn := stateMachineARN(c.manager.region, c.manager.accountID,
+			c.workflowDefinition.Name,
+			c.workflowDefinition.Version,
+			"namespace",
+			c.workflowDefinition.StateMachine.StartAt,
+		)
+		doesNotExist := awserr.New(sfn.ErrCodeStateMachineDoesNotExist, "test", errors.New(""))
+		describeInput := &sfn.DescribeStateMachineInput{StateMachineArn: aws.String(stateMachineArn)}
+		describeOutput := &sfn.DescribeStateMachineOutput{StateMachineArn: aws.String(stateMachineArn)}
+		gomock.InOrder(
+			c.mockSFNAPI.EXPECT().DescribeStateMachine(describeInput).Return(describeOutput, nil),
+			c.mockSFNAPI.EXPECT().StartExecution(gomock.Any()).Return(nil, doesNotExist),
+			c.mockSFNAPI.EXPECT().DescribeStateMachine(describeInput).Return(nil, doesNotExist),
+			c.mockSFNAPI.EXPECT().CreateStateMachine(gomock.Any()).Return(&sfn.CreateStateMachineOutput{}, nil),
+			c.mockSFNAPI.EXPECT().DescribeStateMachine(describeInput).Return(describeOutput, nil),
+			c.mockSFNAPI.EXPECT().StartExecution(gomock.Any()).Return(&sfn.StartExecutionOutput{}, nil),
+		)
+		c.mockSQSAPI.EXPECT().
+			SendMessageWithContext(gomock.Any(), gomock.Any()).
+			Return(&sqs.SendMessageOutput{}, nil)
+
+		workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+			"",
+			input,
+			"namespace",
+			"queue",
+			map[string]interface{}{},
+		)
+		require.NoError(t, err)
+		_, err = c.store.GetWorkflowByID(ctx, workflow.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateWorkflowEffectiveInput(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	c.workflowDefinition.DefaultInput = `{"dryRun": true, "bucket": "default-bucket"}`
+	c.workflowDefinition.InputSchema = `{"type": "object", "required": ["district", "bucket"]}`
+	require.NoError(t, c.store.SaveNamespaceConfig(ctx, models.NamespaceConfig{
+		Namespace:  "namespace",
+		Parameters: `{"bucket": "namespace-bucket"}`,
+	}))
+
+	t.Log("The effective input is validated against the inputSchema")
+	_, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+		"",
+		`{"school": "abc"}`,
+		"namespace",
+		"queue",
+		map[string]interface{}{},
+	)
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Executions start with defaults and namespace parameters merged under the input")
+	stateMachineArn := stateMachineARN(c.manager.region, c.manager.accountID,
+		c.workflowDefinition.Name,
+		c.workflowDefinition.Version,
+		"namespace",
+		c.workflowDefinition.StateMachine.StartAt,
+	)
+	c.mockSFNAPI.EXPECT().
+		DescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}).
+		Return(&sfn.DescribeStateMachineOutput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}, nil)
+	c.mockSFNAPI.EXPECT().
+		StartExecution(gomock.Any()).
+		Do(func(input *sfn.StartExecutionInput) {
+			var executionInput map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(aws.StringValue(input.Input)), &executionInput))
+			assert.Equal(t, "abc", executionInput["district"])
+			assert.Equal(t, false, executionInput["dryRun"])
+			assert.Equal(t, "namespace-bucket", executionInput["bucket"])
+		}).
+		Return(&sfn.StartExecutionOutput{}, nil)
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.SendMessageOutput{}, nil)
+
+	input := `{"district": "abc", "dryRun": false}`
+	workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+		"",
+		input,
+		"namespace",
+		"queue",
+		map[string]interface{}{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, input, workflow.Input)
+	assert.JSONEq(t, `{"district": "abc", "dryRun": false, "bucket": "namespace-bucket"}`, workflow.EffectiveInput)
+
+	savedWorkflow, err := c.store.GetWorkflowByID(ctx, workflow.ID)
+	require.NoError(t, err)
+	assert.Equal(t, workflow.Input, savedWorkflow.Input)
+	assert.Equal(t, workflow.EffectiveInput, savedWorkflow.EffectiveInput)
 }
 
 func TestRetryWorkflow(t *testing.T) {
@@ -283,6 +401,7 @@ func TestRetryWorkflow(t *testing.T) {
 			Return(&sqs.SendMessageOutput{}, nil)
 
 		workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+			"",
 			input,
 			"namespace",
 			"queue",
@@ -298,7 +417,7 @@ func TestRetryWorkflow(t *testing.T) {
 		assert.Equal(t, workflow.CreatedAt.String(), savedWorkflow.CreatedAt.String())
 		assert.Equal(t, workflow.ID, savedWorkflow.ID)
 
-		sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+		sfnExecutionARN := c.executionARN(workflow)
 
 		t.Log("RetryWorkflow should fail if workflow is not yet done")
 		_, err = c.manager.RetryWorkflow(ctx, *workflow, workflow.WorkflowDefinition.StateMachine.StartAt, input)
@@ -330,7 +449,7 @@ func TestRetryWorkflow(t *testing.T) {
 		assert.Equal(t, workflow2.CreatedAt.String(), savedWorkflow2.CreatedAt.String())
 		assert.Equal(t, workflow2.ID, savedWorkflow2.ID)
 
-		sfnExecutionARN2 := c.manager.executionARN(workflow2, c.workflowDefinition)
+		sfnExecutionARN2 := c.executionARN(workflow2)
 		assert.NotEqual(t, sfnExecutionARN2, sfnExecutionARN)
 	})
 }
@@ -349,7 +468,7 @@ func TestCancelWorkflow(t *testing.T) {
 
 	t.Log("Verify execution is stopped and status reason is updated.")
 	reason := "i have my reasons"
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		StopExecution(&sfn.StopExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -414,7 +533,7 @@ func TestUpdateWorkflowStatusJobCreated(t *testing.T) {
 	workflow.Status = models.WorkflowStatusQueued
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -463,7 +582,7 @@ func TestUpdateWorkflowStatusJobFailed(t *testing.T) {
 	workflow.Status = models.WorkflowStatusQueued
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -494,11 +613,81 @@ func TestUpdateWorkflowStatusJobFailed(t *testing.T) {
 	assertBasicJobData(t, workflow.Jobs[0])
 	assert.Equal(t, models.JobStatusFailed, workflow.Jobs[0].Status)
 	assert.Equal(t, "line4\nline5\nline6", workflow.Jobs[0].StatusReason)
+	assert.Equal(t, "line1\nline2\nline3\nline4\nline5\nline6\n\n", workflow.Jobs[0].Cause)
 	assert.WithinDuration(
 		t, jobFailedEventTimestamp, time.Time(workflow.Jobs[0].StoppedAt), 1*time.Second,
 	)
 }
 
+func TestUpdateWorkflowStatusJobRetried(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	workflow := c.newWorkflow()
+	workflow.Status = models.WorkflowStatusRunning
+	c.saveWorkflow(ctx, t, workflow)
+
+	cause := "Traceback (most recent call last):\n  File \"main.py\", line 1\n  File \"sync.py\", line 2\nKeyError: 'district'"
+	c.mockSFNAPI.EXPECT().
+		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
+			ExecutionArn: aws.String(c.executionARN(workflow)),
+		}, gomock.Any()).
+		Do(func(
+			ctx aws.Context,
+			input *sfn.GetExecutionHistoryInput,
+			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
+		) {
+			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
+				jobCreatedEvent,
+				{
+					Id:              aws.Int64(2),
+					PreviousEventId: aws.Int64(1),
+					Timestamp:       aws.Time(jobCreatedEventTimestamp),
+					Type:            aws.String(sfn.HistoryEventTypeActivityScheduled),
+				},
+				{
+					Id:              aws.Int64(3),
+					PreviousEventId: aws.Int64(2),
+					Timestamp:       aws.Time(jobCreatedEventTimestamp.Add(time.Minute)),
+					Type:            aws.String(sfn.HistoryEventTypeActivityStarted),
+					ActivityStartedEventDetails: &sfn.ActivityStartedEventDetails{
+						WorkerName: aws.String("worker-1"),
+					},
+				},
+				{
+					Id:              aws.Int64(4),
+					PreviousEventId: aws.Int64(3),
+					Timestamp:       aws.Time(jobFailedEventTimestamp),
+					Type:            aws.String(sfn.HistoryEventTypeActivityFailed),
+					ActivityFailedEventDetails: &sfn.ActivityFailedEventDetails{
+						Cause: aws.String(cause),
+						Error: aws.String("KeyError"),
+					},
+				},
+				{
+					Id:              aws.Int64(5),
+					PreviousEventId: aws.Int64(4),
+					Timestamp:       aws.Time(jobFailedEventTimestamp.Add(time.Minute)),
+					Type:            aws.String(sfn.HistoryEventTypeActivityScheduled),
+				},
+			}}, true)
+		})
+
+	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
+	require.Len(t, workflow.Jobs, 1)
+	job := workflow.Jobs[0]
+	assert.Equal(t, models.JobStatusQueued, job.Status)
+	assert.Empty(t, job.ErrorName)
+	require.Len(t, job.Attempts, 1)
+	assert.Equal(t, "KeyError", job.Attempts[0].ErrorName)
+	assert.Equal(t, "worker-1", job.Attempts[0].WorkerName)
+	assert.Empty(t, job.Attempts[0].TaskARN)
+	assert.Equal(t, cause, job.Attempts[0].Cause)
+	assert.Equal(t, "File \"main.py\", line 1\n  File \"sync.py\", line 2\nKeyError: 'district'\nKeyError", job.Attempts[0].Reason)
+}
+
 func TestUpdateWorkflowStatusJobFailedNotDeployed(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -509,7 +698,7 @@ func TestUpdateWorkflowStatusJobFailedNotDeployed(t *testing.T) {
 	workflow.Status = models.WorkflowStatusQueued
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -588,7 +777,7 @@ func TestUpdateWorkflowStatusWorkflowJobSucceeded(t *testing.T) {
 	c.saveWorkflow(ctx, t, workflow)
 
 	executionOutput := `{"output": true}`
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -623,6 +812,100 @@ func TestUpdateWorkflowStatusWorkflowJobSucceeded(t *testing.T) {
 	assertSucceededJobData(t, workflow.Jobs[0])
 }
 
+func TestUpdatePendingWorkflowCountsStateStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	workflow := c.newWorkflow()
+	workflow.Status = models.WorkflowStatusRunning
+	c.saveWorkflow(ctx, t, workflow)
+
+	sfnExecutionARN := c.executionARN(workflow)
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(sfnExecutionARN),
+		}).
+		Return(&sfn.DescribeExecutionOutput{
+			Status:   aws.String(sfn.ExecutionStatusSucceeded),
+			StopDate: aws.Time(jobExitedEventTimestamp),
+		}, nil)
+	c.mockSFNAPI.EXPECT().
+		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
+			ExecutionArn: aws.String(sfnExecutionARN),
+		}, gomock.Any()).
+		Do(func(
+			ctx aws.Context,
+			input *sfn.GetExecutionHistoryInput,
+			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
+		) {
+			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
+				jobCreatedEvent,
+				jobSucceededEvent,
+				jobExitedEvent,
+			}}, true)
+		}).
+		Times(2)
+
+	// the workflow is done, so it isn't put back into the update queue
+	c.mockSQSAPI.EXPECT().
+		DeleteMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.DeleteMessageOutput{}, nil).
+		Times(2)
+
+	msg := &sqs.Message{Body: aws.String(workflow.ID), ReceiptHandle: aws.String("last-message")}
+	_, err := updatePendingWorkflow(ctx, msg, c.manager, c.store, c.mockSQSAPI, "")
+	require.NoError(t, err)
+
+	createdAt := time.Time(workflow.CreatedAt)
+	periods, err := c.store.GetWorkflowStatsCounts(ctx, workflow.WorkflowDefinition.Name, createdAt, createdAt.Add(time.Hour))
+	require.NoError(t, err)
+	stats := resources.NewWorkflowDefinitionStateStats(
+		workflow.WorkflowDefinition.Name, createdAt, createdAt.Add(time.Hour), periods,
+	)
+	assert.Equal(t, int64(1), stats.Workflows)
+	require.Len(t, stats.States, 1)
+	assert.Equal(t, aws.StringValue(jobCreatedEvent.StateEnteredEventDetails.Name), stats.States[0].State)
+	assert.Equal(t, int64(1), stats.States[0].Succeeded)
+
+	t.Log("Its jobs are only counted once if its last update is delivered again")
+	_, err = updatePendingWorkflow(ctx, msg, c.manager, c.store, c.mockSQSAPI, "")
+	require.NoError(t, err)
+	periods, err = c.store.GetWorkflowStatsCounts(ctx, workflow.WorkflowDefinition.Name, createdAt, createdAt.Add(time.Hour))
+	require.NoError(t, err)
+	stats = resources.NewWorkflowDefinitionStateStats(
+		workflow.WorkflowDefinition.Name, createdAt, createdAt.Add(time.Hour), periods,
+	)
+	assert.Equal(t, int64(1), stats.Workflows)
+}
+
+func TestUpdatePendingWorkflowStopsRetryingHistory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	workflow := c.newWorkflow()
+	workflow.Status = models.WorkflowStatusSucceeded
+	workflow.StoppedAt = strfmt.DateTime(time.Now().Add(-durationToRetryStoppedWorkflowHistory - time.Minute))
+	c.saveWorkflow(ctx, t, workflow)
+
+	c.mockSFNAPI.EXPECT().
+		GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("history unavailable"))
+
+	// the final jobs of a workflow that stopped long ago aren't retried, so it isn't put back
+	// into the update queue
+	c.mockSQSAPI.EXPECT().
+		DeleteMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.DeleteMessageOutput{}, nil)
+
+	msg := &sqs.Message{Body: aws.String(workflow.ID), ReceiptHandle: aws.String("last-message")}
+	_, err := updatePendingWorkflow(ctx, msg, c.manager, c.store, c.mockSQSAPI, "")
+	require.NoError(t, err)
+}
+
 var jobAbortedEventTimestamp = jobSucceededEventTimestamp.Add(5 * time.Minute)
 var jobAbortedEvent = &sfn.HistoryEvent{
 	Id:        aws.Int64(5),
@@ -650,7 +933,7 @@ func TestUpdateWorkflowStatusJobCancelled(t *testing.T) {
 	workflow.StatusReason = "cancelled by user"
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -694,7 +977,7 @@ func TestUpdateWorkflowStatusWorkflowCancelledAfterJobSucceeded(t *testing.T) {
 	workflow.StatusReason = "cancelled by user"
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -739,7 +1022,7 @@ func TestUpdateWorkflowStatusExecutionNotFoundRetry(t *testing.T) {
 	c.saveWorkflow(ctx, t, workflow)
 
 	executionOutput := `{"output": true}`
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	// fail the first time
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
@@ -795,7 +1078,7 @@ func TestUpdateWorkflowStatusExecutionNotFoundStopRetry(t *testing.T) {
 	workflow.Status = models.WorkflowStatusQueued
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -871,7 +1154,7 @@ func TestUpdateWorkflowStatusJobTimedOut(t *testing.T) {
 	workflow.Status = models.WorkflowStatusRunning
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -930,7 +1213,7 @@ func TestUpdateWorkflowStatusWorkflowTimedOut(t *testing.T) {
 	workflow.Status = models.WorkflowStatusRunning
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionARN(workflow, c.workflowDefinition)
+	sfnExecutionARN := c.executionARN(workflow)
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
@@ -965,6 +1248,274 @@ func TestUpdateWorkflowStatusWorkflowTimedOut(t *testing.T) {
 	assertWorkflowTimedOutJobData(t, workflow.Jobs[0])
 }
 
+func TestStateMachineFromAWS(t *testing.T) {
+	userRetry := &models.SLRetrier{
+		MaxAttempts: swag.Int64(1),
+		ErrorEquals: []models.SLErrorEquals{"States.ALL"},
+	}
+	sm := models.SLStateMachine{
+		StartAt: "foostate",
+		States: map[string]models.SLState{
+			"foostate": models.SLState{
+				Type:     models.SLStateTypeTask,
+				Resource: "resource-name",
+				Next:     "foostatelambda",
+				Retry:    []*models.SLRetrier{userRetry},
+			},
+			"foostatelambda": models.SLState{
+				Type:     models.SLStateTypeTask,
+				Resource: "lambda:resource-name",
+				Next:     "end",
+			},
+			"end": models.SLState{
+				Type: models.SLStateTypeSucceed,
+			},
+		},
+	}
+
+	t.Log("Converting to AWS and back gives the original state machine")
+	awsSM := stateMachineWithDefaultRetriers(*stateMachineWithFullActivityARNs(sm, "region", "accountID", "namespace"))
+	imported, err := StateMachineFromAWS(*awsSM)
+	require.NoError(t, err)
+	require.Equal(t, sm, *imported)
+
+	t.Log("Resources must be activity or lambda ARNs of a single namespace")
+	for resource, message := range map[string]string{
+		"arn:aws:states:region:accountID:activity:other--resource-name":      "more than one namespace",
+		"arn:aws:states:region:accountID:activity:resource-name":             "<namespace>--<resource>",
+		"arn:aws:lambda:region:accountID:function:namespace--resource:alias": "versions and aliases",
+		"arn:aws:sqs:region:accountID:namespace--queue":                      "invalid ARN",
+		"arn:aws:states:region:accountID:stateMachine:namespace--machine":    "only activity and lambda function ARNs",
+	} {
+		invalidSM := *stateMachineWithFullActivityARNs(sm, "region", "accountID", "namespace")
+		foostate := invalidSM.States["foostate"]
+		foostate.Resource = resource
+		invalidSM.States["foostate"] = foostate
+		_, err := StateMachineFromAWS(invalidSM)
+		require.IsType(t, models.BadRequest{}, err, resource)
+		assert.Contains(t, err.(models.BadRequest).Message, message)
+	}
+}
+
+func TestExportStateMachine(t *testing.T) {
+	ctx := context.Background()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	require.NoError(t, c.store.SaveNamespaceConfig(ctx, models.NamespaceConfig{
+		Namespace: "staging",
+		AccountID: "222222222222",
+		RoleARN:   "arn:aws:iam::222222222222:role/sfn",
+	}))
+
+	export, err := c.manager.ExportStateMachine(ctx, *c.workflowDefinition, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, stateMachineName(c.workflowDefinition.Name, c.workflowDefinition.Version, "staging",
+		c.workflowDefinition.StateMachine.StartAt), export.Name)
+	assert.Equal(t, stateMachineARN("", "222222222222", c.workflowDefinition.Name, c.workflowDefinition.Version,
+		"staging", c.workflowDefinition.StateMachine.StartAt), export.Arn)
+	assert.Equal(t, "arn:aws:iam::222222222222:role/sfn", export.RoleARN)
+
+	var sm models.SLStateMachine
+	require.NoError(t, json.Unmarshal([]byte(export.Definition), &sm))
+	for _, state := range sm.States {
+		if state.Type == models.SLStateTypeTask {
+			assert.Contains(t, state.Resource, "arn:aws:states::222222222222:activity:staging--")
+			assert.Equal(t, defaultSFNCLICommandTerminatedRetrier, state.Retry[0])
+		}
+	}
+}
+
+func TestDeleteStateMachines(t *testing.T) {
+	ctx := context.Background()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	wd := c.workflowDefinition
+	running := stateMachineName(wd.Name, wd.Version, "running", wd.StateMachine.StartAt)
+	retried := stateMachineName(wd.Name, wd.Version, "retried", "second-state")
+	stateMachine := func(name string) *sfn.StateMachineListItem {
+		return &sfn.StateMachineListItem{
+			Name:            aws.String(name),
+			StateMachineArn: aws.String("arn:aws:states:::stateMachine:" + name),
+		}
+	}
+	c.mockSFNAPI.EXPECT().
+		ListStateMachines(&sfn.ListStateMachinesInput{}).
+		Return(&sfn.ListStateMachinesOutput{
+			StateMachines: []*sfn.StateMachineListItem{
+				stateMachine(running),
+				stateMachine(stateMachineName("other-definition", wd.Version, "running", wd.StateMachine.StartAt)),
+			},
+			NextToken: aws.String("next"),
+		}, nil)
+	c.mockSFNAPI.EXPECT().
+		ListStateMachines(&sfn.ListStateMachinesInput{NextToken: aws.String("next")}).
+		Return(&sfn.ListStateMachinesOutput{
+			StateMachines: []*sfn.StateMachineListItem{
+				stateMachine(retried),
+				stateMachine(stateMachineName(wd.Name, wd.Version+1, "retried", wd.StateMachine.StartAt)),
+			},
+		}, nil)
+
+	t.Log("State machines with running executions are kept")
+	c.mockSFNAPI.EXPECT().
+		ListExecutions(&sfn.ListExecutionsInput{
+			StateMachineArn: stateMachine(running).StateMachineArn,
+			StatusFilter:    aws.String(sfn.ExecutionStatusRunning),
+			MaxResults:      aws.Int64(1),
+		}).
+		Return(&sfn.ListExecutionsOutput{Executions: []*sfn.ExecutionListItem{{}}}, nil)
+	c.mockSFNAPI.EXPECT().
+		ListExecutions(&sfn.ListExecutionsInput{
+			StateMachineArn: stateMachine(retried).StateMachineArn,
+			StatusFilter:    aws.String(sfn.ExecutionStatusRunning),
+			MaxResults:      aws.Int64(1),
+		}).
+		Return(&sfn.ListExecutionsOutput{}, nil)
+	c.mockSFNAPI.EXPECT().
+		DeleteStateMachine(&sfn.DeleteStateMachineInput{StateMachineArn: stateMachine(retried).StateMachineArn}).
+		Return(&sfn.DeleteStateMachineOutput{}, nil)
+
+	require.NoError(t, c.manager.DeleteStateMachines(ctx, *wd))
+}
+
+func TestActivities(t *testing.T) {
+	ctx := context.Background()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	arn := "arn:aws:states:us-east-1:123456789012:activity:staging--echo"
+	c.mockSFNAPI.EXPECT().
+		CreateActivity(&sfn.CreateActivityInput{Name: aws.String("staging--echo")}).
+		Return(&sfn.CreateActivityOutput{ActivityArn: aws.String(arn)}, nil)
+	created, err := c.manager.CreateActivity(ctx, "staging", "staging--echo")
+	require.NoError(t, err)
+	assert.Equal(t, arn, created)
+
+	c.mockSFNAPI.EXPECT().
+		DeleteActivity(&sfn.DeleteActivityInput{ActivityArn: aws.String(arn)}).
+		Return(&sfn.DeleteActivityOutput{}, nil)
+	require.NoError(t, c.manager.DeleteActivity(ctx, "staging", arn))
+}
+
+func TestIsStateMachineForDefinition(t *testing.T) {
+	wd := models.WorkflowDefinition{
+		Name:    "definition",
+		Version: 3,
+		StateMachine: &models.SLStateMachine{
+			StartAt: "start",
+			States:  map[string]models.SLState{"start": {}, "retry-from": {}},
+		},
+	}
+	assert.True(t, isStateMachineForDefinition("namespace--definition--3--start", wd))
+	assert.True(t, isStateMachineForDefinition("namespace--definition--3--retry-from", wd))
+	assert.False(t, isStateMachineForDefinition("namespace--definition--4--start", wd))
+	assert.False(t, isStateMachineForDefinition("namespace--prefixed--definition--3--start", wd))
+	assert.False(t, isStateMachineForDefinition("--definition--3--start", wd))
+}
+
+func TestNamespaceConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+
+	stagingSFNAPI := mocks.NewMockSFNAPI(c.mockController)
+	factoryCalls := 0
+	c.manager.newSFNAPI = func(region, assumeRoleARN string) (sfniface.SFNAPI, error) {
+		factoryCalls++
+		assert.Equal(t, "us-east-1", region)
+		assert.Equal(t, "arn:aws:iam::222222222222:role/workflow-manager", assumeRoleARN)
+		return stagingSFNAPI, nil
+	}
+	require.NoError(t, c.store.SaveNamespaceConfig(ctx, models.NamespaceConfig{
+		Namespace:     "staging",
+		Region:        "us-east-1",
+		AccountID:     "222222222222",
+		RoleARN:       "arn:aws:iam::222222222222:role/sfn",
+		AssumeRoleARN: "arn:aws:iam::222222222222:role/workflow-manager",
+	}))
+
+	t.Log("Namespaces without a config use the default client and settings")
+	target, err := c.manager.targetForNamespace(ctx, "namespace")
+	require.NoError(t, err)
+	assert.Equal(t, c.manager.region, target.region)
+	assert.Equal(t, c.manager.accountID, target.accountID)
+	assert.Equal(t, c.mockSFNAPI, target.sfnapi)
+
+	t.Log("Namespaces with a config create state machines and executions in their own account")
+	stateMachineArn := stateMachineARN("us-east-1", "222222222222",
+		c.workflowDefinition.Name,
+		c.workflowDefinition.Version,
+		"staging",
+		c.workflowDefinition.StateMachine.StartAt,
+	)
+	stagingSFNAPI.EXPECT().
+		DescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}).
+		Return(nil, awserr.New(sfn.ErrCodeStateMachineDoesNotExist, "does not exist", errors.New("")))
+	stagingSFNAPI.EXPECT().
+		CreateStateMachine(gomock.Any()).
+		Do(func(input *sfn.CreateStateMachineInput) {
+			assert.Equal(t, "arn:aws:iam::222222222222:role/sfn", aws.StringValue(input.RoleArn))
+			assert.Contains(t, aws.StringValue(input.Definition), "arn:aws:states:us-east-1:222222222222:activity:staging--")
+		}).
+		Return(&sfn.CreateStateMachineOutput{}, nil)
+	stagingSFNAPI.EXPECT().
+		DescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}).
+		Return(&sfn.DescribeStateMachineOutput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}, nil)
+	stagingSFNAPI.EXPECT().
+		StartExecution(gomock.Any()).
+		Return(&sfn.StartExecutionOutput{}, nil)
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.SendMessageOutput{}, nil)
+
+	workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition,
+		"",
+		"{}",
+		"staging",
+		"queue",
+		map[string]interface{}{},
+	)
+	require.NoError(t, err)
+
+	t.Log("The client for a namespace is reused")
+	stagingSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(c.executionARN(workflow)),
+		}).
+		Return(&sfn.DescribeExecutionOutput{
+			Status: aws.String(sfn.ExecutionStatusRunning),
+		}, nil)
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Contains(t, c.executionARN(workflow), "arn:aws:states:us-east-1:222222222222:execution:staging--")
+	assert.Equal(t, 1, factoryCalls)
+
+	assert.Equal(t, "us-east-1", workflow.Region)
+	assert.Equal(t, "222222222222", workflow.AccountID)
+	assert.Equal(t, "arn:aws:iam::222222222222:role/workflow-manager", workflow.AssumeRoleARN)
+
+	t.Log("Workflows are found where they started after their namespace's config is deleted")
+	require.NoError(t, c.store.DeleteNamespaceConfig(ctx, "staging"))
+	stagingSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(c.executionARN(workflow)),
+		}).
+		Return(&sfn.DescribeExecutionOutput{
+			Status: aws.String(sfn.ExecutionStatusRunning),
+		}, nil)
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Contains(t, c.executionARN(workflow), "arn:aws:states:us-east-1:222222222222:execution:staging--")
+	assert.Equal(t, 1, factoryCalls)
+}
+
 func newSFNManagerTestController(t *testing.T) *sfnManagerTestController {
 	mockController := gomock.NewController(t)
 	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
@@ -975,7 +1526,7 @@ func newSFNManagerTestController(t *testing.T) *sfnManagerTestController {
 	require.NoError(t, store.SaveWorkflowDefinition(context.Background(), *workflowDefinition))
 
 	return &sfnManagerTestController{
-		manager:            NewSFNWorkflowManager(mockSFNAPI, mockSQSAPI, store, "", "", "", ""),
+		manager:            NewSFNWorkflowManager(mockSFNAPI, nil, mockSQSAPI, store, "", "", "", ""),
 		mockController:     mockController,
 		mockSFNAPI:         mockSFNAPI,
 		mockSQSAPI:         mockSQSAPI,
@@ -999,6 +1550,12 @@ func (c *sfnManagerTestController) updateWorkflow(ctx context.Context, t *testin
 	require.NoError(t, c.store.UpdateWorkflow(ctx, *workflow))
 }
 
+func (c *sfnManagerTestController) executionARN(workflow *models.Workflow) string {
+	target, err := c.manager.targetForWorkflow(context.Background(), workflow)
+	require.NoError(c.t, err)
+	return target.executionARN(workflow, c.workflowDefinition)
+}
+
 func (c *sfnManagerTestController) tearDown() {
 	c.mockController.Finish()
 }
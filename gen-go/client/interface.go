@@ -19,6 +19,93 @@ type Client interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	HealthCheck(ctx context.Context) error
 
+	// GetNamespaceConfigs makes a GET request to /namespace-configs
+	//
+	// 200: []models.NamespaceConfig
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error)
+
+	// DeleteNamespaceConfig makes a DELETE request to /namespace-configs/{namespace}
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteNamespaceConfig(ctx context.Context, namespace string) error
+
+	// GetNamespaceConfig makes a GET request to /namespace-configs/{namespace}
+	//
+	// 200: *models.NamespaceConfig
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetNamespaceConfig(ctx context.Context, namespace string) (*models.NamespaceConfig, error)
+
+	// PutNamespaceConfig makes a PUT request to /namespace-configs/{namespace}
+	//
+	// 201: *models.NamespaceConfig
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	PutNamespaceConfig(ctx context.Context, i *models.PutNamespaceConfigInput) (*models.NamespaceConfig, error)
+
+	// GetNamespaces makes a GET request to /namespaces
+	//
+	// 200: []models.Namespace
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetNamespaces(ctx context.Context) ([]models.Namespace, error)
+
+	// DeleteNamespace makes a DELETE request to /namespaces/{namespace}
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteNamespace(ctx context.Context, namespace string) error
+
+	// GetNamespace makes a GET request to /namespaces/{namespace}
+	//
+	// 200: *models.Namespace
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetNamespace(ctx context.Context, namespace string) (*models.Namespace, error)
+
+	// PutNamespace makes a PUT request to /namespaces/{namespace}
+	//
+	// 201: *models.Namespace
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	PutNamespace(ctx context.Context, i *models.PutNamespaceInput) (*models.Namespace, error)
+
+	// GetStalledActivities makes a GET request to /stalled-activities
+	// Refreshes the jobs of every running workflow, so this is meant for debugging workflows stuck in queued rather than for frequent polling.
+	// 200: []models.StalledActivity
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetStalledActivities(ctx context.Context, i *models.GetStalledActivitiesInput) ([]models.StalledActivity, error)
+
+	// GetStateResources makes a GET request to /state-resources
+	//
+	// 200: []models.StateResource
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetStateResources(ctx context.Context, i *models.GetStateResourcesInput) ([]models.StateResource, error)
+
+	NewGetStateResourcesIter(ctx context.Context, i *models.GetStateResourcesInput) (GetStateResourcesIter, error)
+
 	// PostStateResource makes a POST request to /state-resources
 	//
 	// 201: *models.StateResource
@@ -53,13 +140,75 @@ type Client interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	PutStateResource(ctx context.Context, i *models.PutStateResourceInput) (*models.StateResource, error)
 
+	// GetActivityWorkers makes a GET request to /state-resources/{namespace}/{name}/workers
+	//
+	// 200: []models.ActivityWorker
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetActivityWorkers(ctx context.Context, i *models.GetActivityWorkersInput) ([]models.ActivityWorker, error)
+
+	// GetWorkflowDefinitionTemplates makes a GET request to /workflow-definition-templates
+	//
+	// 200: []models.WorkflowDefinitionTemplate
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error)
+
+	// GetWorkflowDefinitionTemplate makes a GET request to /workflow-definition-templates/{name}
+	//
+	// 200: *models.WorkflowDefinitionTemplate
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionTemplate(ctx context.Context, name string) (*models.WorkflowDefinitionTemplate, error)
+
+	// PutWorkflowDefinitionTemplate makes a PUT request to /workflow-definition-templates/{name}
+	//
+	// 201: *models.WorkflowDefinitionTemplate
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	PutWorkflowDefinitionTemplate(ctx context.Context, i *models.PutWorkflowDefinitionTemplateInput) (*models.WorkflowDefinitionTemplate, error)
+
+	// GetWorkflowDefinitionTemplateInstances makes a GET request to /workflow-definition-templates/{name}/instances
+	//
+	// 200: []models.WorkflowDefinition
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error)
+
+	// InstantiateWorkflowDefinitionTemplate makes a POST request to /workflow-definition-templates/{name}/instances
+	//
+	// 201: *models.WorkflowDefinition
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 409: *models.Conflict
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	InstantiateWorkflowDefinitionTemplate(ctx context.Context, i *models.InstantiateWorkflowDefinitionTemplateInput) (*models.WorkflowDefinition, error)
+
+	// RegenerateWorkflowDefinitionTemplateInstances makes a POST request to /workflow-definition-templates/{name}/regenerate
+	//
+	// 200: []models.WorkflowDefinition
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	RegenerateWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error)
+
 	// GetWorkflowDefinitions makes a GET request to /workflow-definitions
 	// Get the latest versions of all available WorkflowDefinitions
 	// 200: []models.WorkflowDefinition
 	// 400: *models.BadRequest
 	// 500: *models.InternalError
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
-	GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error)
+	GetWorkflowDefinitions(ctx context.Context, i *models.GetWorkflowDefinitionsInput) ([]models.WorkflowDefinition, error)
 
 	// NewWorkflowDefinition makes a POST request to /workflow-definitions
 	//
@@ -69,6 +218,22 @@ type Client interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	NewWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.WorkflowDefinition, error)
 
+	// ImportWorkflowDefinition makes a POST request to /workflow-definitions/import
+	//
+	// 201: *models.WorkflowDefinition
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ImportWorkflowDefinition(ctx context.Context, i *models.ImportWorkflowDefinitionRequest) (*models.WorkflowDefinition, error)
+
+	// LintWorkflowDefinition makes a POST request to /workflow-definitions/lint
+	//
+	// 200: *models.DefinitionLintResult
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	LintWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.DefinitionLintResult, error)
+
 	// GetWorkflowDefinitionVersionsByName makes a GET request to /workflow-definitions/{name}
 	//
 	// 200: []models.WorkflowDefinition
@@ -87,6 +252,126 @@ type Client interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	UpdateWorkflowDefinition(ctx context.Context, i *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error)
 
+	// GetWorkflowDefinitionAliases makes a GET request to /workflow-definitions/{name}/aliases
+	//
+	// 200: []models.WorkflowDefinitionAlias
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error)
+
+	// DeleteWorkflowDefinitionAlias makes a DELETE request to /workflow-definitions/{name}/aliases/{alias}
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error
+
+	// GetWorkflowDefinitionAlias makes a GET request to /workflow-definitions/{name}/aliases/{alias}
+	//
+	// 200: *models.WorkflowDefinitionAlias
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionAlias(ctx context.Context, i *models.GetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error)
+
+	// PutWorkflowDefinitionAlias makes a PUT request to /workflow-definitions/{name}/aliases/{alias}
+	//
+	// 201: *models.WorkflowDefinitionAlias
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 409: *models.Conflict
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	PutWorkflowDefinitionAlias(ctx context.Context, i *models.PutWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error)
+
+	// CancelWorkflowDefinitionRollout makes a DELETE request to /workflow-definitions/{name}/aliases/{alias}/rollout
+	//
+	// 200: *models.Rollout
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 409: *models.Conflict
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	CancelWorkflowDefinitionRollout(ctx context.Context, i *models.CancelWorkflowDefinitionRolloutInput) (*models.Rollout, error)
+
+	// GetWorkflowDefinitionRollout makes a GET request to /workflow-definitions/{name}/aliases/{alias}/rollout
+	//
+	// 200: *models.Rollout
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionRollout(ctx context.Context, i *models.GetWorkflowDefinitionRolloutInput) (*models.Rollout, error)
+
+	// StartWorkflowDefinitionRollout makes a POST request to /workflow-definitions/{name}/aliases/{alias}/rollout
+	//
+	// 201: *models.Rollout
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 409: *models.Conflict
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	StartWorkflowDefinitionRollout(ctx context.Context, i *models.StartWorkflowDefinitionRolloutInput) (*models.Rollout, error)
+
+	// UnarchiveWorkflowDefinition makes a DELETE request to /workflow-definitions/{name}/archive
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	UnarchiveWorkflowDefinition(ctx context.Context, name string) error
+
+	// ArchiveWorkflowDefinition makes a POST request to /workflow-definitions/{name}/archive
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ArchiveWorkflowDefinition(ctx context.Context, name string) error
+
+	// GetWorkflowDefinitionDiff makes a GET request to /workflow-definitions/{name}/diff
+	//
+	// 200: *models.WorkflowDefinitionDiff
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error)
+
+	// GetWorkflowDefinitionStateStats makes a GET request to /workflow-definitions/{name}/state-stats
+	//
+	// 200: *models.WorkflowDefinitionStateStats
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionStateStats(ctx context.Context, i *models.GetWorkflowDefinitionStateStatsInput) (*models.WorkflowDefinitionStateStats, error)
+
+	// GetWorkflowDefinitionStats makes a GET request to /workflow-definitions/{name}/stats
+	//
+	// 200: *models.WorkflowStats
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionStats(ctx context.Context, i *models.GetWorkflowDefinitionStatsInput) (*models.WorkflowStats, error)
+
+	// DeleteWorkflowDefinitionVersion makes a DELETE request to /workflow-definitions/{name}/{version}
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 409: *models.Conflict
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteWorkflowDefinitionVersion(ctx context.Context, i *models.DeleteWorkflowDefinitionVersionInput) error
+
 	// GetWorkflowDefinitionByNameAndVersion makes a GET request to /workflow-definitions/{name}/{version}
 	//
 	// 200: *models.WorkflowDefinition
@@ -96,6 +381,33 @@ type Client interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error)
 
+	// ExportWorkflowDefinition makes a GET request to /workflow-definitions/{name}/{version}/export
+	//
+	// 200: *models.StateMachineExport
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	ExportWorkflowDefinition(ctx context.Context, i *models.ExportWorkflowDefinitionInput) (*models.StateMachineExport, error)
+
+	// GetWorkflowDefinitionGraph makes a GET request to /workflow-definitions/{name}/{version}/graph
+	//
+	// 200: *models.StateMachineGraph
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.StateMachineGraph, error)
+
+	// SimulateWorkflowDefinition makes a POST request to /workflow-definitions/{name}/{version}/simulate
+	//
+	// 200: *models.SimulationResult
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	SimulateWorkflowDefinition(ctx context.Context, i *models.SimulateWorkflowDefinitionInput) (*models.SimulationResult, error)
+
 	// GetWorkflows makes a GET request to /workflows
 	//
 	// 200: []models.Workflow
@@ -143,6 +455,24 @@ type Client interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	ResumeWorkflowByID(ctx context.Context, i *models.ResumeWorkflowByIDInput) (*models.Workflow, error)
 
+	// GetWorkflowGraph makes a GET request to /workflows/{workflowID}/graph
+	//
+	// 200: *models.StateMachineGraph
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.StateMachineGraph, error)
+
+	// GetWorkflowJob makes a GET request to /workflows/{workflowID}/jobs/{jobID}
+	//
+	// 200: *models.Job
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowJob(ctx context.Context, i *models.GetWorkflowJobInput) (*models.Job, error)
+
 	// ResolveWorkflowByID makes a POST request to /workflows/{workflowID}/resolved
 	//
 	// 201: nil
@@ -154,6 +484,12 @@ type Client interface {
 	ResolveWorkflowByID(ctx context.Context, workflowID string) error
 }
 
+// GetStateResourcesIter defines the methods available on GetStateResources iterators.
+type GetStateResourcesIter interface {
+	Next(*models.StateResource) bool
+	Err() error
+}
+
 // GetWorkflowsIter defines the methods available on GetWorkflows iterators.
 type GetWorkflowsIter interface {
 	Next(*models.Workflow) bool
@@ -46,6 +46,147 @@ func (mr *MockClientMockRecorder) HealthCheck(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockClient)(nil).HealthCheck), ctx)
 }
 
+// GetNamespaceConfigs mocks base method
+func (m *MockClient) GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error) {
+	ret := m.ctrl.Call(m, "GetNamespaceConfigs", ctx)
+	ret0, _ := ret[0].([]models.NamespaceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceConfigs indicates an expected call of GetNamespaceConfigs
+func (mr *MockClientMockRecorder) GetNamespaceConfigs(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceConfigs", reflect.TypeOf((*MockClient)(nil).GetNamespaceConfigs), ctx)
+}
+
+// DeleteNamespaceConfig mocks base method
+func (m *MockClient) DeleteNamespaceConfig(ctx context.Context, namespace string) error {
+	ret := m.ctrl.Call(m, "DeleteNamespaceConfig", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespaceConfig indicates an expected call of DeleteNamespaceConfig
+func (mr *MockClientMockRecorder) DeleteNamespaceConfig(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespaceConfig", reflect.TypeOf((*MockClient)(nil).DeleteNamespaceConfig), ctx, namespace)
+}
+
+// GetNamespaceConfig mocks base method
+func (m *MockClient) GetNamespaceConfig(ctx context.Context, namespace string) (*models.NamespaceConfig, error) {
+	ret := m.ctrl.Call(m, "GetNamespaceConfig", ctx, namespace)
+	ret0, _ := ret[0].(*models.NamespaceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceConfig indicates an expected call of GetNamespaceConfig
+func (mr *MockClientMockRecorder) GetNamespaceConfig(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceConfig", reflect.TypeOf((*MockClient)(nil).GetNamespaceConfig), ctx, namespace)
+}
+
+// PutNamespaceConfig mocks base method
+func (m *MockClient) PutNamespaceConfig(ctx context.Context, i *models.PutNamespaceConfigInput) (*models.NamespaceConfig, error) {
+	ret := m.ctrl.Call(m, "PutNamespaceConfig", ctx, i)
+	ret0, _ := ret[0].(*models.NamespaceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutNamespaceConfig indicates an expected call of PutNamespaceConfig
+func (mr *MockClientMockRecorder) PutNamespaceConfig(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNamespaceConfig", reflect.TypeOf((*MockClient)(nil).PutNamespaceConfig), ctx, i)
+}
+
+// GetNamespaces mocks base method
+func (m *MockClient) GetNamespaces(ctx context.Context) ([]models.Namespace, error) {
+	ret := m.ctrl.Call(m, "GetNamespaces", ctx)
+	ret0, _ := ret[0].([]models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaces indicates an expected call of GetNamespaces
+func (mr *MockClientMockRecorder) GetNamespaces(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaces", reflect.TypeOf((*MockClient)(nil).GetNamespaces), ctx)
+}
+
+// DeleteNamespace mocks base method
+func (m *MockClient) DeleteNamespace(ctx context.Context, namespace string) error {
+	ret := m.ctrl.Call(m, "DeleteNamespace", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace
+func (mr *MockClientMockRecorder) DeleteNamespace(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockClient)(nil).DeleteNamespace), ctx, namespace)
+}
+
+// GetNamespace mocks base method
+func (m *MockClient) GetNamespace(ctx context.Context, namespace string) (*models.Namespace, error) {
+	ret := m.ctrl.Call(m, "GetNamespace", ctx, namespace)
+	ret0, _ := ret[0].(*models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespace indicates an expected call of GetNamespace
+func (mr *MockClientMockRecorder) GetNamespace(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockClient)(nil).GetNamespace), ctx, namespace)
+}
+
+// PutNamespace mocks base method
+func (m *MockClient) PutNamespace(ctx context.Context, i *models.PutNamespaceInput) (*models.Namespace, error) {
+	ret := m.ctrl.Call(m, "PutNamespace", ctx, i)
+	ret0, _ := ret[0].(*models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutNamespace indicates an expected call of PutNamespace
+func (mr *MockClientMockRecorder) PutNamespace(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNamespace", reflect.TypeOf((*MockClient)(nil).PutNamespace), ctx, i)
+}
+
+// GetStalledActivities mocks base method
+func (m *MockClient) GetStalledActivities(ctx context.Context, i *models.GetStalledActivitiesInput) ([]models.StalledActivity, error) {
+	ret := m.ctrl.Call(m, "GetStalledActivities", ctx, i)
+	ret0, _ := ret[0].([]models.StalledActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStalledActivities indicates an expected call of GetStalledActivities
+func (mr *MockClientMockRecorder) GetStalledActivities(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStalledActivities", reflect.TypeOf((*MockClient)(nil).GetStalledActivities), ctx, i)
+}
+
+// GetStateResources mocks base method
+func (m *MockClient) GetStateResources(ctx context.Context, i *models.GetStateResourcesInput) ([]models.StateResource, error) {
+	ret := m.ctrl.Call(m, "GetStateResources", ctx, i)
+	ret0, _ := ret[0].([]models.StateResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateResources indicates an expected call of GetStateResources
+func (mr *MockClientMockRecorder) GetStateResources(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateResources", reflect.TypeOf((*MockClient)(nil).GetStateResources), ctx, i)
+}
+
+// NewGetStateResourcesIter mocks base method
+func (m *MockClient) NewGetStateResourcesIter(ctx context.Context, i *models.GetStateResourcesInput) (GetStateResourcesIter, error) {
+	ret := m.ctrl.Call(m, "NewGetStateResourcesIter", ctx, i)
+	ret0, _ := ret[0].(GetStateResourcesIter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewGetStateResourcesIter indicates an expected call of NewGetStateResourcesIter
+func (mr *MockClientMockRecorder) NewGetStateResourcesIter(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGetStateResourcesIter", reflect.TypeOf((*MockClient)(nil).NewGetStateResourcesIter), ctx, i)
+}
+
 // PostStateResource mocks base method
 func (m *MockClient) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
 	ret := m.ctrl.Call(m, "PostStateResource", ctx, i)
@@ -97,17 +238,108 @@ func (mr *MockClientMockRecorder) PutStateResource(ctx, i interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStateResource", reflect.TypeOf((*MockClient)(nil).PutStateResource), ctx, i)
 }
 
+// GetActivityWorkers mocks base method
+func (m *MockClient) GetActivityWorkers(ctx context.Context, i *models.GetActivityWorkersInput) ([]models.ActivityWorker, error) {
+	ret := m.ctrl.Call(m, "GetActivityWorkers", ctx, i)
+	ret0, _ := ret[0].([]models.ActivityWorker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityWorkers indicates an expected call of GetActivityWorkers
+func (mr *MockClientMockRecorder) GetActivityWorkers(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityWorkers", reflect.TypeOf((*MockClient)(nil).GetActivityWorkers), ctx, i)
+}
+
+// GetWorkflowDefinitionTemplates mocks base method
+func (m *MockClient) GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionTemplates", ctx)
+	ret0, _ := ret[0].([]models.WorkflowDefinitionTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionTemplates indicates an expected call of GetWorkflowDefinitionTemplates
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionTemplates(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionTemplates", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionTemplates), ctx)
+}
+
+// GetWorkflowDefinitionTemplate mocks base method
+func (m *MockClient) GetWorkflowDefinitionTemplate(ctx context.Context, name string) (*models.WorkflowDefinitionTemplate, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionTemplate", ctx, name)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionTemplate indicates an expected call of GetWorkflowDefinitionTemplate
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionTemplate(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionTemplate", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionTemplate), ctx, name)
+}
+
+// PutWorkflowDefinitionTemplate mocks base method
+func (m *MockClient) PutWorkflowDefinitionTemplate(ctx context.Context, i *models.PutWorkflowDefinitionTemplateInput) (*models.WorkflowDefinitionTemplate, error) {
+	ret := m.ctrl.Call(m, "PutWorkflowDefinitionTemplate", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWorkflowDefinitionTemplate indicates an expected call of PutWorkflowDefinitionTemplate
+func (mr *MockClientMockRecorder) PutWorkflowDefinitionTemplate(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWorkflowDefinitionTemplate", reflect.TypeOf((*MockClient)(nil).PutWorkflowDefinitionTemplate), ctx, i)
+}
+
+// GetWorkflowDefinitionTemplateInstances mocks base method
+func (m *MockClient) GetWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionTemplateInstances", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionTemplateInstances indicates an expected call of GetWorkflowDefinitionTemplateInstances
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionTemplateInstances(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionTemplateInstances", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionTemplateInstances), ctx, name)
+}
+
+// InstantiateWorkflowDefinitionTemplate mocks base method
+func (m *MockClient) InstantiateWorkflowDefinitionTemplate(ctx context.Context, i *models.InstantiateWorkflowDefinitionTemplateInput) (*models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "InstantiateWorkflowDefinitionTemplate", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateWorkflowDefinitionTemplate indicates an expected call of InstantiateWorkflowDefinitionTemplate
+func (mr *MockClientMockRecorder) InstantiateWorkflowDefinitionTemplate(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateWorkflowDefinitionTemplate", reflect.TypeOf((*MockClient)(nil).InstantiateWorkflowDefinitionTemplate), ctx, i)
+}
+
+// RegenerateWorkflowDefinitionTemplateInstances mocks base method
+func (m *MockClient) RegenerateWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "RegenerateWorkflowDefinitionTemplateInstances", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateWorkflowDefinitionTemplateInstances indicates an expected call of RegenerateWorkflowDefinitionTemplateInstances
+func (mr *MockClientMockRecorder) RegenerateWorkflowDefinitionTemplateInstances(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateWorkflowDefinitionTemplateInstances", reflect.TypeOf((*MockClient)(nil).RegenerateWorkflowDefinitionTemplateInstances), ctx, name)
+}
+
 // GetWorkflowDefinitions mocks base method
-func (m *MockClient) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
-	ret := m.ctrl.Call(m, "GetWorkflowDefinitions", ctx)
+func (m *MockClient) GetWorkflowDefinitions(ctx context.Context, i *models.GetWorkflowDefinitionsInput) ([]models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitions", ctx, i)
 	ret0, _ := ret[0].([]models.WorkflowDefinition)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetWorkflowDefinitions indicates an expected call of GetWorkflowDefinitions
-func (mr *MockClientMockRecorder) GetWorkflowDefinitions(ctx interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitions", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitions), ctx)
+func (mr *MockClientMockRecorder) GetWorkflowDefinitions(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitions", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitions), ctx, i)
 }
 
 // NewWorkflowDefinition mocks base method
@@ -123,6 +355,32 @@ func (mr *MockClientMockRecorder) NewWorkflowDefinition(ctx, i interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).NewWorkflowDefinition), ctx, i)
 }
 
+// ImportWorkflowDefinition mocks base method
+func (m *MockClient) ImportWorkflowDefinition(ctx context.Context, i *models.ImportWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "ImportWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowDefinition indicates an expected call of ImportWorkflowDefinition
+func (mr *MockClientMockRecorder) ImportWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).ImportWorkflowDefinition), ctx, i)
+}
+
+// LintWorkflowDefinition mocks base method
+func (m *MockClient) LintWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.DefinitionLintResult, error) {
+	ret := m.ctrl.Call(m, "LintWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.DefinitionLintResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LintWorkflowDefinition indicates an expected call of LintWorkflowDefinition
+func (mr *MockClientMockRecorder) LintWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LintWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).LintWorkflowDefinition), ctx, i)
+}
+
 // GetWorkflowDefinitionVersionsByName mocks base method
 func (m *MockClient) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *models.GetWorkflowDefinitionVersionsByNameInput) ([]models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitionVersionsByName", ctx, i)
@@ -149,6 +407,171 @@ func (mr *MockClientMockRecorder) UpdateWorkflowDefinition(ctx, i interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowDefinition), ctx, i)
 }
 
+// GetWorkflowDefinitionAliases mocks base method
+func (m *MockClient) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionAliases", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionAliases indicates an expected call of GetWorkflowDefinitionAliases
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionAliases(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionAliases", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionAliases), ctx, name)
+}
+
+// DeleteWorkflowDefinitionAlias mocks base method
+func (m *MockClient) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionAlias indicates an expected call of DeleteWorkflowDefinitionAlias
+func (mr *MockClientMockRecorder) DeleteWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionAlias", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowDefinitionAlias), ctx, i)
+}
+
+// GetWorkflowDefinitionAlias mocks base method
+func (m *MockClient) GetWorkflowDefinitionAlias(ctx context.Context, i *models.GetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionAlias indicates an expected call of GetWorkflowDefinitionAlias
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionAlias", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionAlias), ctx, i)
+}
+
+// PutWorkflowDefinitionAlias mocks base method
+func (m *MockClient) PutWorkflowDefinitionAlias(ctx context.Context, i *models.PutWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "PutWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWorkflowDefinitionAlias indicates an expected call of PutWorkflowDefinitionAlias
+func (mr *MockClientMockRecorder) PutWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWorkflowDefinitionAlias", reflect.TypeOf((*MockClient)(nil).PutWorkflowDefinitionAlias), ctx, i)
+}
+
+// CancelWorkflowDefinitionRollout mocks base method
+func (m *MockClient) CancelWorkflowDefinitionRollout(ctx context.Context, i *models.CancelWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	ret := m.ctrl.Call(m, "CancelWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.Rollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelWorkflowDefinitionRollout indicates an expected call of CancelWorkflowDefinitionRollout
+func (mr *MockClientMockRecorder) CancelWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelWorkflowDefinitionRollout", reflect.TypeOf((*MockClient)(nil).CancelWorkflowDefinitionRollout), ctx, i)
+}
+
+// GetWorkflowDefinitionRollout mocks base method
+func (m *MockClient) GetWorkflowDefinitionRollout(ctx context.Context, i *models.GetWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.Rollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionRollout indicates an expected call of GetWorkflowDefinitionRollout
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionRollout", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionRollout), ctx, i)
+}
+
+// StartWorkflowDefinitionRollout mocks base method
+func (m *MockClient) StartWorkflowDefinitionRollout(ctx context.Context, i *models.StartWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	ret := m.ctrl.Call(m, "StartWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.Rollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkflowDefinitionRollout indicates an expected call of StartWorkflowDefinitionRollout
+func (mr *MockClientMockRecorder) StartWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowDefinitionRollout", reflect.TypeOf((*MockClient)(nil).StartWorkflowDefinitionRollout), ctx, i)
+}
+
+// UnarchiveWorkflowDefinition mocks base method
+func (m *MockClient) UnarchiveWorkflowDefinition(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "UnarchiveWorkflowDefinition", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveWorkflowDefinition indicates an expected call of UnarchiveWorkflowDefinition
+func (mr *MockClientMockRecorder) UnarchiveWorkflowDefinition(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).UnarchiveWorkflowDefinition), ctx, name)
+}
+
+// ArchiveWorkflowDefinition mocks base method
+func (m *MockClient) ArchiveWorkflowDefinition(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "ArchiveWorkflowDefinition", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveWorkflowDefinition indicates an expected call of ArchiveWorkflowDefinition
+func (mr *MockClientMockRecorder) ArchiveWorkflowDefinition(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).ArchiveWorkflowDefinition), ctx, name)
+}
+
+// GetWorkflowDefinitionDiff mocks base method
+func (m *MockClient) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionDiff", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionDiff indicates an expected call of GetWorkflowDefinitionDiff
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionDiff(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionDiff", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionDiff), ctx, i)
+}
+
+// GetWorkflowDefinitionStateStats mocks base method
+func (m *MockClient) GetWorkflowDefinitionStateStats(ctx context.Context, i *models.GetWorkflowDefinitionStateStatsInput) (*models.WorkflowDefinitionStateStats, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionStateStats", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionStateStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionStateStats indicates an expected call of GetWorkflowDefinitionStateStats
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionStateStats(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionStateStats", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionStateStats), ctx, i)
+}
+
+// GetWorkflowDefinitionStats mocks base method
+func (m *MockClient) GetWorkflowDefinitionStats(ctx context.Context, i *models.GetWorkflowDefinitionStatsInput) (*models.WorkflowStats, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionStats", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionStats indicates an expected call of GetWorkflowDefinitionStats
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionStats(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionStats", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionStats), ctx, i)
+}
+
+// DeleteWorkflowDefinitionVersion mocks base method
+func (m *MockClient) DeleteWorkflowDefinitionVersion(ctx context.Context, i *models.DeleteWorkflowDefinitionVersionInput) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionVersion", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionVersion indicates an expected call of DeleteWorkflowDefinitionVersion
+func (mr *MockClientMockRecorder) DeleteWorkflowDefinitionVersion(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionVersion", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowDefinitionVersion), ctx, i)
+}
+
 // GetWorkflowDefinitionByNameAndVersion mocks base method
 func (m *MockClient) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitionByNameAndVersion", ctx, i)
@@ -162,6 +585,45 @@ func (mr *MockClientMockRecorder) GetWorkflowDefinitionByNameAndVersion(ctx, i i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionByNameAndVersion", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionByNameAndVersion), ctx, i)
 }
 
+// ExportWorkflowDefinition mocks base method
+func (m *MockClient) ExportWorkflowDefinition(ctx context.Context, i *models.ExportWorkflowDefinitionInput) (*models.StateMachineExport, error) {
+	ret := m.ctrl.Call(m, "ExportWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.StateMachineExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowDefinition indicates an expected call of ExportWorkflowDefinition
+func (mr *MockClientMockRecorder) ExportWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).ExportWorkflowDefinition), ctx, i)
+}
+
+// GetWorkflowDefinitionGraph mocks base method
+func (m *MockClient) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.StateMachineGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionGraph", ctx, i)
+	ret0, _ := ret[0].(*models.StateMachineGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionGraph indicates an expected call of GetWorkflowDefinitionGraph
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionGraph", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionGraph), ctx, i)
+}
+
+// SimulateWorkflowDefinition mocks base method
+func (m *MockClient) SimulateWorkflowDefinition(ctx context.Context, i *models.SimulateWorkflowDefinitionInput) (*models.SimulationResult, error) {
+	ret := m.ctrl.Call(m, "SimulateWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.SimulationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateWorkflowDefinition indicates an expected call of SimulateWorkflowDefinition
+func (mr *MockClientMockRecorder) SimulateWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).SimulateWorkflowDefinition), ctx, i)
+}
+
 // GetWorkflows mocks base method
 func (m *MockClient) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, error) {
 	ret := m.ctrl.Call(m, "GetWorkflows", ctx, i)
@@ -239,6 +701,32 @@ func (mr *MockClientMockRecorder) ResumeWorkflowByID(ctx, i interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowByID", reflect.TypeOf((*MockClient)(nil).ResumeWorkflowByID), ctx, i)
 }
 
+// GetWorkflowGraph mocks base method
+func (m *MockClient) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.StateMachineGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowGraph", ctx, i)
+	ret0, _ := ret[0].(*models.StateMachineGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowGraph indicates an expected call of GetWorkflowGraph
+func (mr *MockClientMockRecorder) GetWorkflowGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowGraph", reflect.TypeOf((*MockClient)(nil).GetWorkflowGraph), ctx, i)
+}
+
+// GetWorkflowJob mocks base method
+func (m *MockClient) GetWorkflowJob(ctx context.Context, i *models.GetWorkflowJobInput) (*models.Job, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowJob", ctx, i)
+	ret0, _ := ret[0].(*models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowJob indicates an expected call of GetWorkflowJob
+func (mr *MockClientMockRecorder) GetWorkflowJob(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowJob", reflect.TypeOf((*MockClient)(nil).GetWorkflowJob), ctx, i)
+}
+
 // ResolveWorkflowByID mocks base method
 func (m *MockClient) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
 	ret := m.ctrl.Call(m, "ResolveWorkflowByID", ctx, workflowID)
@@ -251,6 +739,53 @@ func (mr *MockClientMockRecorder) ResolveWorkflowByID(ctx, workflowID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWorkflowByID", reflect.TypeOf((*MockClient)(nil).ResolveWorkflowByID), ctx, workflowID)
 }
 
+// MockGetStateResourcesIter is a mock of GetStateResourcesIter interface
+type MockGetStateResourcesIter struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetStateResourcesIterMockRecorder
+}
+
+// MockGetStateResourcesIterMockRecorder is the mock recorder for MockGetStateResourcesIter
+type MockGetStateResourcesIterMockRecorder struct {
+	mock *MockGetStateResourcesIter
+}
+
+// NewMockGetStateResourcesIter creates a new mock instance
+func NewMockGetStateResourcesIter(ctrl *gomock.Controller) *MockGetStateResourcesIter {
+	mock := &MockGetStateResourcesIter{ctrl: ctrl}
+	mock.recorder = &MockGetStateResourcesIterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetStateResourcesIter) EXPECT() *MockGetStateResourcesIterMockRecorder {
+	return m.recorder
+}
+
+// Next mocks base method
+func (m *MockGetStateResourcesIter) Next(arg0 *models.StateResource) bool {
+	ret := m.ctrl.Call(m, "Next", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Next indicates an expected call of Next
+func (mr *MockGetStateResourcesIterMockRecorder) Next(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockGetStateResourcesIter)(nil).Next), arg0)
+}
+
+// Err mocks base method
+func (m *MockGetStateResourcesIter) Err() error {
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err
+func (mr *MockGetStateResourcesIterMockRecorder) Err() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockGetStateResourcesIter)(nil).Err))
+}
+
 // MockGetWorkflowsIter is a mock of GetWorkflowsIter interface
 type MockGetWorkflowsIter struct {
 	ctrl     *gomock.Controller
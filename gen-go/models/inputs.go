@@ -38,32 +38,1084 @@ func (i HealthCheckInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetNamespaceConfigsInput holds the input parameters for a getNamespaceConfigs operation.
+type GetNamespaceConfigsInput struct {
+}
+
+// Validate returns an error if any of the GetNamespaceConfigsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetNamespaceConfigsInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetNamespaceConfigsInput) Path() (string, error) {
+	path := "/namespace-configs"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// DeleteNamespaceConfigInput holds the input parameters for a deleteNamespaceConfig operation.
+type DeleteNamespaceConfigInput struct {
+	Namespace string
+}
+
+// ValidateDeleteNamespaceConfigInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateDeleteNamespaceConfigInput(namespace string) error {
+
+	return nil
+}
+
+// DeleteNamespaceConfigInputPath returns the URI path for the input.
+func DeleteNamespaceConfigInputPath(namespace string) (string, error) {
+	path := "/namespace-configs/{namespace}"
+	urlVals := url.Values{}
+
+	pathnamespace := namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetNamespaceConfigInput holds the input parameters for a getNamespaceConfig operation.
+type GetNamespaceConfigInput struct {
+	Namespace string
+}
+
+// ValidateGetNamespaceConfigInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetNamespaceConfigInput(namespace string) error {
+
+	return nil
+}
+
+// GetNamespaceConfigInputPath returns the URI path for the input.
+func GetNamespaceConfigInputPath(namespace string) (string, error) {
+	path := "/namespace-configs/{namespace}"
+	urlVals := url.Values{}
+
+	pathnamespace := namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// PutNamespaceConfigInput holds the input parameters for a putNamespaceConfig operation.
+type PutNamespaceConfigInput struct {
+	Namespace       string
+	NamespaceConfig *NamespaceConfig
+}
+
+// Validate returns an error if any of the PutNamespaceConfigInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i PutNamespaceConfigInput) Validate() error {
+
+	if err := i.NamespaceConfig.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i PutNamespaceConfigInput) Path() (string, error) {
+	path := "/namespace-configs/{namespace}"
+	urlVals := url.Values{}
+
+	pathnamespace := i.Namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetNamespacesInput holds the input parameters for a getNamespaces operation.
+type GetNamespacesInput struct {
+}
+
+// Validate returns an error if any of the GetNamespacesInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetNamespacesInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetNamespacesInput) Path() (string, error) {
+	path := "/namespaces"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// DeleteNamespaceInput holds the input parameters for a deleteNamespace operation.
+type DeleteNamespaceInput struct {
+	Namespace string
+}
+
+// ValidateDeleteNamespaceInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateDeleteNamespaceInput(namespace string) error {
+
+	return nil
+}
+
+// DeleteNamespaceInputPath returns the URI path for the input.
+func DeleteNamespaceInputPath(namespace string) (string, error) {
+	path := "/namespaces/{namespace}"
+	urlVals := url.Values{}
+
+	pathnamespace := namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetNamespaceInput holds the input parameters for a getNamespace operation.
+type GetNamespaceInput struct {
+	Namespace string
+}
+
+// ValidateGetNamespaceInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetNamespaceInput(namespace string) error {
+
+	return nil
+}
+
+// GetNamespaceInputPath returns the URI path for the input.
+func GetNamespaceInputPath(namespace string) (string, error) {
+	path := "/namespaces/{namespace}"
+	urlVals := url.Values{}
+
+	pathnamespace := namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// PutNamespaceInput holds the input parameters for a putNamespace operation.
+type PutNamespaceInput struct {
+	Namespace           string
+	RegisteredNamespace *Namespace
+}
+
+// Validate returns an error if any of the PutNamespaceInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i PutNamespaceInput) Validate() error {
+
+	if err := i.RegisteredNamespace.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i PutNamespaceInput) Path() (string, error) {
+	path := "/namespaces/{namespace}"
+	urlVals := url.Values{}
+
+	pathnamespace := i.Namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetStalledActivitiesInput holds the input parameters for a getStalledActivities operation.
+type GetStalledActivitiesInput struct {
+	Namespace     *string
+	WorkerTimeout *int64
+}
+
+// Validate returns an error if any of the GetStalledActivitiesInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetStalledActivitiesInput) Validate() error {
+
+	if i.WorkerTimeout != nil {
+		if err := validate.MinimumInt("workerTimeout", "query", *i.WorkerTimeout, int64(1), false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetStalledActivitiesInput) Path() (string, error) {
+	path := "/stalled-activities"
+	urlVals := url.Values{}
+
+	if i.Namespace != nil {
+		urlVals.Add("namespace", *i.Namespace)
+	}
+
+	if i.WorkerTimeout != nil {
+		urlVals.Add("workerTimeout", strconv.FormatInt(*i.WorkerTimeout, 10))
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetStateResourcesInput holds the input parameters for a getStateResources operation.
+type GetStateResourcesInput struct {
+	Namespace *string
+	Limit     *int64
+	PageToken *string
+}
+
+// Validate returns an error if any of the GetStateResourcesInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetStateResourcesInput) Validate() error {
+
+	if i.Limit != nil {
+		if err := validate.MaximumInt("limit", "query", *i.Limit, int64(1000), false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetStateResourcesInput) Path() (string, error) {
+	path := "/state-resources"
+	urlVals := url.Values{}
+
+	if i.Namespace != nil {
+		urlVals.Add("namespace", *i.Namespace)
+	}
+
+	if i.Limit != nil {
+		urlVals.Add("limit", strconv.FormatInt(*i.Limit, 10))
+	}
+
+	if i.PageToken != nil {
+		urlVals.Add("pageToken", *i.PageToken)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // DeleteStateResourceInput holds the input parameters for a deleteStateResource operation.
 type DeleteStateResourceInput struct {
+	Namespace      string
+	Name           string
+	DeleteActivity *bool
+}
+
+// Validate returns an error if any of the DeleteStateResourceInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i DeleteStateResourceInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i DeleteStateResourceInput) Path() (string, error) {
+	path := "/state-resources/{namespace}/{name}"
+	urlVals := url.Values{}
+
+	pathnamespace := i.Namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	if i.DeleteActivity != nil {
+		urlVals.Add("deleteActivity", strconv.FormatBool(*i.DeleteActivity))
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetStateResourceInput holds the input parameters for a getStateResource operation.
+type GetStateResourceInput struct {
 	Namespace string
 	Name      string
 }
 
-// Validate returns an error if any of the DeleteStateResourceInput parameters don't satisfy the
-// requirements from the swagger yml file.
-func (i DeleteStateResourceInput) Validate() error {
+// Validate returns an error if any of the GetStateResourceInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetStateResourceInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetStateResourceInput) Path() (string, error) {
+	path := "/state-resources/{namespace}/{name}"
+	urlVals := url.Values{}
+
+	pathnamespace := i.Namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// PutStateResourceInput holds the input parameters for a putStateResource operation.
+type PutStateResourceInput struct {
+	Namespace        string
+	Name             string
+	NewStateResource *NewStateResource
+}
+
+// Validate returns an error if any of the PutStateResourceInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i PutStateResourceInput) Validate() error {
+
+	if err := i.NewStateResource.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i PutStateResourceInput) Path() (string, error) {
+	path := "/state-resources/{namespace}/{name}"
+	urlVals := url.Values{}
+
+	pathnamespace := i.Namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetActivityWorkersInput holds the input parameters for a getActivityWorkers operation.
+type GetActivityWorkersInput struct {
+	Namespace string
+	Name      string
+}
+
+// Validate returns an error if any of the GetActivityWorkersInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetActivityWorkersInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetActivityWorkersInput) Path() (string, error) {
+	path := "/state-resources/{namespace}/{name}/workers"
+	urlVals := url.Values{}
+
+	pathnamespace := i.Namespace
+	if pathnamespace == "" {
+		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionTemplatesInput holds the input parameters for a getWorkflowDefinitionTemplates operation.
+type GetWorkflowDefinitionTemplatesInput struct {
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionTemplatesInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionTemplatesInput) Validate() error {
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionTemplatesInput) Path() (string, error) {
+	path := "/workflow-definition-templates"
+	urlVals := url.Values{}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionTemplateInput holds the input parameters for a getWorkflowDefinitionTemplate operation.
+type GetWorkflowDefinitionTemplateInput struct {
+	Name string
+}
+
+// ValidateGetWorkflowDefinitionTemplateInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowDefinitionTemplateInput(name string) error {
+
+	return nil
+}
+
+// GetWorkflowDefinitionTemplateInputPath returns the URI path for the input.
+func GetWorkflowDefinitionTemplateInputPath(name string) (string, error) {
+	path := "/workflow-definition-templates/{name}"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// PutWorkflowDefinitionTemplateInput holds the input parameters for a putWorkflowDefinitionTemplate operation.
+type PutWorkflowDefinitionTemplateInput struct {
+	Name                       string
+	WorkflowDefinitionTemplate *WorkflowDefinitionTemplate
+}
+
+// Validate returns an error if any of the PutWorkflowDefinitionTemplateInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i PutWorkflowDefinitionTemplateInput) Validate() error {
+
+	if err := i.WorkflowDefinitionTemplate.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i PutWorkflowDefinitionTemplateInput) Path() (string, error) {
+	path := "/workflow-definition-templates/{name}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionTemplateInstancesInput holds the input parameters for a getWorkflowDefinitionTemplateInstances operation.
+type GetWorkflowDefinitionTemplateInstancesInput struct {
+	Name string
+}
+
+// ValidateGetWorkflowDefinitionTemplateInstancesInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowDefinitionTemplateInstancesInput(name string) error {
+
+	return nil
+}
+
+// GetWorkflowDefinitionTemplateInstancesInputPath returns the URI path for the input.
+func GetWorkflowDefinitionTemplateInstancesInputPath(name string) (string, error) {
+	path := "/workflow-definition-templates/{name}/instances"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// InstantiateWorkflowDefinitionTemplateInput holds the input parameters for a instantiateWorkflowDefinitionTemplate operation.
+type InstantiateWorkflowDefinitionTemplateInput struct {
+	Name                    string
+	TemplateInstanceRequest *TemplateInstanceRequest
+}
+
+// Validate returns an error if any of the InstantiateWorkflowDefinitionTemplateInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i InstantiateWorkflowDefinitionTemplateInput) Validate() error {
+
+	if err := i.TemplateInstanceRequest.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i InstantiateWorkflowDefinitionTemplateInput) Path() (string, error) {
+	path := "/workflow-definition-templates/{name}/instances"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// RegenerateWorkflowDefinitionTemplateInstancesInput holds the input parameters for a regenerateWorkflowDefinitionTemplateInstances operation.
+type RegenerateWorkflowDefinitionTemplateInstancesInput struct {
+	Name string
+}
+
+// ValidateRegenerateWorkflowDefinitionTemplateInstancesInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateRegenerateWorkflowDefinitionTemplateInstancesInput(name string) error {
+
+	return nil
+}
+
+// RegenerateWorkflowDefinitionTemplateInstancesInputPath returns the URI path for the input.
+func RegenerateWorkflowDefinitionTemplateInstancesInputPath(name string) (string, error) {
+	path := "/workflow-definition-templates/{name}/regenerate"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionsInput holds the input parameters for a getWorkflowDefinitions operation.
+type GetWorkflowDefinitionsInput struct {
+	Team   *string
+	Label  *string
+	Search *string
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionsInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionsInput) Path() (string, error) {
+	path := "/workflow-definitions"
+	urlVals := url.Values{}
+
+	if i.Team != nil {
+		urlVals.Add("team", *i.Team)
+	}
+
+	if i.Label != nil {
+		urlVals.Add("label", *i.Label)
+	}
+
+	if i.Search != nil {
+		urlVals.Add("search", *i.Search)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionVersionsByNameInput holds the input parameters for a getWorkflowDefinitionVersionsByName operation.
+type GetWorkflowDefinitionVersionsByNameInput struct {
+	Name   string
+	Latest *bool
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionVersionsByNameInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionVersionsByNameInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionVersionsByNameInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	if i.Latest != nil {
+		urlVals.Add("latest", strconv.FormatBool(*i.Latest))
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// UpdateWorkflowDefinitionInput holds the input parameters for a updateWorkflowDefinition operation.
+type UpdateWorkflowDefinitionInput struct {
+	NewWorkflowDefinitionRequest *NewWorkflowDefinitionRequest
+	Name                         string
+}
+
+// Validate returns an error if any of the UpdateWorkflowDefinitionInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i UpdateWorkflowDefinitionInput) Validate() error {
+
+	if err := i.NewWorkflowDefinitionRequest.Validate(nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i UpdateWorkflowDefinitionInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionAliasesInput holds the input parameters for a getWorkflowDefinitionAliases operation.
+type GetWorkflowDefinitionAliasesInput struct {
+	Name string
+}
+
+// ValidateGetWorkflowDefinitionAliasesInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowDefinitionAliasesInput(name string) error {
+
+	return nil
+}
+
+// GetWorkflowDefinitionAliasesInputPath returns the URI path for the input.
+func GetWorkflowDefinitionAliasesInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/aliases"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// DeleteWorkflowDefinitionAliasInput holds the input parameters for a deleteWorkflowDefinitionAlias operation.
+type DeleteWorkflowDefinitionAliasInput struct {
+	Name  string
+	Alias string
+}
+
+// Validate returns an error if any of the DeleteWorkflowDefinitionAliasInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i DeleteWorkflowDefinitionAliasInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i DeleteWorkflowDefinitionAliasInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionAliasInput holds the input parameters for a getWorkflowDefinitionAlias operation.
+type GetWorkflowDefinitionAliasInput struct {
+	Name  string
+	Alias string
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionAliasInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionAliasInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionAliasInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// PutWorkflowDefinitionAliasInput holds the input parameters for a putWorkflowDefinitionAlias operation.
+type PutWorkflowDefinitionAliasInput struct {
+	Name                    string
+	Alias                   string
+	WorkflowDefinitionAlias *WorkflowDefinitionAlias
+}
+
+// Validate returns an error if any of the PutWorkflowDefinitionAliasInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i PutWorkflowDefinitionAliasInput) Validate() error {
+
+	if err := i.WorkflowDefinitionAlias.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i PutWorkflowDefinitionAliasInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// CancelWorkflowDefinitionRolloutInput holds the input parameters for a cancelWorkflowDefinitionRollout operation.
+type CancelWorkflowDefinitionRolloutInput struct {
+	Name  string
+	Alias string
+}
+
+// Validate returns an error if any of the CancelWorkflowDefinitionRolloutInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i CancelWorkflowDefinitionRolloutInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i CancelWorkflowDefinitionRolloutInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}/rollout"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionRolloutInput holds the input parameters for a getWorkflowDefinitionRollout operation.
+type GetWorkflowDefinitionRolloutInput struct {
+	Name  string
+	Alias string
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionRolloutInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionRolloutInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionRolloutInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}/rollout"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// StartWorkflowDefinitionRolloutInput holds the input parameters for a startWorkflowDefinitionRollout operation.
+type StartWorkflowDefinitionRolloutInput struct {
+	Name           string
+	Alias          string
+	RolloutRequest *RolloutRequest
+}
+
+// Validate returns an error if any of the StartWorkflowDefinitionRolloutInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i StartWorkflowDefinitionRolloutInput) Validate() error {
+
+	if err := i.RolloutRequest.Validate(nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i StartWorkflowDefinitionRolloutInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}/rollout"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// UnarchiveWorkflowDefinitionInput holds the input parameters for a unarchiveWorkflowDefinition operation.
+type UnarchiveWorkflowDefinitionInput struct {
+	Name string
+}
+
+// ValidateUnarchiveWorkflowDefinitionInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateUnarchiveWorkflowDefinitionInput(name string) error {
+
+	return nil
+}
+
+// UnarchiveWorkflowDefinitionInputPath returns the URI path for the input.
+func UnarchiveWorkflowDefinitionInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/archive"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// ArchiveWorkflowDefinitionInput holds the input parameters for a archiveWorkflowDefinition operation.
+type ArchiveWorkflowDefinitionInput struct {
+	Name string
+}
+
+// ValidateArchiveWorkflowDefinitionInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateArchiveWorkflowDefinitionInput(name string) error {
 
 	return nil
 }
 
-// Path returns the URI path for the input.
-func (i DeleteStateResourceInput) Path() (string, error) {
-	path := "/state-resources/{namespace}/{name}"
+// ArchiveWorkflowDefinitionInputPath returns the URI path for the input.
+func ArchiveWorkflowDefinitionInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/archive"
 	urlVals := url.Values{}
 
-	pathnamespace := i.Namespace
-	if pathnamespace == "" {
-		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
 		if err != nil {
 			return "", err
 		}
 	}
-	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionDiffInput holds the input parameters for a getWorkflowDefinitionDiff operation.
+type GetWorkflowDefinitionDiffInput struct {
+	Name string
+	From int64
+	To   int64
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionDiffInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionDiffInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionDiffInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/diff"
+	urlVals := url.Values{}
 
 	pathname := i.Name
 	if pathname == "" {
@@ -74,35 +1126,70 @@ func (i DeleteStateResourceInput) Path() (string, error) {
 	}
 	path = strings.Replace(path, "{name}", pathname, -1)
 
+	urlVals.Add("from", strconv.FormatInt(i.From, 10))
+
+	urlVals.Add("to", strconv.FormatInt(i.To, 10))
+
 	return path + "?" + urlVals.Encode(), nil
 }
 
-// GetStateResourceInput holds the input parameters for a getStateResource operation.
-type GetStateResourceInput struct {
-	Namespace string
-	Name      string
+// GetWorkflowDefinitionStateStatsInput holds the input parameters for a getWorkflowDefinitionStateStats operation.
+type GetWorkflowDefinitionStateStatsInput struct {
+	Name          string
+	CreatedAfter  *strfmt.DateTime
+	CreatedBefore *strfmt.DateTime
 }
 
-// Validate returns an error if any of the GetStateResourceInput parameters don't satisfy the
+// Validate returns an error if any of the GetWorkflowDefinitionStateStatsInput parameters don't satisfy the
 // requirements from the swagger yml file.
-func (i GetStateResourceInput) Validate() error {
+func (i GetWorkflowDefinitionStateStatsInput) Validate() error {
 
 	return nil
 }
 
 // Path returns the URI path for the input.
-func (i GetStateResourceInput) Path() (string, error) {
-	path := "/state-resources/{namespace}/{name}"
+func (i GetWorkflowDefinitionStateStatsInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/state-stats"
 	urlVals := url.Values{}
 
-	pathnamespace := i.Namespace
-	if pathnamespace == "" {
-		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
 		if err != nil {
 			return "", err
 		}
 	}
-	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	if i.CreatedAfter != nil {
+		urlVals.Add("createdAfter", (*i.CreatedAfter).String())
+	}
+
+	if i.CreatedBefore != nil {
+		urlVals.Add("createdBefore", (*i.CreatedBefore).String())
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionStatsInput holds the input parameters for a getWorkflowDefinitionStats operation.
+type GetWorkflowDefinitionStatsInput struct {
+	Name          string
+	CreatedAfter  *strfmt.DateTime
+	CreatedBefore *strfmt.DateTime
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionStatsInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionStatsInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionStatsInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/stats"
+	urlVals := url.Values{}
 
 	pathname := i.Name
 	if pathname == "" {
@@ -113,86 +1200,112 @@ func (i GetStateResourceInput) Path() (string, error) {
 	}
 	path = strings.Replace(path, "{name}", pathname, -1)
 
+	if i.CreatedAfter != nil {
+		urlVals.Add("createdAfter", (*i.CreatedAfter).String())
+	}
+
+	if i.CreatedBefore != nil {
+		urlVals.Add("createdBefore", (*i.CreatedBefore).String())
+	}
+
 	return path + "?" + urlVals.Encode(), nil
 }
 
-// PutStateResourceInput holds the input parameters for a putStateResource operation.
-type PutStateResourceInput struct {
-	Namespace        string
-	Name             string
-	NewStateResource *NewStateResource
+// DeleteWorkflowDefinitionVersionInput holds the input parameters for a deleteWorkflowDefinitionVersion operation.
+type DeleteWorkflowDefinitionVersionInput struct {
+	Name    string
+	Version int64
 }
 
-// Validate returns an error if any of the PutStateResourceInput parameters don't satisfy the
+// Validate returns an error if any of the DeleteWorkflowDefinitionVersionInput parameters don't satisfy the
 // requirements from the swagger yml file.
-func (i PutStateResourceInput) Validate() error {
+func (i DeleteWorkflowDefinitionVersionInput) Validate() error {
 
-	if err := i.NewStateResource.Validate(nil); err != nil {
-		return err
-	}
 	return nil
 }
 
 // Path returns the URI path for the input.
-func (i PutStateResourceInput) Path() (string, error) {
-	path := "/state-resources/{namespace}/{name}"
+func (i DeleteWorkflowDefinitionVersionInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/{version}"
 	urlVals := url.Values{}
 
-	pathnamespace := i.Namespace
-	if pathnamespace == "" {
-		err := fmt.Errorf("namespace cannot be empty because it's a path parameter")
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
 		if err != nil {
 			return "", err
 		}
 	}
-	path = strings.Replace(path, "{namespace}", pathnamespace, -1)
+	path = strings.Replace(path, "{name}", pathname, -1)
 
-	pathname := i.Name
-	if pathname == "" {
-		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+	pathversion := strconv.FormatInt(i.Version, 10)
+	if pathversion == "" {
+		err := fmt.Errorf("version cannot be empty because it's a path parameter")
 		if err != nil {
 			return "", err
 		}
 	}
-	path = strings.Replace(path, "{name}", pathname, -1)
+	path = strings.Replace(path, "{version}", pathversion, -1)
 
 	return path + "?" + urlVals.Encode(), nil
 }
 
-// GetWorkflowDefinitionsInput holds the input parameters for a getWorkflowDefinitions operation.
-type GetWorkflowDefinitionsInput struct {
+// GetWorkflowDefinitionByNameAndVersionInput holds the input parameters for a getWorkflowDefinitionByNameAndVersion operation.
+type GetWorkflowDefinitionByNameAndVersionInput struct {
+	Name    string
+	Version int64
 }
 
-// Validate returns an error if any of the GetWorkflowDefinitionsInput parameters don't satisfy the
+// Validate returns an error if any of the GetWorkflowDefinitionByNameAndVersionInput parameters don't satisfy the
 // requirements from the swagger yml file.
-func (i GetWorkflowDefinitionsInput) Validate() error {
+func (i GetWorkflowDefinitionByNameAndVersionInput) Validate() error {
+
 	return nil
 }
 
 // Path returns the URI path for the input.
-func (i GetWorkflowDefinitionsInput) Path() (string, error) {
-	path := "/workflow-definitions"
+func (i GetWorkflowDefinitionByNameAndVersionInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/{version}"
 	urlVals := url.Values{}
 
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathversion := strconv.FormatInt(i.Version, 10)
+	if pathversion == "" {
+		err := fmt.Errorf("version cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{version}", pathversion, -1)
+
 	return path + "?" + urlVals.Encode(), nil
 }
 
-// GetWorkflowDefinitionVersionsByNameInput holds the input parameters for a getWorkflowDefinitionVersionsByName operation.
-type GetWorkflowDefinitionVersionsByNameInput struct {
-	Name   string
-	Latest *bool
+// ExportWorkflowDefinitionInput holds the input parameters for a exportWorkflowDefinition operation.
+type ExportWorkflowDefinitionInput struct {
+	Name      string
+	Version   int64
+	Namespace string
 }
 
-// Validate returns an error if any of the GetWorkflowDefinitionVersionsByNameInput parameters don't satisfy the
+// Validate returns an error if any of the ExportWorkflowDefinitionInput parameters don't satisfy the
 // requirements from the swagger yml file.
-func (i GetWorkflowDefinitionVersionsByNameInput) Validate() error {
+func (i ExportWorkflowDefinitionInput) Validate() error {
 
 	return nil
 }
 
 // Path returns the URI path for the input.
-func (i GetWorkflowDefinitionVersionsByNameInput) Path() (string, error) {
-	path := "/workflow-definitions/{name}"
+func (i ExportWorkflowDefinitionInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/{version}/export"
 	urlVals := url.Values{}
 
 	pathname := i.Name
@@ -204,33 +1317,43 @@ func (i GetWorkflowDefinitionVersionsByNameInput) Path() (string, error) {
 	}
 	path = strings.Replace(path, "{name}", pathname, -1)
 
-	if i.Latest != nil {
-		urlVals.Add("latest", strconv.FormatBool(*i.Latest))
+	pathversion := strconv.FormatInt(i.Version, 10)
+	if pathversion == "" {
+		err := fmt.Errorf("version cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
 	}
+	path = strings.Replace(path, "{version}", pathversion, -1)
+
+	urlVals.Add("namespace", i.Namespace)
 
 	return path + "?" + urlVals.Encode(), nil
 }
 
-// UpdateWorkflowDefinitionInput holds the input parameters for a updateWorkflowDefinition operation.
-type UpdateWorkflowDefinitionInput struct {
-	NewWorkflowDefinitionRequest *NewWorkflowDefinitionRequest
-	Name                         string
+// GetWorkflowDefinitionGraphInput holds the input parameters for a getWorkflowDefinitionGraph operation.
+type GetWorkflowDefinitionGraphInput struct {
+	Name    string
+	Version int64
+	Format  *string
 }
 
-// Validate returns an error if any of the UpdateWorkflowDefinitionInput parameters don't satisfy the
+// Validate returns an error if any of the GetWorkflowDefinitionGraphInput parameters don't satisfy the
 // requirements from the swagger yml file.
-func (i UpdateWorkflowDefinitionInput) Validate() error {
+func (i GetWorkflowDefinitionGraphInput) Validate() error {
 
-	if err := i.NewWorkflowDefinitionRequest.Validate(nil); err != nil {
-		return err
+	if i.Format != nil {
+		if err := validate.Enum("format", "query", *i.Format, []interface{}{"dot", "mermaid"}); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 // Path returns the URI path for the input.
-func (i UpdateWorkflowDefinitionInput) Path() (string, error) {
-	path := "/workflow-definitions/{name}"
+func (i GetWorkflowDefinitionGraphInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/{version}/graph"
 	urlVals := url.Values{}
 
 	pathname := i.Name
@@ -242,25 +1365,42 @@ func (i UpdateWorkflowDefinitionInput) Path() (string, error) {
 	}
 	path = strings.Replace(path, "{name}", pathname, -1)
 
+	pathversion := strconv.FormatInt(i.Version, 10)
+	if pathversion == "" {
+		err := fmt.Errorf("version cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{version}", pathversion, -1)
+
+	if i.Format != nil {
+		urlVals.Add("format", *i.Format)
+	}
+
 	return path + "?" + urlVals.Encode(), nil
 }
 
-// GetWorkflowDefinitionByNameAndVersionInput holds the input parameters for a getWorkflowDefinitionByNameAndVersion operation.
-type GetWorkflowDefinitionByNameAndVersionInput struct {
-	Name    string
-	Version int64
+// SimulateWorkflowDefinitionInput holds the input parameters for a simulateWorkflowDefinition operation.
+type SimulateWorkflowDefinitionInput struct {
+	Name              string
+	Version           int64
+	SimulationRequest *SimulationRequest
 }
 
-// Validate returns an error if any of the GetWorkflowDefinitionByNameAndVersionInput parameters don't satisfy the
+// Validate returns an error if any of the SimulateWorkflowDefinitionInput parameters don't satisfy the
 // requirements from the swagger yml file.
-func (i GetWorkflowDefinitionByNameAndVersionInput) Validate() error {
+func (i SimulateWorkflowDefinitionInput) Validate() error {
 
+	if err := i.SimulationRequest.Validate(nil); err != nil {
+		return err
+	}
 	return nil
 }
 
 // Path returns the URI path for the input.
-func (i GetWorkflowDefinitionByNameAndVersionInput) Path() (string, error) {
-	path := "/workflow-definitions/{name}/{version}"
+func (i SimulateWorkflowDefinitionInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/{version}/simulate"
 	urlVals := url.Values{}
 
 	pathname := i.Name
@@ -292,7 +1432,12 @@ type GetWorkflowsInput struct {
 	Status                 *string
 	ResolvedByUser         *bool
 	SummaryOnly            *bool
-	WorkflowDefinitionName string
+	WorkflowDefinitionName *string
+	Namespace              *string
+	Queue                  *string
+	Tags                   *string
+	CreatedAfter           *strfmt.DateTime
+	CreatedBefore          *strfmt.DateTime
 }
 
 // Validate returns an error if any of the GetWorkflowsInput parameters don't satisfy the
@@ -337,7 +1482,29 @@ func (i GetWorkflowsInput) Path() (string, error) {
 		urlVals.Add("summaryOnly", strconv.FormatBool(*i.SummaryOnly))
 	}
 
-	urlVals.Add("workflowDefinitionName", i.WorkflowDefinitionName)
+	if i.WorkflowDefinitionName != nil {
+		urlVals.Add("workflowDefinitionName", *i.WorkflowDefinitionName)
+	}
+
+	if i.Namespace != nil {
+		urlVals.Add("namespace", *i.Namespace)
+	}
+
+	if i.Queue != nil {
+		urlVals.Add("queue", *i.Queue)
+	}
+
+	if i.Tags != nil {
+		urlVals.Add("tags", *i.Tags)
+	}
+
+	if i.CreatedAfter != nil {
+		urlVals.Add("createdAfter", (*i.CreatedAfter).String())
+	}
+
+	if i.CreatedBefore != nil {
+		urlVals.Add("createdBefore", (*i.CreatedBefore).String())
+	}
 
 	return path + "?" + urlVals.Encode(), nil
 }
@@ -437,6 +1604,85 @@ func (i ResumeWorkflowByIDInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetWorkflowGraphInput holds the input parameters for a getWorkflowGraph operation.
+type GetWorkflowGraphInput struct {
+	WorkflowID string
+	Format     *string
+}
+
+// Validate returns an error if any of the GetWorkflowGraphInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowGraphInput) Validate() error {
+
+	if i.Format != nil {
+		if err := validate.Enum("format", "query", *i.Format, []interface{}{"dot", "mermaid"}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowGraphInput) Path() (string, error) {
+	path := "/workflows/{workflowID}/graph"
+	urlVals := url.Values{}
+
+	pathworkflowID := i.WorkflowID
+	if pathworkflowID == "" {
+		err := fmt.Errorf("workflowID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{workflowID}", pathworkflowID, -1)
+
+	if i.Format != nil {
+		urlVals.Add("format", *i.Format)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowJobInput holds the input parameters for a getWorkflowJob operation.
+type GetWorkflowJobInput struct {
+	WorkflowID string
+	JobID      string
+}
+
+// Validate returns an error if any of the GetWorkflowJobInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowJobInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowJobInput) Path() (string, error) {
+	path := "/workflows/{workflowID}/jobs/{jobID}"
+	urlVals := url.Values{}
+
+	pathworkflowID := i.WorkflowID
+	if pathworkflowID == "" {
+		err := fmt.Errorf("workflowID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{workflowID}", pathworkflowID, -1)
+
+	pathjobID := i.JobID
+	if pathjobID == "" {
+		err := fmt.Errorf("jobID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{jobID}", pathjobID, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // ResolveWorkflowByIDInput holds the input parameters for a resolveWorkflowByID operation.
 type ResolveWorkflowByIDInput struct {
 	WorkflowID string
@@ -21,18 +21,33 @@ type Job struct {
 	// attempts
 	Attempts []*JobAttempt `json:"attempts"`
 
+	// Complete failure cause of the Job, e.g. a stack trace.
+	Cause string `json:"cause,omitempty"`
+
 	// container
 	Container string `json:"container,omitempty"`
 
+	// Container instance reported in a structured failure cause.
+	ContainerInstanceARN string `json:"containerInstanceARN,omitempty"`
+
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// Name of the error the Job failed with.
+	ErrorName string `json:"errorName,omitempty"`
+
+	// Exit code reported in a structured failure cause.
+	ExitCode int64 `json:"exitCode,omitempty"`
+
 	// id
 	ID string `json:"id,omitempty"`
 
 	// input
 	Input string `json:"input,omitempty"`
 
+	// Logs of the failure reported in a structured failure cause.
+	LogURL string `json:"logURL,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -54,11 +69,14 @@ type Job struct {
 	// status
 	Status JobStatus `json:"status,omitempty"`
 
-	// status reason
+	// Summary of why the Job stopped, with the last few lines of the failure cause.
 	StatusReason string `json:"statusReason,omitempty"`
 
 	// stopped at
 	StoppedAt strfmt.DateTime `json:"stoppedAt,omitempty"`
+
+	// ECS task reported in a structured failure cause.
+	TaskARN string `json:"taskARN,omitempty"`
 }
 
 // Validate validates this job
@@ -16,15 +16,24 @@ import (
 // swagger:model JobAttempt
 type JobAttempt struct {
 
+	// Complete failure cause of the attempt.
+	Cause string `json:"cause,omitempty"`
+
 	// container instance a r n
 	ContainerInstanceARN string `json:"containerInstanceARN,omitempty"`
 
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// Name of the error the attempt failed with.
+	ErrorName string `json:"errorName,omitempty"`
+
 	// exit code
 	ExitCode int64 `json:"exitCode,omitempty"`
 
+	// log URL
+	LogURL string `json:"logURL,omitempty"`
+
 	// reason
 	Reason string `json:"reason,omitempty"`
 
@@ -36,6 +45,9 @@ type JobAttempt struct {
 
 	// task a r n
 	TaskARN string `json:"taskARN,omitempty"`
+
+	// Name of the worker that picked up the attempt.
+	WorkerName string `json:"workerName,omitempty"`
 }
 
 // Validate validates this job attempt
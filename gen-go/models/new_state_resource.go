@@ -22,7 +22,7 @@ type NewStateResource struct {
 	// namespace
 	Namespace string `json:"namespace,omitempty"`
 
-	// uri
+	// ARN of an activity, Lambda function or Batch job definition; the type of the StateResource is inferred from it. Activities are created if they don't exist, and an empty uri creates the activity "<namespace>--<name>".
 	URI string `json:"uri,omitempty"`
 }
 
@@ -16,6 +16,24 @@ import (
 // swagger:model NewWorkflowDefinitionRequest
 type NewWorkflowDefinitionRequest struct {
 
+	// what changed in this version
+	Changelog string `json:"changelog,omitempty"`
+
+	// who to reach about the workflow definition, e.g. a Slack channel or email address
+	Contact string `json:"contact,omitempty"`
+
+	// JSON object deep-merged under the input of new workflows
+	DefaultInput string `json:"defaultInput,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// JSON Schema that the input of workflows must satisfy
+	InputSchema string `json:"inputSchema,omitempty"`
+
+	// key-value pairs for grouping and finding workflow definitions
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// manager
 	Manager Manager `json:"manager,omitempty"`
 
@@ -24,6 +42,9 @@ type NewWorkflowDefinitionRequest struct {
 
 	// state machine
 	StateMachine *SLStateMachine `json:"stateMachine,omitempty"`
+
+	// team that owns the workflow definition
+	Team string `json:"team,omitempty"`
 }
 
 // Validate validates this new workflow definition request
@@ -19,7 +19,7 @@ type StartWorkflowRequest struct {
 	// input
 	Input string `json:"input,omitempty"`
 
-	// namespace
+	// a registered, active namespace
 	Namespace string `json:"namespace,omitempty"`
 
 	// queue
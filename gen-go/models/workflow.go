@@ -25,6 +25,9 @@ type Workflow struct {
 	// output
 	Output string `json:"output,omitempty"`
 
+	// whether the jobs of the stopped workflow were counted in the state stats of its definition
+	StateStatsRecorded bool `json:"stateStatsRecorded,omitempty"`
+
 	// status reason
 	StatusReason string `json:"statusReason,omitempty"`
 }
@@ -43,6 +46,8 @@ func (m *Workflow) UnmarshalJSON(raw []byte) error {
 
 		Output string `json:"output,omitempty"`
 
+		StateStatsRecorded bool `json:"stateStatsRecorded,omitempty"`
+
 		StatusReason string `json:"statusReason,omitempty"`
 	}
 	if err := swag.ReadJSON(raw, &data); err != nil {
@@ -53,6 +58,8 @@ func (m *Workflow) UnmarshalJSON(raw []byte) error {
 
 	m.Output = data.Output
 
+	m.StateStatsRecorded = data.StateStatsRecorded
+
 	m.StatusReason = data.StatusReason
 
 	return nil
@@ -73,6 +80,8 @@ func (m Workflow) MarshalJSON() ([]byte, error) {
 
 		Output string `json:"output,omitempty"`
 
+		StateStatsRecorded bool `json:"stateStatsRecorded,omitempty"`
+
 		StatusReason string `json:"statusReason,omitempty"`
 	}
 
@@ -80,6 +89,8 @@ func (m Workflow) MarshalJSON() ([]byte, error) {
 
 	data.Output = m.Output
 
+	data.StateStatsRecorded = m.StateStatsRecorded
+
 	data.StatusReason = m.StatusReason
 
 	jsonData, err := swag.WriteJSON(data)
@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -16,12 +18,39 @@ import (
 // swagger:model WorkflowDefinition
 type WorkflowDefinition struct {
 
+	// archived definitions are hidden from the list of definitions and can't start workflows
+	Archived bool `json:"archived,omitempty"`
+
+	// archived at
+	ArchivedAt *strfmt.DateTime `json:"archivedAt,omitempty"`
+
+	// what changed in this version
+	Changelog string `json:"changelog,omitempty"`
+
+	// who to reach about the workflow definition, e.g. a Slack channel or email address
+	Contact string `json:"contact,omitempty"`
+
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// JSON object deep-merged under the input of new workflows
+	DefaultInput string `json:"defaultInput,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
 	// id
 	ID string `json:"id,omitempty"`
 
+	// JSON Schema that the input of workflows must satisfy
+	InputSchema string `json:"inputSchema,omitempty"`
+
+	// key-value pairs for grouping and finding workflow definitions
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// risky patterns found in the state machine when this version was created
+	LintWarnings []*DefinitionProblem `json:"lintWarnings,omitempty"`
+
 	// manager
 	Manager Manager `json:"manager,omitempty"`
 
@@ -31,6 +60,12 @@ type WorkflowDefinition struct {
 	// state machine
 	StateMachine *SLStateMachine `json:"stateMachine,omitempty"`
 
+	// team that owns the workflow definition
+	Team string `json:"team,omitempty"`
+
+	// template
+	Template *WorkflowDefinitionTemplateRef `json:"template,omitempty"`
+
 	// version
 	Version int64 `json:"version,omitempty"`
 }
@@ -39,6 +74,11 @@ type WorkflowDefinition struct {
 func (m *WorkflowDefinition) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateLintWarnings(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateManager(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -49,12 +89,44 @@ func (m *WorkflowDefinition) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTemplate(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *WorkflowDefinition) validateLintWarnings(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LintWarnings) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LintWarnings); i++ {
+
+		if swag.IsZero(m.LintWarnings[i]) { // not required
+			continue
+		}
+
+		if m.LintWarnings[i] != nil {
+
+			if err := m.LintWarnings[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lintWarnings" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *WorkflowDefinition) validateManager(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Manager) { // not required
@@ -90,6 +162,25 @@ func (m *WorkflowDefinition) validateStateMachine(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *WorkflowDefinition) validateTemplate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Template) { // not required
+		return nil
+	}
+
+	if m.Template != nil {
+
+		if err := m.Template.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *WorkflowDefinition) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -16,6 +16,9 @@ import (
 // swagger:model WorkflowDefinitionRef
 type WorkflowDefinitionRef struct {
 
+	// alias to pick the version from, instead of version
+	Alias string `json:"alias,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -17,16 +17,28 @@ import (
 // swagger:model WorkflowQuery
 type WorkflowQuery struct {
 
+	// created after
+	CreatedAfter strfmt.DateTime `json:"createdAfter,omitempty"`
+
+	// created before
+	CreatedBefore strfmt.DateTime `json:"createdBefore,omitempty"`
+
 	// limit
 	// Maximum: 10000
 	Limit int64 `json:"limit,omitempty"`
 
+	// namespace
+	Namespace string `json:"namespace,omitempty"`
+
 	// oldest first
 	OldestFirst bool `json:"oldestFirst,omitempty"`
 
 	// page token
 	PageToken string `json:"pageToken,omitempty"`
 
+	// queue
+	Queue string `json:"queue,omitempty"`
+
 	// Tracks whether the resolvedByUser query parameter was sent or omitted in the request.
 	ResolvedByUserWrapper *ResolvedByUserWrapper `json:"resolvedByUserWrapper,omitempty"`
 
@@ -36,9 +48,11 @@ type WorkflowQuery struct {
 	// summary only
 	SummaryOnly *bool `json:"summaryOnly,omitempty"`
 
+	// tags
+	Tags map[string]string `json:"tags,omitempty"`
+
 	// workflow definition name
-	// Required: true
-	WorkflowDefinitionName *string `json:"workflowDefinitionName"`
+	WorkflowDefinitionName *string `json:"workflowDefinitionName,omitempty"`
 }
 
 // Validate validates this workflow query
@@ -60,11 +74,6 @@ func (m *WorkflowQuery) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateWorkflowDefinitionName(formats); err != nil {
-		// prop
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -119,15 +128,6 @@ func (m *WorkflowQuery) validateStatus(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *WorkflowQuery) validateWorkflowDefinitionName(formats strfmt.Registry) error {
-
-	if err := validate.Required("workflowDefinitionName", "body", m.WorkflowDefinitionName); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // MarshalBinary interface implementation
 func (m *WorkflowQuery) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -16,9 +16,18 @@ import (
 // swagger:model WorkflowSummary
 type WorkflowSummary struct {
 
+	// AWS account the execution of the workflow runs in
+	AccountID string `json:"accountID,omitempty"`
+
+	// IAM role assumed to reach the execution of the workflow, if it runs in another account
+	AssumeRoleARN string `json:"assumeRoleARN,omitempty"`
+
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// input the workflow was started with, after merging definition defaults and namespace parameters
+	EffectiveInput string `json:"effectiveInput,omitempty"`
+
 	// id
 	ID string `json:"id,omitempty"`
 
@@ -34,6 +43,9 @@ type WorkflowSummary struct {
 	// queue
 	Queue string `json:"queue,omitempty"`
 
+	// AWS region the execution of the workflow runs in
+	Region string `json:"region,omitempty"`
+
 	// resolved by user
 	ResolvedByUser bool `json:"resolvedByUser,omitempty"`
 
@@ -54,6 +66,9 @@ type WorkflowSummary struct {
 
 	// workflow definition
 	WorkflowDefinition *WorkflowDefinition `json:"workflowDefinition,omitempty"`
+
+	// alias the workflow was started through, which picked its workflowDefinition version
+	WorkflowDefinitionAlias string `json:"workflowDefinitionAlias,omitempty"`
 }
 
 // Validate validates this workflow summary
@@ -122,20 +122,23 @@ func newHealthCheckInput(r *http.Request) (*models.HealthCheckInput, error) {
 	return &input, nil
 }
 
-// statusCodeForPostStateResource returns the status code corresponding to the returned
+// statusCodeForGetNamespaceConfigs returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForPostStateResource(obj interface{}) int {
+func statusCodeForGetNamespaceConfigs(obj interface{}) int {
 
 	switch obj.(type) {
 
+	case *[]models.NamespaceConfig:
+		return 200
+
 	case *models.BadRequest:
 		return 400
 
 	case *models.InternalError:
 		return 500
 
-	case *models.StateResource:
-		return 201
+	case []models.NamespaceConfig:
+		return 200
 
 	case models.BadRequest:
 		return 400
@@ -143,39 +146,27 @@ func statusCodeForPostStateResource(obj interface{}) int {
 	case models.InternalError:
 		return 500
 
-	case models.StateResource:
-		return 201
-
 	default:
 		return -1
 	}
 }
 
-func (h handler) PostStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-
-	input, err := newPostStateResourceInput(r)
-	if err != nil {
-		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
-		return
-	}
+func (h handler) GetNamespaceConfigsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	err = input.Validate(nil)
+	resp, err := h.GetNamespaceConfigs(ctx)
 
-	if err != nil {
-		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
-		return
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.NamespaceConfig{}
 	}
 
-	resp, err := h.PostStateResource(ctx, input)
-
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForPostStateResource(err)
+		statusCode := statusCodeForGetNamespaceConfigs(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -192,32 +183,24 @@ func (h handler) PostStateResourceHandler(ctx context.Context, w http.ResponseWr
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForPostStateResource(resp))
+	w.WriteHeader(statusCodeForGetNamespaceConfigs(resp))
 	w.Write(respBytes)
 
 }
 
-// newPostStateResourceInput takes in an http.Request an returns the input struct.
-func newPostStateResourceInput(r *http.Request) (*models.NewStateResource, error) {
-	var input models.NewStateResource
+// newGetNamespaceConfigsInput takes in an http.Request an returns the input struct.
+func newGetNamespaceConfigsInput(r *http.Request) (*models.GetNamespaceConfigsInput, error) {
+	var input models.GetNamespaceConfigsInput
 
 	var err error
 	_ = err
 
-	data, err := ioutil.ReadAll(r.Body)
-
-	if len(data) > 0 {
-		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
-			return nil, err
-		}
-	}
-
 	return &input, nil
 }
 
-// statusCodeForDeleteStateResource returns the status code corresponding to the returned
+// statusCodeForDeleteNamespaceConfig returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForDeleteStateResource(obj interface{}) int {
+func statusCodeForDeleteNamespaceConfig(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -244,16 +227,16 @@ func statusCodeForDeleteStateResource(obj interface{}) int {
 	}
 }
 
-func (h handler) DeleteStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) DeleteNamespaceConfigHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newDeleteStateResourceInput(r)
+	namespace, err := newDeleteNamespaceConfigInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
 		return
 	}
 
-	err = input.Validate()
+	err = models.ValidateDeleteNamespaceConfigInput(namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
@@ -261,14 +244,14 @@ func (h handler) DeleteStateResourceHandler(ctx context.Context, w http.Response
 		return
 	}
 
-	err = h.DeleteStateResource(ctx, input)
+	err = h.DeleteNamespaceConfig(ctx, namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForDeleteStateResource(err)
+		statusCode := statusCodeForDeleteNamespaceConfig(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -282,51 +265,19 @@ func (h handler) DeleteStateResourceHandler(ctx context.Context, w http.Response
 
 }
 
-// newDeleteStateResourceInput takes in an http.Request an returns the input struct.
-func newDeleteStateResourceInput(r *http.Request) (*models.DeleteStateResourceInput, error) {
-	var input models.DeleteStateResourceInput
-
-	var err error
-	_ = err
-
-	namespaceStr := mux.Vars(r)["namespace"]
-	if len(namespaceStr) == 0 {
-		return nil, errors.New("path parameter 'namespace' must be specified")
-	}
-	namespaceStrs := []string{namespaceStr}
-
-	if len(namespaceStrs) > 0 {
-		var namespaceTmp string
-		namespaceStr := namespaceStrs[0]
-		namespaceTmp, err = namespaceStr, error(nil)
-		if err != nil {
-			return nil, err
-		}
-		input.Namespace = namespaceTmp
-	}
-
-	nameStr := mux.Vars(r)["name"]
-	if len(nameStr) == 0 {
-		return nil, errors.New("path parameter 'name' must be specified")
-	}
-	nameStrs := []string{nameStr}
-
-	if len(nameStrs) > 0 {
-		var nameTmp string
-		nameStr := nameStrs[0]
-		nameTmp, err = nameStr, error(nil)
-		if err != nil {
-			return nil, err
-		}
-		input.Name = nameTmp
+// newDeleteNamespaceConfigInput takes in an http.Request an returns the namespace parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newDeleteNamespaceConfigInput(r *http.Request) (string, error) {
+	namespace := mux.Vars(r)["namespace"]
+	if len(namespace) == 0 {
+		return "", errors.New("Parameter namespace must be specified")
 	}
-
-	return &input, nil
+	return namespace, nil
 }
 
-// statusCodeForGetStateResource returns the status code corresponding to the returned
+// statusCodeForGetNamespaceConfig returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetStateResource(obj interface{}) int {
+func statusCodeForGetNamespaceConfig(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -336,39 +287,39 @@ func statusCodeForGetStateResource(obj interface{}) int {
 	case *models.InternalError:
 		return 500
 
+	case *models.NamespaceConfig:
+		return 200
+
 	case *models.NotFound:
 		return 404
 
-	case *models.StateResource:
-		return 200
-
 	case models.BadRequest:
 		return 400
 
 	case models.InternalError:
 		return 500
 
+	case models.NamespaceConfig:
+		return 200
+
 	case models.NotFound:
 		return 404
 
-	case models.StateResource:
-		return 200
-
 	default:
 		return -1
 	}
 }
 
-func (h handler) GetStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetNamespaceConfigHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newGetStateResourceInput(r)
+	namespace, err := newGetNamespaceConfigInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
 		return
 	}
 
-	err = input.Validate()
+	err = models.ValidateGetNamespaceConfigInput(namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
@@ -376,14 +327,14 @@ func (h handler) GetStateResourceHandler(ctx context.Context, w http.ResponseWri
 		return
 	}
 
-	resp, err := h.GetStateResource(ctx, input)
+	resp, err := h.GetNamespaceConfig(ctx, namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForGetStateResource(err)
+		statusCode := statusCodeForGetNamespaceConfig(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -400,56 +351,24 @@ func (h handler) GetStateResourceHandler(ctx context.Context, w http.ResponseWri
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForGetStateResource(resp))
+	w.WriteHeader(statusCodeForGetNamespaceConfig(resp))
 	w.Write(respBytes)
 
 }
 
-// newGetStateResourceInput takes in an http.Request an returns the input struct.
-func newGetStateResourceInput(r *http.Request) (*models.GetStateResourceInput, error) {
-	var input models.GetStateResourceInput
-
-	var err error
-	_ = err
-
-	namespaceStr := mux.Vars(r)["namespace"]
-	if len(namespaceStr) == 0 {
-		return nil, errors.New("path parameter 'namespace' must be specified")
-	}
-	namespaceStrs := []string{namespaceStr}
-
-	if len(namespaceStrs) > 0 {
-		var namespaceTmp string
-		namespaceStr := namespaceStrs[0]
-		namespaceTmp, err = namespaceStr, error(nil)
-		if err != nil {
-			return nil, err
-		}
-		input.Namespace = namespaceTmp
-	}
-
-	nameStr := mux.Vars(r)["name"]
-	if len(nameStr) == 0 {
-		return nil, errors.New("path parameter 'name' must be specified")
-	}
-	nameStrs := []string{nameStr}
-
-	if len(nameStrs) > 0 {
-		var nameTmp string
-		nameStr := nameStrs[0]
-		nameTmp, err = nameStr, error(nil)
-		if err != nil {
-			return nil, err
-		}
-		input.Name = nameTmp
+// newGetNamespaceConfigInput takes in an http.Request an returns the namespace parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetNamespaceConfigInput(r *http.Request) (string, error) {
+	namespace := mux.Vars(r)["namespace"]
+	if len(namespace) == 0 {
+		return "", errors.New("Parameter namespace must be specified")
 	}
-
-	return &input, nil
+	return namespace, nil
 }
 
-// statusCodeForPutStateResource returns the status code corresponding to the returned
+// statusCodeForPutNamespaceConfig returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForPutStateResource(obj interface{}) int {
+func statusCodeForPutNamespaceConfig(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -459,26 +378,32 @@ func statusCodeForPutStateResource(obj interface{}) int {
 	case *models.InternalError:
 		return 500
 
-	case *models.StateResource:
+	case *models.NamespaceConfig:
 		return 201
 
+	case *models.NotFound:
+		return 404
+
 	case models.BadRequest:
 		return 400
 
 	case models.InternalError:
 		return 500
 
-	case models.StateResource:
+	case models.NamespaceConfig:
 		return 201
 
+	case models.NotFound:
+		return 404
+
 	default:
 		return -1
 	}
 }
 
-func (h handler) PutStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) PutNamespaceConfigHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newPutStateResourceInput(r)
+	input, err := newPutNamespaceConfigInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
@@ -493,14 +418,14 @@ func (h handler) PutStateResourceHandler(ctx context.Context, w http.ResponseWri
 		return
 	}
 
-	resp, err := h.PutStateResource(ctx, input)
+	resp, err := h.PutNamespaceConfig(ctx, input)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForPutStateResource(err)
+		statusCode := statusCodeForPutNamespaceConfig(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -517,14 +442,14 @@ func (h handler) PutStateResourceHandler(ctx context.Context, w http.ResponseWri
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForPutStateResource(resp))
+	w.WriteHeader(statusCodeForPutNamespaceConfig(resp))
 	w.Write(respBytes)
 
 }
 
-// newPutStateResourceInput takes in an http.Request an returns the input struct.
-func newPutStateResourceInput(r *http.Request) (*models.PutStateResourceInput, error) {
-	var input models.PutStateResourceInput
+// newPutNamespaceConfigInput takes in an http.Request an returns the input struct.
+func newPutNamespaceConfigInput(r *http.Request) (*models.PutNamespaceConfigInput, error) {
+	var input models.PutNamespaceConfigInput
 
 	var err error
 	_ = err
@@ -545,27 +470,11 @@ func newPutStateResourceInput(r *http.Request) (*models.PutStateResourceInput, e
 		input.Namespace = namespaceTmp
 	}
 
-	nameStr := mux.Vars(r)["name"]
-	if len(nameStr) == 0 {
-		return nil, errors.New("path parameter 'name' must be specified")
-	}
-	nameStrs := []string{nameStr}
-
-	if len(nameStrs) > 0 {
-		var nameTmp string
-		nameStr := nameStrs[0]
-		nameTmp, err = nameStr, error(nil)
-		if err != nil {
-			return nil, err
-		}
-		input.Name = nameTmp
-	}
-
 	data, err := ioutil.ReadAll(r.Body)
 
 	if len(data) > 0 {
-		input.NewStateResource = &models.NewStateResource{}
-		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.NewStateResource); err != nil {
+		input.NamespaceConfig = &models.NamespaceConfig{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.NamespaceConfig); err != nil {
 			return nil, err
 		}
 	}
@@ -573,13 +482,13 @@ func newPutStateResourceInput(r *http.Request) (*models.PutStateResourceInput, e
 	return &input, nil
 }
 
-// statusCodeForGetWorkflowDefinitions returns the status code corresponding to the returned
+// statusCodeForGetNamespaces returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
+func statusCodeForGetNamespaces(obj interface{}) int {
 
 	switch obj.(type) {
 
-	case *[]models.WorkflowDefinition:
+	case *[]models.Namespace:
 		return 200
 
 	case *models.BadRequest:
@@ -588,7 +497,7 @@ func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
 	case *models.InternalError:
 		return 500
 
-	case []models.WorkflowDefinition:
+	case []models.Namespace:
 		return 200
 
 	case models.BadRequest:
@@ -602,14 +511,14 @@ func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
 	}
 }
 
-func (h handler) GetWorkflowDefinitionsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetNamespacesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	resp, err := h.GetWorkflowDefinitions(ctx)
+	resp, err := h.GetNamespaces(ctx)
 
 	// Success types that return an array should never return nil so let's make this easier
 	// for consumers by converting nil arrays to empty arrays
 	if resp == nil {
-		resp = []models.WorkflowDefinition{}
+		resp = []models.Namespace{}
 	}
 
 	if err != nil {
@@ -617,7 +526,7 @@ func (h handler) GetWorkflowDefinitionsHandler(ctx context.Context, w http.Respo
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForGetWorkflowDefinitions(err)
+		statusCode := statusCodeForGetNamespaces(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -634,14 +543,14 @@ func (h handler) GetWorkflowDefinitionsHandler(ctx context.Context, w http.Respo
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForGetWorkflowDefinitions(resp))
+	w.WriteHeader(statusCodeForGetNamespaces(resp))
 	w.Write(respBytes)
 
 }
 
-// newGetWorkflowDefinitionsInput takes in an http.Request an returns the input struct.
-func newGetWorkflowDefinitionsInput(r *http.Request) (*models.GetWorkflowDefinitionsInput, error) {
-	var input models.GetWorkflowDefinitionsInput
+// newGetNamespacesInput takes in an http.Request an returns the input struct.
+func newGetNamespacesInput(r *http.Request) (*models.GetNamespacesInput, error) {
+	var input models.GetNamespacesInput
 
 	var err error
 	_ = err
@@ -649,9 +558,9 @@ func newGetWorkflowDefinitionsInput(r *http.Request) (*models.GetWorkflowDefinit
 	return &input, nil
 }
 
-// statusCodeForNewWorkflowDefinition returns the status code corresponding to the returned
+// statusCodeForDeleteNamespace returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForNewWorkflowDefinition(obj interface{}) int {
+func statusCodeForDeleteNamespace(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -661,8 +570,8 @@ func statusCodeForNewWorkflowDefinition(obj interface{}) int {
 	case *models.InternalError:
 		return 500
 
-	case *models.WorkflowDefinition:
-		return 201
+	case *models.NotFound:
+		return 404
 
 	case models.BadRequest:
 		return 400
@@ -670,24 +579,24 @@ func statusCodeForNewWorkflowDefinition(obj interface{}) int {
 	case models.InternalError:
 		return 500
 
-	case models.WorkflowDefinition:
-		return 201
+	case models.NotFound:
+		return 404
 
 	default:
 		return -1
 	}
 }
 
-func (h handler) NewWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) DeleteNamespaceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newNewWorkflowDefinitionInput(r)
+	namespace, err := newDeleteNamespaceInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
 		return
 	}
 
-	err = input.Validate(nil)
+	err = models.ValidateDeleteNamespaceInput(namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
@@ -695,14 +604,14 @@ func (h handler) NewWorkflowDefinitionHandler(ctx context.Context, w http.Respon
 		return
 	}
 
-	resp, err := h.NewWorkflowDefinition(ctx, input)
+	err = h.DeleteNamespace(ctx, namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForNewWorkflowDefinition(err)
+		statusCode := statusCodeForDeleteNamespace(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -711,64 +620,48 @@ func (h handler) NewWorkflowDefinitionHandler(ctx context.Context, w http.Respon
 		return
 	}
 
-	respBytes, err := json.MarshalIndent(resp, "", "\t")
-	if err != nil {
-		logger.FromContext(ctx).AddContext("error", err.Error())
-		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForNewWorkflowDefinition(resp))
-	w.Write(respBytes)
+	w.WriteHeader(200)
+	w.Write([]byte(""))
 
 }
 
-// newNewWorkflowDefinitionInput takes in an http.Request an returns the input struct.
-func newNewWorkflowDefinitionInput(r *http.Request) (*models.NewWorkflowDefinitionRequest, error) {
-	var input models.NewWorkflowDefinitionRequest
-
-	var err error
-	_ = err
-
-	data, err := ioutil.ReadAll(r.Body)
-
-	if len(data) > 0 {
-		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
-			return nil, err
-		}
+// newDeleteNamespaceInput takes in an http.Request an returns the namespace parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newDeleteNamespaceInput(r *http.Request) (string, error) {
+	namespace := mux.Vars(r)["namespace"]
+	if len(namespace) == 0 {
+		return "", errors.New("Parameter namespace must be specified")
 	}
-
-	return &input, nil
+	return namespace, nil
 }
 
-// statusCodeForGetWorkflowDefinitionVersionsByName returns the status code corresponding to the returned
+// statusCodeForGetNamespace returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflowDefinitionVersionsByName(obj interface{}) int {
+func statusCodeForGetNamespace(obj interface{}) int {
 
 	switch obj.(type) {
 
-	case *[]models.WorkflowDefinition:
-		return 200
-
 	case *models.BadRequest:
 		return 400
 
 	case *models.InternalError:
 		return 500
 
+	case *models.Namespace:
+		return 200
+
 	case *models.NotFound:
 		return 404
 
-	case []models.WorkflowDefinition:
-		return 200
-
 	case models.BadRequest:
 		return 400
 
 	case models.InternalError:
 		return 500
 
+	case models.Namespace:
+		return 200
+
 	case models.NotFound:
 		return 404
 
@@ -777,16 +670,16 @@ func statusCodeForGetWorkflowDefinitionVersionsByName(obj interface{}) int {
 	}
 }
 
-func (h handler) GetWorkflowDefinitionVersionsByNameHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetNamespaceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newGetWorkflowDefinitionVersionsByNameInput(r)
+	namespace, err := newGetNamespaceInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
 		return
 	}
 
-	err = input.Validate()
+	err = models.ValidateGetNamespaceInput(namespace)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
@@ -794,20 +687,3843 @@ func (h handler) GetWorkflowDefinitionVersionsByNameHandler(ctx context.Context,
 		return
 	}
 
-	resp, err := h.GetWorkflowDefinitionVersionsByName(ctx, input)
+	resp, err := h.GetNamespace(ctx, namespace)
 
-	// Success types that return an array should never return nil so let's make this easier
-	// for consumers by converting nil arrays to empty arrays
-	if resp == nil {
-		resp = []models.WorkflowDefinition{}
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetNamespace(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetNamespace(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetNamespaceInput takes in an http.Request an returns the namespace parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetNamespaceInput(r *http.Request) (string, error) {
+	namespace := mux.Vars(r)["namespace"]
+	if len(namespace) == 0 {
+		return "", errors.New("Parameter namespace must be specified")
+	}
+	return namespace, nil
+}
+
+// statusCodeForPutNamespace returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForPutNamespace(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Namespace:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Namespace:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) PutNamespaceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newPutNamespaceInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.PutNamespace(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForPutNamespace(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForPutNamespace(resp))
+	w.Write(respBytes)
+
+}
+
+// newPutNamespaceInput takes in an http.Request an returns the input struct.
+func newPutNamespaceInput(r *http.Request) (*models.PutNamespaceInput, error) {
+	var input models.PutNamespaceInput
+
+	var err error
+	_ = err
+
+	namespaceStr := mux.Vars(r)["namespace"]
+	if len(namespaceStr) == 0 {
+		return nil, errors.New("path parameter 'namespace' must be specified")
+	}
+	namespaceStrs := []string{namespaceStr}
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = namespaceTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+
+	if len(data) > 0 {
+		input.RegisteredNamespace = &models.Namespace{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.RegisteredNamespace); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetStalledActivities returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetStalledActivities(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.StalledActivity:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.StalledActivity:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetStalledActivitiesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetStalledActivitiesInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetStalledActivities(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.StalledActivity{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetStalledActivities(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetStalledActivities(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetStalledActivitiesInput takes in an http.Request an returns the input struct.
+func newGetStalledActivitiesInput(r *http.Request) (*models.GetStalledActivitiesInput, error) {
+	var input models.GetStalledActivitiesInput
+
+	var err error
+	_ = err
+
+	namespaceStrs := r.URL.Query()["namespace"]
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = &namespaceTmp
+	}
+
+	workerTimeoutStrs := r.URL.Query()["workerTimeout"]
+
+	if len(workerTimeoutStrs) == 0 {
+		workerTimeoutStrs = []string{"600"}
+	}
+	if len(workerTimeoutStrs) > 0 {
+		var workerTimeoutTmp int64
+		workerTimeoutStr := workerTimeoutStrs[0]
+		workerTimeoutTmp, err = swag.ConvertInt64(workerTimeoutStr)
+		if err != nil {
+			return nil, err
+		}
+		input.WorkerTimeout = &workerTimeoutTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetStateResources returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetStateResources(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.StateResource:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.StateResource:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetStateResourcesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetStateResourcesInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, nextPageID, err := h.GetStateResources(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.StateResource{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetStateResources(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	if !swag.IsZero(nextPageID) {
+		input.PageToken = &nextPageID
+		path, err := input.Path()
+		if err != nil {
+			logger.FromContext(ctx).AddContext("error", err.Error())
+			http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Next-Page-Path", path)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetStateResources(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetStateResourcesInput takes in an http.Request an returns the input struct.
+func newGetStateResourcesInput(r *http.Request) (*models.GetStateResourcesInput, error) {
+	var input models.GetStateResourcesInput
+
+	var err error
+	_ = err
+
+	namespaceStrs := r.URL.Query()["namespace"]
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = &namespaceTmp
+	}
+
+	limitStrs := r.URL.Query()["limit"]
+
+	if len(limitStrs) == 0 {
+		limitStrs = []string{"100"}
+	}
+	if len(limitStrs) > 0 {
+		var limitTmp int64
+		limitStr := limitStrs[0]
+		limitTmp, err = swag.ConvertInt64(limitStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Limit = &limitTmp
+	}
+
+	pageTokenStrs := r.URL.Query()["pageToken"]
+
+	if len(pageTokenStrs) > 0 {
+		var pageTokenTmp string
+		pageTokenStr := pageTokenStrs[0]
+		pageTokenTmp, err = pageTokenStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.PageToken = &pageTokenTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForPostStateResource returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForPostStateResource(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.StateResource:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.StateResource:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) PostStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newPostStateResourceInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate(nil)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.PostStateResource(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForPostStateResource(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForPostStateResource(resp))
+	w.Write(respBytes)
+
+}
+
+// newPostStateResourceInput takes in an http.Request an returns the input struct.
+func newPostStateResourceInput(r *http.Request) (*models.NewStateResource, error) {
+	var input models.NewStateResource
+
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForDeleteStateResource returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForDeleteStateResource(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) DeleteStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newDeleteStateResourceInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.DeleteStateResource(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForDeleteStateResource(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newDeleteStateResourceInput takes in an http.Request an returns the input struct.
+func newDeleteStateResourceInput(r *http.Request) (*models.DeleteStateResourceInput, error) {
+	var input models.DeleteStateResourceInput
+
+	var err error
+	_ = err
+
+	namespaceStr := mux.Vars(r)["namespace"]
+	if len(namespaceStr) == 0 {
+		return nil, errors.New("path parameter 'namespace' must be specified")
+	}
+	namespaceStrs := []string{namespaceStr}
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = namespaceTmp
+	}
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	deleteActivityStrs := r.URL.Query()["deleteActivity"]
+
+	if len(deleteActivityStrs) == 0 {
+		deleteActivityStrs = []string{"false"}
+	}
+	if len(deleteActivityStrs) > 0 {
+		var deleteActivityTmp bool
+		deleteActivityStr := deleteActivityStrs[0]
+		deleteActivityTmp, err = strconv.ParseBool(deleteActivityStr)
+		if err != nil {
+			return nil, err
+		}
+		input.DeleteActivity = &deleteActivityTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetStateResource returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetStateResource(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.StateResource:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.StateResource:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetStateResourceInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetStateResource(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetStateResource(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetStateResource(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetStateResourceInput takes in an http.Request an returns the input struct.
+func newGetStateResourceInput(r *http.Request) (*models.GetStateResourceInput, error) {
+	var input models.GetStateResourceInput
+
+	var err error
+	_ = err
+
+	namespaceStr := mux.Vars(r)["namespace"]
+	if len(namespaceStr) == 0 {
+		return nil, errors.New("path parameter 'namespace' must be specified")
+	}
+	namespaceStrs := []string{namespaceStr}
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = namespaceTmp
+	}
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForPutStateResource returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForPutStateResource(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.StateResource:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.StateResource:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) PutStateResourceHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newPutStateResourceInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.PutStateResource(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForPutStateResource(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForPutStateResource(resp))
+	w.Write(respBytes)
+
+}
+
+// newPutStateResourceInput takes in an http.Request an returns the input struct.
+func newPutStateResourceInput(r *http.Request) (*models.PutStateResourceInput, error) {
+	var input models.PutStateResourceInput
+
+	var err error
+	_ = err
+
+	namespaceStr := mux.Vars(r)["namespace"]
+	if len(namespaceStr) == 0 {
+		return nil, errors.New("path parameter 'namespace' must be specified")
+	}
+	namespaceStrs := []string{namespaceStr}
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = namespaceTmp
+	}
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		input.NewStateResource = &models.NewStateResource{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.NewStateResource); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetActivityWorkers returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetActivityWorkers(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.ActivityWorker:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case []models.ActivityWorker:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetActivityWorkersHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetActivityWorkersInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetActivityWorkers(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.ActivityWorker{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetActivityWorkers(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetActivityWorkers(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetActivityWorkersInput takes in an http.Request an returns the input struct.
+func newGetActivityWorkersInput(r *http.Request) (*models.GetActivityWorkersInput, error) {
+	var input models.GetActivityWorkersInput
+
+	var err error
+	_ = err
+
+	namespaceStr := mux.Vars(r)["namespace"]
+	if len(namespaceStr) == 0 {
+		return nil, errors.New("path parameter 'namespace' must be specified")
+	}
+	namespaceStrs := []string{namespaceStr}
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = namespaceTmp
+	}
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionTemplates returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionTemplates(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinitionTemplate:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.WorkflowDefinitionTemplate:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionTemplatesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	resp, err := h.GetWorkflowDefinitionTemplates(ctx)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinitionTemplate{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionTemplates(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionTemplates(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionTemplatesInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionTemplatesInput(r *http.Request) (*models.GetWorkflowDefinitionTemplatesInput, error) {
+	var input models.GetWorkflowDefinitionTemplatesInput
+
+	var err error
+	_ = err
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionTemplate returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionTemplate(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionTemplate:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionTemplate:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionTemplateHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newGetWorkflowDefinitionTemplateInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowDefinitionTemplateInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionTemplate(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionTemplate(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionTemplate(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionTemplateInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowDefinitionTemplateInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForPutWorkflowDefinitionTemplate returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForPutWorkflowDefinitionTemplate(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.WorkflowDefinitionTemplate:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.WorkflowDefinitionTemplate:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) PutWorkflowDefinitionTemplateHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newPutWorkflowDefinitionTemplateInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.PutWorkflowDefinitionTemplate(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForPutWorkflowDefinitionTemplate(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForPutWorkflowDefinitionTemplate(resp))
+	w.Write(respBytes)
+
+}
+
+// newPutWorkflowDefinitionTemplateInput takes in an http.Request an returns the input struct.
+func newPutWorkflowDefinitionTemplateInput(r *http.Request) (*models.PutWorkflowDefinitionTemplateInput, error) {
+	var input models.PutWorkflowDefinitionTemplateInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+
+	if len(data) > 0 {
+		input.WorkflowDefinitionTemplate = &models.WorkflowDefinitionTemplate{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.WorkflowDefinitionTemplate); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionTemplateInstances returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionTemplateInstances(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinition:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case []models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionTemplateInstancesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newGetWorkflowDefinitionTemplateInstancesInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowDefinitionTemplateInstancesInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionTemplateInstances(ctx, name)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinition{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionTemplateInstances(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionTemplateInstances(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionTemplateInstancesInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowDefinitionTemplateInstancesInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForInstantiateWorkflowDefinitionTemplate returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForInstantiateWorkflowDefinitionTemplate(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Conflict:
+		return 409
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinition:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.Conflict:
+		return 409
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinition:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) InstantiateWorkflowDefinitionTemplateHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newInstantiateWorkflowDefinitionTemplateInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.InstantiateWorkflowDefinitionTemplate(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForInstantiateWorkflowDefinitionTemplate(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForInstantiateWorkflowDefinitionTemplate(resp))
+	w.Write(respBytes)
+
+}
+
+// newInstantiateWorkflowDefinitionTemplateInput takes in an http.Request an returns the input struct.
+func newInstantiateWorkflowDefinitionTemplateInput(r *http.Request) (*models.InstantiateWorkflowDefinitionTemplateInput, error) {
+	var input models.InstantiateWorkflowDefinitionTemplateInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+
+	if len(data) > 0 {
+		input.TemplateInstanceRequest = &models.TemplateInstanceRequest{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.TemplateInstanceRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForRegenerateWorkflowDefinitionTemplateInstances returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForRegenerateWorkflowDefinitionTemplateInstances(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinition:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case []models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) RegenerateWorkflowDefinitionTemplateInstancesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newRegenerateWorkflowDefinitionTemplateInstancesInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateRegenerateWorkflowDefinitionTemplateInstancesInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.RegenerateWorkflowDefinitionTemplateInstances(ctx, name)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinition{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForRegenerateWorkflowDefinitionTemplateInstances(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForRegenerateWorkflowDefinitionTemplateInstances(resp))
+	w.Write(respBytes)
+
+}
+
+// newRegenerateWorkflowDefinitionTemplateInstancesInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newRegenerateWorkflowDefinitionTemplateInstancesInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForGetWorkflowDefinitions returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinition:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionsInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitions(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinition{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitions(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitions(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionsInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionsInput(r *http.Request) (*models.GetWorkflowDefinitionsInput, error) {
+	var input models.GetWorkflowDefinitionsInput
+
+	var err error
+	_ = err
+
+	teamStrs := r.URL.Query()["team"]
+
+	if len(teamStrs) > 0 {
+		var teamTmp string
+		teamStr := teamStrs[0]
+		teamTmp, err = teamStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Team = &teamTmp
+	}
+
+	labelStrs := r.URL.Query()["label"]
+
+	if len(labelStrs) > 0 {
+		var labelTmp string
+		labelStr := labelStrs[0]
+		labelTmp, err = labelStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Label = &labelTmp
+	}
+
+	searchStrs := r.URL.Query()["search"]
+
+	if len(searchStrs) > 0 {
+		var searchTmp string
+		searchStr := searchStrs[0]
+		searchTmp, err = searchStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Search = &searchTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForNewWorkflowDefinition returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForNewWorkflowDefinition(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.WorkflowDefinition:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.WorkflowDefinition:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) NewWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newNewWorkflowDefinitionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate(nil)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.NewWorkflowDefinition(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForNewWorkflowDefinition(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForNewWorkflowDefinition(resp))
+	w.Write(respBytes)
+
+}
+
+// newNewWorkflowDefinitionInput takes in an http.Request an returns the input struct.
+func newNewWorkflowDefinitionInput(r *http.Request) (*models.NewWorkflowDefinitionRequest, error) {
+	var input models.NewWorkflowDefinitionRequest
+
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForImportWorkflowDefinition returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForImportWorkflowDefinition(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.WorkflowDefinition:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.WorkflowDefinition:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) ImportWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newImportWorkflowDefinitionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate(nil)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.ImportWorkflowDefinition(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForImportWorkflowDefinition(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForImportWorkflowDefinition(resp))
+	w.Write(respBytes)
+
+}
+
+// newImportWorkflowDefinitionInput takes in an http.Request an returns the input struct.
+func newImportWorkflowDefinitionInput(r *http.Request) (*models.ImportWorkflowDefinitionRequest, error) {
+	var input models.ImportWorkflowDefinitionRequest
+
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForLintWorkflowDefinition returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForLintWorkflowDefinition(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.DefinitionLintResult:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.DefinitionLintResult:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) LintWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newLintWorkflowDefinitionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate(nil)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.LintWorkflowDefinition(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForLintWorkflowDefinition(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForLintWorkflowDefinition(resp))
+	w.Write(respBytes)
+
+}
+
+// newLintWorkflowDefinitionInput takes in an http.Request an returns the input struct.
+func newLintWorkflowDefinitionInput(r *http.Request) (*models.NewWorkflowDefinitionRequest, error) {
+	var input models.NewWorkflowDefinitionRequest
+
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionVersionsByName returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionVersionsByName(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinition:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case []models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionVersionsByNameHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionVersionsByNameInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionVersionsByName(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinition{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionVersionsByName(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionVersionsByName(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionVersionsByNameInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionVersionsByNameInput(r *http.Request) (*models.GetWorkflowDefinitionVersionsByNameInput, error) {
+	var input models.GetWorkflowDefinitionVersionsByNameInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	latestStrs := r.URL.Query()["latest"]
+
+	if len(latestStrs) == 0 {
+		latestStrs = []string{"true"}
+	}
+	if len(latestStrs) > 0 {
+		var latestTmp bool
+		latestStr := latestStrs[0]
+		latestTmp, err = strconv.ParseBool(latestStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Latest = &latestTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForUpdateWorkflowDefinition returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForUpdateWorkflowDefinition(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinition:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinition:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) UpdateWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newUpdateWorkflowDefinitionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.UpdateWorkflowDefinition(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForUpdateWorkflowDefinition(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForUpdateWorkflowDefinition(resp))
+	w.Write(respBytes)
+
+}
+
+// newUpdateWorkflowDefinitionInput takes in an http.Request an returns the input struct.
+func newUpdateWorkflowDefinitionInput(r *http.Request) (*models.UpdateWorkflowDefinitionInput, error) {
+	var input models.UpdateWorkflowDefinitionInput
+
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		input.NewWorkflowDefinitionRequest = &models.NewWorkflowDefinitionRequest{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.NewWorkflowDefinitionRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionAliases returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionAliases(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinitionAlias:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.WorkflowDefinitionAlias:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionAliasesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newGetWorkflowDefinitionAliasesInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowDefinitionAliasesInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionAliases(ctx, name)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinitionAlias{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionAliases(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionAliases(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionAliasesInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowDefinitionAliasesInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForDeleteWorkflowDefinitionAlias returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForDeleteWorkflowDefinitionAlias(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) DeleteWorkflowDefinitionAliasHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newDeleteWorkflowDefinitionAliasInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.DeleteWorkflowDefinitionAlias(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForDeleteWorkflowDefinitionAlias(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newDeleteWorkflowDefinitionAliasInput takes in an http.Request an returns the input struct.
+func newDeleteWorkflowDefinitionAliasInput(r *http.Request) (*models.DeleteWorkflowDefinitionAliasInput, error) {
+	var input models.DeleteWorkflowDefinitionAliasInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionAlias returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionAlias(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionAlias:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionAlias:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionAliasHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionAliasInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionAlias(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionAlias(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionAlias(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionAliasInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionAliasInput(r *http.Request) (*models.GetWorkflowDefinitionAliasInput, error) {
+	var input models.GetWorkflowDefinitionAliasInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForPutWorkflowDefinitionAlias returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForPutWorkflowDefinitionAlias(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Conflict:
+		return 409
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionAlias:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.Conflict:
+		return 409
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionAlias:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) PutWorkflowDefinitionAliasHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newPutWorkflowDefinitionAliasInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.PutWorkflowDefinitionAlias(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForPutWorkflowDefinitionAlias(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForPutWorkflowDefinitionAlias(resp))
+	w.Write(respBytes)
+
+}
+
+// newPutWorkflowDefinitionAliasInput takes in an http.Request an returns the input struct.
+func newPutWorkflowDefinitionAliasInput(r *http.Request) (*models.PutWorkflowDefinitionAliasInput, error) {
+	var input models.PutWorkflowDefinitionAliasInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		input.WorkflowDefinitionAlias = &models.WorkflowDefinitionAlias{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.WorkflowDefinitionAlias); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForCancelWorkflowDefinitionRollout returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForCancelWorkflowDefinitionRollout(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Conflict:
+		return 409
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.Rollout:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.Conflict:
+		return 409
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.Rollout:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CancelWorkflowDefinitionRolloutHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCancelWorkflowDefinitionRolloutInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.CancelWorkflowDefinitionRollout(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForCancelWorkflowDefinitionRollout(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForCancelWorkflowDefinitionRollout(resp))
+	w.Write(respBytes)
+
+}
+
+// newCancelWorkflowDefinitionRolloutInput takes in an http.Request an returns the input struct.
+func newCancelWorkflowDefinitionRolloutInput(r *http.Request) (*models.CancelWorkflowDefinitionRolloutInput, error) {
+	var input models.CancelWorkflowDefinitionRolloutInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionRollout returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionRollout(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.Rollout:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.Rollout:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionRolloutHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionRolloutInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionRollout(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionRollout(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionRollout(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionRolloutInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionRolloutInput(r *http.Request) (*models.GetWorkflowDefinitionRolloutInput, error) {
+	var input models.GetWorkflowDefinitionRolloutInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForStartWorkflowDefinitionRollout returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForStartWorkflowDefinitionRollout(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Conflict:
+		return 409
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.Rollout:
+		return 201
+
+	case models.BadRequest:
+		return 400
+
+	case models.Conflict:
+		return 409
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.Rollout:
+		return 201
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) StartWorkflowDefinitionRolloutHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newStartWorkflowDefinitionRolloutInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.StartWorkflowDefinitionRollout(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForStartWorkflowDefinitionRollout(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForStartWorkflowDefinitionRollout(resp))
+	w.Write(respBytes)
+
+}
+
+// newStartWorkflowDefinitionRolloutInput takes in an http.Request an returns the input struct.
+func newStartWorkflowDefinitionRolloutInput(r *http.Request) (*models.StartWorkflowDefinitionRolloutInput, error) {
+	var input models.StartWorkflowDefinitionRolloutInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+
+	if len(data) > 0 {
+		input.RolloutRequest = &models.RolloutRequest{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.RolloutRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	return &input, nil
+}
+
+// statusCodeForUnarchiveWorkflowDefinition returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForUnarchiveWorkflowDefinition(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) UnarchiveWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newUnarchiveWorkflowDefinitionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateUnarchiveWorkflowDefinitionInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.UnarchiveWorkflowDefinition(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForUnarchiveWorkflowDefinition(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newUnarchiveWorkflowDefinitionInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newUnarchiveWorkflowDefinitionInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForArchiveWorkflowDefinition returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForArchiveWorkflowDefinition(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) ArchiveWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newArchiveWorkflowDefinitionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateArchiveWorkflowDefinitionInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.ArchiveWorkflowDefinition(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForArchiveWorkflowDefinition(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newArchiveWorkflowDefinitionInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newArchiveWorkflowDefinitionInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForGetWorkflowDefinitionDiff returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionDiff(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionDiff:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionDiff:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionDiffHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionDiffInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionDiff(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionDiff(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionDiff(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionDiffInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionDiffInput(r *http.Request) (*models.GetWorkflowDefinitionDiffInput, error) {
+	var input models.GetWorkflowDefinitionDiffInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	fromStrs := r.URL.Query()["from"]
+	if len(fromStrs) == 0 {
+		return nil, errors.New("query parameter 'from' must be specified")
+	}
+
+	if len(fromStrs) > 0 {
+		var fromTmp int64
+		fromStr := fromStrs[0]
+		fromTmp, err = swag.ConvertInt64(fromStr)
+		if err != nil {
+			return nil, err
+		}
+		input.From = fromTmp
+	}
+
+	toStrs := r.URL.Query()["to"]
+	if len(toStrs) == 0 {
+		return nil, errors.New("query parameter 'to' must be specified")
+	}
+
+	if len(toStrs) > 0 {
+		var toTmp int64
+		toStr := toStrs[0]
+		toTmp, err = swag.ConvertInt64(toStr)
+		if err != nil {
+			return nil, err
+		}
+		input.To = toTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionStateStats returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionStateStats(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionStateStats:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionStateStats:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionStateStatsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionStateStatsInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionStateStats(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionStateStats(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionStateStats(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionStateStatsInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionStateStatsInput(r *http.Request) (*models.GetWorkflowDefinitionStateStatsInput, error) {
+	var input models.GetWorkflowDefinitionStateStatsInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	createdAfterStrs := r.URL.Query()["createdAfter"]
+
+	if len(createdAfterStrs) > 0 {
+		var createdAfterTmp strfmt.DateTime
+		createdAfterStr := createdAfterStrs[0]
+		createdAfterTmp, err = convertDateTime(createdAfterStr)
+		if err != nil {
+			return nil, err
+		}
+		input.CreatedAfter = &createdAfterTmp
+	}
+
+	createdBeforeStrs := r.URL.Query()["createdBefore"]
+
+	if len(createdBeforeStrs) > 0 {
+		var createdBeforeTmp strfmt.DateTime
+		createdBeforeStr := createdBeforeStrs[0]
+		createdBeforeTmp, err = convertDateTime(createdBeforeStr)
+		if err != nil {
+			return nil, err
+		}
+		input.CreatedBefore = &createdBeforeTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionStats returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionStats(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowStats:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowStats:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionStatsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionStatsInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionStats(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionStats(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionStats(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionStatsInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionStatsInput(r *http.Request) (*models.GetWorkflowDefinitionStatsInput, error) {
+	var input models.GetWorkflowDefinitionStatsInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	createdAfterStrs := r.URL.Query()["createdAfter"]
+
+	if len(createdAfterStrs) > 0 {
+		var createdAfterTmp strfmt.DateTime
+		createdAfterStr := createdAfterStrs[0]
+		createdAfterTmp, err = convertDateTime(createdAfterStr)
+		if err != nil {
+			return nil, err
+		}
+		input.CreatedAfter = &createdAfterTmp
+	}
+
+	createdBeforeStrs := r.URL.Query()["createdBefore"]
+
+	if len(createdBeforeStrs) > 0 {
+		var createdBeforeTmp strfmt.DateTime
+		createdBeforeStr := createdBeforeStrs[0]
+		createdBeforeTmp, err = convertDateTime(createdBeforeStr)
+		if err != nil {
+			return nil, err
+		}
+		input.CreatedBefore = &createdBeforeTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForDeleteWorkflowDefinitionVersion returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForDeleteWorkflowDefinitionVersion(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Conflict:
+		return 409
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.Conflict:
+		return 409
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) DeleteWorkflowDefinitionVersionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newDeleteWorkflowDefinitionVersionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.DeleteWorkflowDefinitionVersion(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForDeleteWorkflowDefinitionVersion(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newDeleteWorkflowDefinitionVersionInput takes in an http.Request an returns the input struct.
+func newDeleteWorkflowDefinitionVersionInput(r *http.Request) (*models.DeleteWorkflowDefinitionVersionInput, error) {
+	var input models.DeleteWorkflowDefinitionVersionInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	versionStr := mux.Vars(r)["version"]
+	if len(versionStr) == 0 {
+		return nil, errors.New("path parameter 'version' must be specified")
+	}
+	versionStrs := []string{versionStr}
+
+	if len(versionStrs) > 0 {
+		var versionTmp int64
+		versionStr := versionStrs[0]
+		versionTmp, err = swag.ConvertInt64(versionStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Version = versionTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionByNameAndVersion returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinition:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionByNameAndVersionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
 	}
 
+	resp, err := h.GetWorkflowDefinitionByNameAndVersion(ctx, input)
+
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForGetWorkflowDefinitionVersionsByName(err)
+		statusCode := statusCodeForGetWorkflowDefinitionByNameAndVersion(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -824,14 +4540,14 @@ func (h handler) GetWorkflowDefinitionVersionsByNameHandler(ctx context.Context,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForGetWorkflowDefinitionVersionsByName(resp))
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionByNameAndVersion(resp))
 	w.Write(respBytes)
 
 }
 
-// newGetWorkflowDefinitionVersionsByNameInput takes in an http.Request an returns the input struct.
-func newGetWorkflowDefinitionVersionsByNameInput(r *http.Request) (*models.GetWorkflowDefinitionVersionsByNameInput, error) {
-	var input models.GetWorkflowDefinitionVersionsByNameInput
+// newGetWorkflowDefinitionByNameAndVersionInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionByNameAndVersionInput(r *http.Request) (*models.GetWorkflowDefinitionByNameAndVersionInput, error) {
+	var input models.GetWorkflowDefinitionByNameAndVersionInput
 
 	var err error
 	_ = err
@@ -852,27 +4568,28 @@ func newGetWorkflowDefinitionVersionsByNameInput(r *http.Request) (*models.GetWo
 		input.Name = nameTmp
 	}
 
-	latestStrs := r.URL.Query()["latest"]
-
-	if len(latestStrs) == 0 {
-		latestStrs = []string{"true"}
+	versionStr := mux.Vars(r)["version"]
+	if len(versionStr) == 0 {
+		return nil, errors.New("path parameter 'version' must be specified")
 	}
-	if len(latestStrs) > 0 {
-		var latestTmp bool
-		latestStr := latestStrs[0]
-		latestTmp, err = strconv.ParseBool(latestStr)
+	versionStrs := []string{versionStr}
+
+	if len(versionStrs) > 0 {
+		var versionTmp int64
+		versionStr := versionStrs[0]
+		versionTmp, err = swag.ConvertInt64(versionStr)
 		if err != nil {
 			return nil, err
 		}
-		input.Latest = &latestTmp
+		input.Version = versionTmp
 	}
 
 	return &input, nil
 }
 
-// statusCodeForUpdateWorkflowDefinition returns the status code corresponding to the returned
+// statusCodeForExportWorkflowDefinition returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForUpdateWorkflowDefinition(obj interface{}) int {
+func statusCodeForExportWorkflowDefinition(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -885,8 +4602,8 @@ func statusCodeForUpdateWorkflowDefinition(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
-	case *models.WorkflowDefinition:
-		return 201
+	case *models.StateMachineExport:
+		return 200
 
 	case models.BadRequest:
 		return 400
@@ -897,17 +4614,17 @@ func statusCodeForUpdateWorkflowDefinition(obj interface{}) int {
 	case models.NotFound:
 		return 404
 
-	case models.WorkflowDefinition:
-		return 201
+	case models.StateMachineExport:
+		return 200
 
 	default:
 		return -1
 	}
 }
 
-func (h handler) UpdateWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) ExportWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newUpdateWorkflowDefinitionInput(r)
+	input, err := newExportWorkflowDefinitionInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
@@ -922,14 +4639,14 @@ func (h handler) UpdateWorkflowDefinitionHandler(ctx context.Context, w http.Res
 		return
 	}
 
-	resp, err := h.UpdateWorkflowDefinition(ctx, input)
+	resp, err := h.ExportWorkflowDefinition(ctx, input)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForUpdateWorkflowDefinition(err)
+		statusCode := statusCodeForExportWorkflowDefinition(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -946,27 +4663,156 @@ func (h handler) UpdateWorkflowDefinitionHandler(ctx context.Context, w http.Res
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForUpdateWorkflowDefinition(resp))
+	w.WriteHeader(statusCodeForExportWorkflowDefinition(resp))
 	w.Write(respBytes)
 
 }
 
-// newUpdateWorkflowDefinitionInput takes in an http.Request an returns the input struct.
-func newUpdateWorkflowDefinitionInput(r *http.Request) (*models.UpdateWorkflowDefinitionInput, error) {
-	var input models.UpdateWorkflowDefinitionInput
+// newExportWorkflowDefinitionInput takes in an http.Request an returns the input struct.
+func newExportWorkflowDefinitionInput(r *http.Request) (*models.ExportWorkflowDefinitionInput, error) {
+	var input models.ExportWorkflowDefinitionInput
 
 	var err error
 	_ = err
 
-	data, err := ioutil.ReadAll(r.Body)
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
 
-	if len(data) > 0 {
-		input.NewWorkflowDefinitionRequest = &models.NewWorkflowDefinitionRequest{}
-		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.NewWorkflowDefinitionRequest); err != nil {
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	versionStr := mux.Vars(r)["version"]
+	if len(versionStr) == 0 {
+		return nil, errors.New("path parameter 'version' must be specified")
+	}
+	versionStrs := []string{versionStr}
+
+	if len(versionStrs) > 0 {
+		var versionTmp int64
+		versionStr := versionStrs[0]
+		versionTmp, err = swag.ConvertInt64(versionStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Version = versionTmp
+	}
+
+	namespaceStrs := r.URL.Query()["namespace"]
+	if len(namespaceStrs) == 0 {
+		return nil, errors.New("query parameter 'namespace' must be specified")
+	}
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
 			return nil, err
 		}
+		input.Namespace = namespaceTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionGraph returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionGraph(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.StateMachineGraph:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.StateMachineGraph:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionGraphHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionGraphInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionGraph(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionGraph(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionGraph(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionGraphInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionGraphInput(r *http.Request) (*models.GetWorkflowDefinitionGraphInput, error) {
+	var input models.GetWorkflowDefinitionGraphInput
+
+	var err error
+	_ = err
+
 	nameStr := mux.Vars(r)["name"]
 	if len(nameStr) == 0 {
 		return nil, errors.New("path parameter 'name' must be specified")
@@ -983,12 +4829,43 @@ func newUpdateWorkflowDefinitionInput(r *http.Request) (*models.UpdateWorkflowDe
 		input.Name = nameTmp
 	}
 
+	versionStr := mux.Vars(r)["version"]
+	if len(versionStr) == 0 {
+		return nil, errors.New("path parameter 'version' must be specified")
+	}
+	versionStrs := []string{versionStr}
+
+	if len(versionStrs) > 0 {
+		var versionTmp int64
+		versionStr := versionStrs[0]
+		versionTmp, err = swag.ConvertInt64(versionStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Version = versionTmp
+	}
+
+	formatStrs := r.URL.Query()["format"]
+
+	if len(formatStrs) == 0 {
+		formatStrs = []string{"dot"}
+	}
+	if len(formatStrs) > 0 {
+		var formatTmp string
+		formatStr := formatStrs[0]
+		formatTmp, err = formatStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Format = &formatTmp
+	}
+
 	return &input, nil
 }
 
-// statusCodeForGetWorkflowDefinitionByNameAndVersion returns the status code corresponding to the returned
+// statusCodeForSimulateWorkflowDefinition returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
+func statusCodeForSimulateWorkflowDefinition(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -1001,7 +4878,7 @@ func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
-	case *models.WorkflowDefinition:
+	case *models.SimulationResult:
 		return 200
 
 	case models.BadRequest:
@@ -1013,7 +4890,7 @@ func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
 	case models.NotFound:
 		return 404
 
-	case models.WorkflowDefinition:
+	case models.SimulationResult:
 		return 200
 
 	default:
@@ -1021,9 +4898,9 @@ func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
 	}
 }
 
-func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) SimulateWorkflowDefinitionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newGetWorkflowDefinitionByNameAndVersionInput(r)
+	input, err := newSimulateWorkflowDefinitionInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
@@ -1038,14 +4915,14 @@ func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Contex
 		return
 	}
 
-	resp, err := h.GetWorkflowDefinitionByNameAndVersion(ctx, input)
+	resp, err := h.SimulateWorkflowDefinition(ctx, input)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForGetWorkflowDefinitionByNameAndVersion(err)
+		statusCode := statusCodeForSimulateWorkflowDefinition(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -1062,14 +4939,14 @@ func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Contex
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForGetWorkflowDefinitionByNameAndVersion(resp))
+	w.WriteHeader(statusCodeForSimulateWorkflowDefinition(resp))
 	w.Write(respBytes)
 
 }
 
-// newGetWorkflowDefinitionByNameAndVersionInput takes in an http.Request an returns the input struct.
-func newGetWorkflowDefinitionByNameAndVersionInput(r *http.Request) (*models.GetWorkflowDefinitionByNameAndVersionInput, error) {
-	var input models.GetWorkflowDefinitionByNameAndVersionInput
+// newSimulateWorkflowDefinitionInput takes in an http.Request an returns the input struct.
+func newSimulateWorkflowDefinitionInput(r *http.Request) (*models.SimulateWorkflowDefinitionInput, error) {
+	var input models.SimulateWorkflowDefinitionInput
 
 	var err error
 	_ = err
@@ -1106,6 +4983,15 @@ func newGetWorkflowDefinitionByNameAndVersionInput(r *http.Request) (*models.Get
 		input.Version = versionTmp
 	}
 
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+		input.SimulationRequest = &models.SimulationRequest{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.SimulationRequest); err != nil {
+			return nil, err
+		}
+	}
+
 	return &input, nil
 }
 
@@ -1293,9 +5179,6 @@ func newGetWorkflowsInput(r *http.Request) (*models.GetWorkflowsInput, error) {
 	}
 
 	workflowDefinitionNameStrs := r.URL.Query()["workflowDefinitionName"]
-	if len(workflowDefinitionNameStrs) == 0 {
-		return nil, errors.New("query parameter 'workflowDefinitionName' must be specified")
-	}
 
 	if len(workflowDefinitionNameStrs) > 0 {
 		var workflowDefinitionNameTmp string
@@ -1304,7 +5187,67 @@ func newGetWorkflowsInput(r *http.Request) (*models.GetWorkflowsInput, error) {
 		if err != nil {
 			return nil, err
 		}
-		input.WorkflowDefinitionName = workflowDefinitionNameTmp
+		input.WorkflowDefinitionName = &workflowDefinitionNameTmp
+	}
+
+	namespaceStrs := r.URL.Query()["namespace"]
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = &namespaceTmp
+	}
+
+	queueStrs := r.URL.Query()["queue"]
+
+	if len(queueStrs) > 0 {
+		var queueTmp string
+		queueStr := queueStrs[0]
+		queueTmp, err = queueStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Queue = &queueTmp
+	}
+
+	tagsStrs := r.URL.Query()["tags"]
+
+	if len(tagsStrs) > 0 {
+		var tagsTmp string
+		tagsStr := tagsStrs[0]
+		tagsTmp, err = tagsStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Tags = &tagsTmp
+	}
+
+	createdAfterStrs := r.URL.Query()["createdAfter"]
+
+	if len(createdAfterStrs) > 0 {
+		var createdAfterTmp strfmt.DateTime
+		createdAfterStr := createdAfterStrs[0]
+		createdAfterTmp, err = convertDateTime(createdAfterStr)
+		if err != nil {
+			return nil, err
+		}
+		input.CreatedAfter = &createdAfterTmp
+	}
+
+	createdBeforeStrs := r.URL.Query()["createdBefore"]
+
+	if len(createdBeforeStrs) > 0 {
+		var createdBeforeTmp strfmt.DateTime
+		createdBeforeStr := createdBeforeStrs[0]
+		createdBeforeTmp, err = convertDateTime(createdBeforeStr)
+		if err != nil {
+			return nil, err
+		}
+		input.CreatedBefore = &createdBeforeTmp
 	}
 
 	return &input, nil
@@ -1724,6 +5667,251 @@ func newResumeWorkflowByIDInput(r *http.Request) (*models.ResumeWorkflowByIDInpu
 	return &input, nil
 }
 
+// statusCodeForGetWorkflowGraph returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowGraph(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.StateMachineGraph:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.StateMachineGraph:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowGraphHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowGraphInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowGraph(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowGraph(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowGraph(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowGraphInput takes in an http.Request an returns the input struct.
+func newGetWorkflowGraphInput(r *http.Request) (*models.GetWorkflowGraphInput, error) {
+	var input models.GetWorkflowGraphInput
+
+	var err error
+	_ = err
+
+	workflowIDStr := mux.Vars(r)["workflowID"]
+	if len(workflowIDStr) == 0 {
+		return nil, errors.New("path parameter 'workflowID' must be specified")
+	}
+	workflowIDStrs := []string{workflowIDStr}
+
+	if len(workflowIDStrs) > 0 {
+		var workflowIDTmp string
+		workflowIDStr := workflowIDStrs[0]
+		workflowIDTmp, err = workflowIDStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.WorkflowID = workflowIDTmp
+	}
+
+	formatStrs := r.URL.Query()["format"]
+
+	if len(formatStrs) == 0 {
+		formatStrs = []string{"dot"}
+	}
+	if len(formatStrs) > 0 {
+		var formatTmp string
+		formatStr := formatStrs[0]
+		formatTmp, err = formatStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Format = &formatTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowJob returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowJob(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Job:
+		return 200
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Job:
+		return 200
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowJobHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowJobInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowJob(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowJob(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowJob(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowJobInput takes in an http.Request an returns the input struct.
+func newGetWorkflowJobInput(r *http.Request) (*models.GetWorkflowJobInput, error) {
+	var input models.GetWorkflowJobInput
+
+	var err error
+	_ = err
+
+	workflowIDStr := mux.Vars(r)["workflowID"]
+	if len(workflowIDStr) == 0 {
+		return nil, errors.New("path parameter 'workflowID' must be specified")
+	}
+	workflowIDStrs := []string{workflowIDStr}
+
+	if len(workflowIDStrs) > 0 {
+		var workflowIDTmp string
+		workflowIDStr := workflowIDStrs[0]
+		workflowIDTmp, err = workflowIDStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.WorkflowID = workflowIDTmp
+	}
+
+	jobIDStr := mux.Vars(r)["jobID"]
+	if len(jobIDStr) == 0 {
+		return nil, errors.New("path parameter 'jobID' must be specified")
+	}
+	jobIDStrs := []string{jobIDStr}
+
+	if len(jobIDStrs) > 0 {
+		var jobIDTmp string
+		jobIDStr := jobIDStrs[0]
+		jobIDTmp, err = jobIDStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.JobID = jobIDTmp
+	}
+
+	return &input, nil
+}
+
 // statusCodeForResolveWorkflowByID returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForResolveWorkflowByID(obj interface{}) int {
@@ -46,6 +46,135 @@ func (mr *MockControllerMockRecorder) HealthCheck(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockController)(nil).HealthCheck), ctx)
 }
 
+// GetNamespaceConfigs mocks base method
+func (m *MockController) GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error) {
+	ret := m.ctrl.Call(m, "GetNamespaceConfigs", ctx)
+	ret0, _ := ret[0].([]models.NamespaceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceConfigs indicates an expected call of GetNamespaceConfigs
+func (mr *MockControllerMockRecorder) GetNamespaceConfigs(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceConfigs", reflect.TypeOf((*MockController)(nil).GetNamespaceConfigs), ctx)
+}
+
+// DeleteNamespaceConfig mocks base method
+func (m *MockController) DeleteNamespaceConfig(ctx context.Context, namespace string) error {
+	ret := m.ctrl.Call(m, "DeleteNamespaceConfig", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespaceConfig indicates an expected call of DeleteNamespaceConfig
+func (mr *MockControllerMockRecorder) DeleteNamespaceConfig(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespaceConfig", reflect.TypeOf((*MockController)(nil).DeleteNamespaceConfig), ctx, namespace)
+}
+
+// GetNamespaceConfig mocks base method
+func (m *MockController) GetNamespaceConfig(ctx context.Context, namespace string) (*models.NamespaceConfig, error) {
+	ret := m.ctrl.Call(m, "GetNamespaceConfig", ctx, namespace)
+	ret0, _ := ret[0].(*models.NamespaceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceConfig indicates an expected call of GetNamespaceConfig
+func (mr *MockControllerMockRecorder) GetNamespaceConfig(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceConfig", reflect.TypeOf((*MockController)(nil).GetNamespaceConfig), ctx, namespace)
+}
+
+// PutNamespaceConfig mocks base method
+func (m *MockController) PutNamespaceConfig(ctx context.Context, i *models.PutNamespaceConfigInput) (*models.NamespaceConfig, error) {
+	ret := m.ctrl.Call(m, "PutNamespaceConfig", ctx, i)
+	ret0, _ := ret[0].(*models.NamespaceConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutNamespaceConfig indicates an expected call of PutNamespaceConfig
+func (mr *MockControllerMockRecorder) PutNamespaceConfig(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNamespaceConfig", reflect.TypeOf((*MockController)(nil).PutNamespaceConfig), ctx, i)
+}
+
+// GetNamespaces mocks base method
+func (m *MockController) GetNamespaces(ctx context.Context) ([]models.Namespace, error) {
+	ret := m.ctrl.Call(m, "GetNamespaces", ctx)
+	ret0, _ := ret[0].([]models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaces indicates an expected call of GetNamespaces
+func (mr *MockControllerMockRecorder) GetNamespaces(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaces", reflect.TypeOf((*MockController)(nil).GetNamespaces), ctx)
+}
+
+// DeleteNamespace mocks base method
+func (m *MockController) DeleteNamespace(ctx context.Context, namespace string) error {
+	ret := m.ctrl.Call(m, "DeleteNamespace", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace
+func (mr *MockControllerMockRecorder) DeleteNamespace(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockController)(nil).DeleteNamespace), ctx, namespace)
+}
+
+// GetNamespace mocks base method
+func (m *MockController) GetNamespace(ctx context.Context, namespace string) (*models.Namespace, error) {
+	ret := m.ctrl.Call(m, "GetNamespace", ctx, namespace)
+	ret0, _ := ret[0].(*models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespace indicates an expected call of GetNamespace
+func (mr *MockControllerMockRecorder) GetNamespace(ctx, namespace interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockController)(nil).GetNamespace), ctx, namespace)
+}
+
+// PutNamespace mocks base method
+func (m *MockController) PutNamespace(ctx context.Context, i *models.PutNamespaceInput) (*models.Namespace, error) {
+	ret := m.ctrl.Call(m, "PutNamespace", ctx, i)
+	ret0, _ := ret[0].(*models.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutNamespace indicates an expected call of PutNamespace
+func (mr *MockControllerMockRecorder) PutNamespace(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNamespace", reflect.TypeOf((*MockController)(nil).PutNamespace), ctx, i)
+}
+
+// GetStalledActivities mocks base method
+func (m *MockController) GetStalledActivities(ctx context.Context, i *models.GetStalledActivitiesInput) ([]models.StalledActivity, error) {
+	ret := m.ctrl.Call(m, "GetStalledActivities", ctx, i)
+	ret0, _ := ret[0].([]models.StalledActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStalledActivities indicates an expected call of GetStalledActivities
+func (mr *MockControllerMockRecorder) GetStalledActivities(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStalledActivities", reflect.TypeOf((*MockController)(nil).GetStalledActivities), ctx, i)
+}
+
+// GetStateResources mocks base method
+func (m *MockController) GetStateResources(ctx context.Context, i *models.GetStateResourcesInput) ([]models.StateResource, string, error) {
+	ret := m.ctrl.Call(m, "GetStateResources", ctx, i)
+	ret0, _ := ret[0].([]models.StateResource)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStateResources indicates an expected call of GetStateResources
+func (mr *MockControllerMockRecorder) GetStateResources(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateResources", reflect.TypeOf((*MockController)(nil).GetStateResources), ctx, i)
+}
+
 // PostStateResource mocks base method
 func (m *MockController) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
 	ret := m.ctrl.Call(m, "PostStateResource", ctx, i)
@@ -97,17 +226,108 @@ func (mr *MockControllerMockRecorder) PutStateResource(ctx, i interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStateResource", reflect.TypeOf((*MockController)(nil).PutStateResource), ctx, i)
 }
 
+// GetActivityWorkers mocks base method
+func (m *MockController) GetActivityWorkers(ctx context.Context, i *models.GetActivityWorkersInput) ([]models.ActivityWorker, error) {
+	ret := m.ctrl.Call(m, "GetActivityWorkers", ctx, i)
+	ret0, _ := ret[0].([]models.ActivityWorker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityWorkers indicates an expected call of GetActivityWorkers
+func (mr *MockControllerMockRecorder) GetActivityWorkers(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityWorkers", reflect.TypeOf((*MockController)(nil).GetActivityWorkers), ctx, i)
+}
+
+// GetWorkflowDefinitionTemplates mocks base method
+func (m *MockController) GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionTemplates", ctx)
+	ret0, _ := ret[0].([]models.WorkflowDefinitionTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionTemplates indicates an expected call of GetWorkflowDefinitionTemplates
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionTemplates(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionTemplates", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionTemplates), ctx)
+}
+
+// GetWorkflowDefinitionTemplate mocks base method
+func (m *MockController) GetWorkflowDefinitionTemplate(ctx context.Context, name string) (*models.WorkflowDefinitionTemplate, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionTemplate", ctx, name)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionTemplate indicates an expected call of GetWorkflowDefinitionTemplate
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionTemplate(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionTemplate", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionTemplate), ctx, name)
+}
+
+// PutWorkflowDefinitionTemplate mocks base method
+func (m *MockController) PutWorkflowDefinitionTemplate(ctx context.Context, i *models.PutWorkflowDefinitionTemplateInput) (*models.WorkflowDefinitionTemplate, error) {
+	ret := m.ctrl.Call(m, "PutWorkflowDefinitionTemplate", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWorkflowDefinitionTemplate indicates an expected call of PutWorkflowDefinitionTemplate
+func (mr *MockControllerMockRecorder) PutWorkflowDefinitionTemplate(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWorkflowDefinitionTemplate", reflect.TypeOf((*MockController)(nil).PutWorkflowDefinitionTemplate), ctx, i)
+}
+
+// GetWorkflowDefinitionTemplateInstances mocks base method
+func (m *MockController) GetWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionTemplateInstances", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionTemplateInstances indicates an expected call of GetWorkflowDefinitionTemplateInstances
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionTemplateInstances(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionTemplateInstances", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionTemplateInstances), ctx, name)
+}
+
+// InstantiateWorkflowDefinitionTemplate mocks base method
+func (m *MockController) InstantiateWorkflowDefinitionTemplate(ctx context.Context, i *models.InstantiateWorkflowDefinitionTemplateInput) (*models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "InstantiateWorkflowDefinitionTemplate", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateWorkflowDefinitionTemplate indicates an expected call of InstantiateWorkflowDefinitionTemplate
+func (mr *MockControllerMockRecorder) InstantiateWorkflowDefinitionTemplate(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateWorkflowDefinitionTemplate", reflect.TypeOf((*MockController)(nil).InstantiateWorkflowDefinitionTemplate), ctx, i)
+}
+
+// RegenerateWorkflowDefinitionTemplateInstances mocks base method
+func (m *MockController) RegenerateWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "RegenerateWorkflowDefinitionTemplateInstances", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateWorkflowDefinitionTemplateInstances indicates an expected call of RegenerateWorkflowDefinitionTemplateInstances
+func (mr *MockControllerMockRecorder) RegenerateWorkflowDefinitionTemplateInstances(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateWorkflowDefinitionTemplateInstances", reflect.TypeOf((*MockController)(nil).RegenerateWorkflowDefinitionTemplateInstances), ctx, name)
+}
+
 // GetWorkflowDefinitions mocks base method
-func (m *MockController) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
-	ret := m.ctrl.Call(m, "GetWorkflowDefinitions", ctx)
+func (m *MockController) GetWorkflowDefinitions(ctx context.Context, i *models.GetWorkflowDefinitionsInput) ([]models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitions", ctx, i)
 	ret0, _ := ret[0].([]models.WorkflowDefinition)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetWorkflowDefinitions indicates an expected call of GetWorkflowDefinitions
-func (mr *MockControllerMockRecorder) GetWorkflowDefinitions(ctx interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitions", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitions), ctx)
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitions(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitions", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitions), ctx, i)
 }
 
 // NewWorkflowDefinition mocks base method
@@ -123,6 +343,32 @@ func (mr *MockControllerMockRecorder) NewWorkflowDefinition(ctx, i interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkflowDefinition", reflect.TypeOf((*MockController)(nil).NewWorkflowDefinition), ctx, i)
 }
 
+// ImportWorkflowDefinition mocks base method
+func (m *MockController) ImportWorkflowDefinition(ctx context.Context, i *models.ImportWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
+	ret := m.ctrl.Call(m, "ImportWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportWorkflowDefinition indicates an expected call of ImportWorkflowDefinition
+func (mr *MockControllerMockRecorder) ImportWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowDefinition", reflect.TypeOf((*MockController)(nil).ImportWorkflowDefinition), ctx, i)
+}
+
+// LintWorkflowDefinition mocks base method
+func (m *MockController) LintWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.DefinitionLintResult, error) {
+	ret := m.ctrl.Call(m, "LintWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.DefinitionLintResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LintWorkflowDefinition indicates an expected call of LintWorkflowDefinition
+func (mr *MockControllerMockRecorder) LintWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LintWorkflowDefinition", reflect.TypeOf((*MockController)(nil).LintWorkflowDefinition), ctx, i)
+}
+
 // GetWorkflowDefinitionVersionsByName mocks base method
 func (m *MockController) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *models.GetWorkflowDefinitionVersionsByNameInput) ([]models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitionVersionsByName", ctx, i)
@@ -149,6 +395,171 @@ func (mr *MockControllerMockRecorder) UpdateWorkflowDefinition(ctx, i interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowDefinition", reflect.TypeOf((*MockController)(nil).UpdateWorkflowDefinition), ctx, i)
 }
 
+// GetWorkflowDefinitionAliases mocks base method
+func (m *MockController) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionAliases", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionAliases indicates an expected call of GetWorkflowDefinitionAliases
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionAliases(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionAliases", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionAliases), ctx, name)
+}
+
+// DeleteWorkflowDefinitionAlias mocks base method
+func (m *MockController) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionAlias indicates an expected call of DeleteWorkflowDefinitionAlias
+func (mr *MockControllerMockRecorder) DeleteWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionAlias", reflect.TypeOf((*MockController)(nil).DeleteWorkflowDefinitionAlias), ctx, i)
+}
+
+// GetWorkflowDefinitionAlias mocks base method
+func (m *MockController) GetWorkflowDefinitionAlias(ctx context.Context, i *models.GetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionAlias indicates an expected call of GetWorkflowDefinitionAlias
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionAlias", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionAlias), ctx, i)
+}
+
+// PutWorkflowDefinitionAlias mocks base method
+func (m *MockController) PutWorkflowDefinitionAlias(ctx context.Context, i *models.PutWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "PutWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWorkflowDefinitionAlias indicates an expected call of PutWorkflowDefinitionAlias
+func (mr *MockControllerMockRecorder) PutWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWorkflowDefinitionAlias", reflect.TypeOf((*MockController)(nil).PutWorkflowDefinitionAlias), ctx, i)
+}
+
+// CancelWorkflowDefinitionRollout mocks base method
+func (m *MockController) CancelWorkflowDefinitionRollout(ctx context.Context, i *models.CancelWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	ret := m.ctrl.Call(m, "CancelWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.Rollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelWorkflowDefinitionRollout indicates an expected call of CancelWorkflowDefinitionRollout
+func (mr *MockControllerMockRecorder) CancelWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelWorkflowDefinitionRollout", reflect.TypeOf((*MockController)(nil).CancelWorkflowDefinitionRollout), ctx, i)
+}
+
+// GetWorkflowDefinitionRollout mocks base method
+func (m *MockController) GetWorkflowDefinitionRollout(ctx context.Context, i *models.GetWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.Rollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionRollout indicates an expected call of GetWorkflowDefinitionRollout
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionRollout", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionRollout), ctx, i)
+}
+
+// StartWorkflowDefinitionRollout mocks base method
+func (m *MockController) StartWorkflowDefinitionRollout(ctx context.Context, i *models.StartWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	ret := m.ctrl.Call(m, "StartWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.Rollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartWorkflowDefinitionRollout indicates an expected call of StartWorkflowDefinitionRollout
+func (mr *MockControllerMockRecorder) StartWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartWorkflowDefinitionRollout", reflect.TypeOf((*MockController)(nil).StartWorkflowDefinitionRollout), ctx, i)
+}
+
+// UnarchiveWorkflowDefinition mocks base method
+func (m *MockController) UnarchiveWorkflowDefinition(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "UnarchiveWorkflowDefinition", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveWorkflowDefinition indicates an expected call of UnarchiveWorkflowDefinition
+func (mr *MockControllerMockRecorder) UnarchiveWorkflowDefinition(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveWorkflowDefinition", reflect.TypeOf((*MockController)(nil).UnarchiveWorkflowDefinition), ctx, name)
+}
+
+// ArchiveWorkflowDefinition mocks base method
+func (m *MockController) ArchiveWorkflowDefinition(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "ArchiveWorkflowDefinition", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveWorkflowDefinition indicates an expected call of ArchiveWorkflowDefinition
+func (mr *MockControllerMockRecorder) ArchiveWorkflowDefinition(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveWorkflowDefinition", reflect.TypeOf((*MockController)(nil).ArchiveWorkflowDefinition), ctx, name)
+}
+
+// GetWorkflowDefinitionDiff mocks base method
+func (m *MockController) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionDiff", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionDiff indicates an expected call of GetWorkflowDefinitionDiff
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionDiff(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionDiff", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionDiff), ctx, i)
+}
+
+// GetWorkflowDefinitionStateStats mocks base method
+func (m *MockController) GetWorkflowDefinitionStateStats(ctx context.Context, i *models.GetWorkflowDefinitionStateStatsInput) (*models.WorkflowDefinitionStateStats, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionStateStats", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionStateStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionStateStats indicates an expected call of GetWorkflowDefinitionStateStats
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionStateStats(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionStateStats", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionStateStats), ctx, i)
+}
+
+// GetWorkflowDefinitionStats mocks base method
+func (m *MockController) GetWorkflowDefinitionStats(ctx context.Context, i *models.GetWorkflowDefinitionStatsInput) (*models.WorkflowStats, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionStats", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionStats indicates an expected call of GetWorkflowDefinitionStats
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionStats(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionStats", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionStats), ctx, i)
+}
+
+// DeleteWorkflowDefinitionVersion mocks base method
+func (m *MockController) DeleteWorkflowDefinitionVersion(ctx context.Context, i *models.DeleteWorkflowDefinitionVersionInput) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionVersion", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionVersion indicates an expected call of DeleteWorkflowDefinitionVersion
+func (mr *MockControllerMockRecorder) DeleteWorkflowDefinitionVersion(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionVersion", reflect.TypeOf((*MockController)(nil).DeleteWorkflowDefinitionVersion), ctx, i)
+}
+
 // GetWorkflowDefinitionByNameAndVersion mocks base method
 func (m *MockController) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitionByNameAndVersion", ctx, i)
@@ -162,6 +573,45 @@ func (mr *MockControllerMockRecorder) GetWorkflowDefinitionByNameAndVersion(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionByNameAndVersion", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionByNameAndVersion), ctx, i)
 }
 
+// ExportWorkflowDefinition mocks base method
+func (m *MockController) ExportWorkflowDefinition(ctx context.Context, i *models.ExportWorkflowDefinitionInput) (*models.StateMachineExport, error) {
+	ret := m.ctrl.Call(m, "ExportWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.StateMachineExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowDefinition indicates an expected call of ExportWorkflowDefinition
+func (mr *MockControllerMockRecorder) ExportWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowDefinition", reflect.TypeOf((*MockController)(nil).ExportWorkflowDefinition), ctx, i)
+}
+
+// GetWorkflowDefinitionGraph mocks base method
+func (m *MockController) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.StateMachineGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionGraph", ctx, i)
+	ret0, _ := ret[0].(*models.StateMachineGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionGraph indicates an expected call of GetWorkflowDefinitionGraph
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionGraph", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionGraph), ctx, i)
+}
+
+// SimulateWorkflowDefinition mocks base method
+func (m *MockController) SimulateWorkflowDefinition(ctx context.Context, i *models.SimulateWorkflowDefinitionInput) (*models.SimulationResult, error) {
+	ret := m.ctrl.Call(m, "SimulateWorkflowDefinition", ctx, i)
+	ret0, _ := ret[0].(*models.SimulationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateWorkflowDefinition indicates an expected call of SimulateWorkflowDefinition
+func (mr *MockControllerMockRecorder) SimulateWorkflowDefinition(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateWorkflowDefinition", reflect.TypeOf((*MockController)(nil).SimulateWorkflowDefinition), ctx, i)
+}
+
 // GetWorkflows mocks base method
 func (m *MockController) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, string, error) {
 	ret := m.ctrl.Call(m, "GetWorkflows", ctx, i)
@@ -227,6 +677,32 @@ func (mr *MockControllerMockRecorder) ResumeWorkflowByID(ctx, i interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowByID", reflect.TypeOf((*MockController)(nil).ResumeWorkflowByID), ctx, i)
 }
 
+// GetWorkflowGraph mocks base method
+func (m *MockController) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.StateMachineGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowGraph", ctx, i)
+	ret0, _ := ret[0].(*models.StateMachineGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowGraph indicates an expected call of GetWorkflowGraph
+func (mr *MockControllerMockRecorder) GetWorkflowGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowGraph", reflect.TypeOf((*MockController)(nil).GetWorkflowGraph), ctx, i)
+}
+
+// GetWorkflowJob mocks base method
+func (m *MockController) GetWorkflowJob(ctx context.Context, i *models.GetWorkflowJobInput) (*models.Job, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowJob", ctx, i)
+	ret0, _ := ret[0].(*models.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowJob indicates an expected call of GetWorkflowJob
+func (mr *MockControllerMockRecorder) GetWorkflowJob(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowJob", reflect.TypeOf((*MockController)(nil).GetWorkflowJob), ctx, i)
+}
+
 // ResolveWorkflowByID mocks base method
 func (m *MockController) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
 	ret := m.ctrl.Call(m, "ResolveWorkflowByID", ctx, workflowID)
@@ -112,6 +112,76 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/namespace-configs").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getNamespaceConfigs")
+		h.GetNamespaceConfigsHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getNamespaceConfigs")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/namespace-configs/{namespace}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "deleteNamespaceConfig")
+		h.DeleteNamespaceConfigHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "deleteNamespaceConfig")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/namespace-configs/{namespace}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getNamespaceConfig")
+		h.GetNamespaceConfigHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getNamespaceConfig")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("PUT").Path("/namespace-configs/{namespace}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "putNamespaceConfig")
+		h.PutNamespaceConfigHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "putNamespaceConfig")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/namespaces").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getNamespaces")
+		h.GetNamespacesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getNamespaces")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/namespaces/{namespace}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "deleteNamespace")
+		h.DeleteNamespaceHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "deleteNamespace")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/namespaces/{namespace}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getNamespace")
+		h.GetNamespaceHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getNamespace")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("PUT").Path("/namespaces/{namespace}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "putNamespace")
+		h.PutNamespaceHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "putNamespace")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/stalled-activities").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getStalledActivities")
+		h.GetStalledActivitiesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getStalledActivities")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/state-resources").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getStateResources")
+		h.GetStateResourcesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getStateResources")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("POST").Path("/state-resources").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "postStateResource")
 		h.PostStateResourceHandler(r.Context(), w, r)
@@ -140,6 +210,55 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/state-resources/{namespace}/{name}/workers").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getActivityWorkers")
+		h.GetActivityWorkersHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getActivityWorkers")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definition-templates").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionTemplates")
+		h.GetWorkflowDefinitionTemplatesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionTemplates")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definition-templates/{name}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionTemplate")
+		h.GetWorkflowDefinitionTemplateHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionTemplate")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("PUT").Path("/workflow-definition-templates/{name}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "putWorkflowDefinitionTemplate")
+		h.PutWorkflowDefinitionTemplateHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "putWorkflowDefinitionTemplate")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definition-templates/{name}/instances").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionTemplateInstances")
+		h.GetWorkflowDefinitionTemplateInstancesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionTemplateInstances")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workflow-definition-templates/{name}/instances").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "instantiateWorkflowDefinitionTemplate")
+		h.InstantiateWorkflowDefinitionTemplateHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "instantiateWorkflowDefinitionTemplate")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workflow-definition-templates/{name}/regenerate").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "regenerateWorkflowDefinitionTemplateInstances")
+		h.RegenerateWorkflowDefinitionTemplateInstancesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "regenerateWorkflowDefinitionTemplateInstances")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflow-definitions").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitions")
 		h.GetWorkflowDefinitionsHandler(r.Context(), w, r)
@@ -154,6 +273,20 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("POST").Path("/workflow-definitions/import").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "importWorkflowDefinition")
+		h.ImportWorkflowDefinitionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "importWorkflowDefinition")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workflow-definitions/lint").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "lintWorkflowDefinition")
+		h.LintWorkflowDefinitionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "lintWorkflowDefinition")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflow-definitions/{name}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionVersionsByName")
 		h.GetWorkflowDefinitionVersionsByNameHandler(r.Context(), w, r)
@@ -168,6 +301,97 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workflow-definitions/{name}/aliases").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionAliases")
+		h.GetWorkflowDefinitionAliasesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionAliases")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/workflow-definitions/{name}/aliases/{alias}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "deleteWorkflowDefinitionAlias")
+		h.DeleteWorkflowDefinitionAliasHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "deleteWorkflowDefinitionAlias")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/aliases/{alias}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionAlias")
+		h.GetWorkflowDefinitionAliasHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionAlias")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("PUT").Path("/workflow-definitions/{name}/aliases/{alias}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "putWorkflowDefinitionAlias")
+		h.PutWorkflowDefinitionAliasHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "putWorkflowDefinitionAlias")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/workflow-definitions/{name}/aliases/{alias}/rollout").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "cancelWorkflowDefinitionRollout")
+		h.CancelWorkflowDefinitionRolloutHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "cancelWorkflowDefinitionRollout")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/aliases/{alias}/rollout").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionRollout")
+		h.GetWorkflowDefinitionRolloutHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionRollout")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workflow-definitions/{name}/aliases/{alias}/rollout").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "startWorkflowDefinitionRollout")
+		h.StartWorkflowDefinitionRolloutHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "startWorkflowDefinitionRollout")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/workflow-definitions/{name}/archive").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "unarchiveWorkflowDefinition")
+		h.UnarchiveWorkflowDefinitionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "unarchiveWorkflowDefinition")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workflow-definitions/{name}/archive").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "archiveWorkflowDefinition")
+		h.ArchiveWorkflowDefinitionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "archiveWorkflowDefinition")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/diff").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionDiff")
+		h.GetWorkflowDefinitionDiffHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionDiff")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/state-stats").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionStateStats")
+		h.GetWorkflowDefinitionStateStatsHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionStateStats")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/stats").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionStats")
+		h.GetWorkflowDefinitionStatsHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionStats")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/workflow-definitions/{name}/{version}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "deleteWorkflowDefinitionVersion")
+		h.DeleteWorkflowDefinitionVersionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "deleteWorkflowDefinitionVersion")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflow-definitions/{name}/{version}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionByNameAndVersion")
 		h.GetWorkflowDefinitionByNameAndVersionHandler(r.Context(), w, r)
@@ -175,6 +399,27 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workflow-definitions/{name}/{version}/export").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "exportWorkflowDefinition")
+		h.ExportWorkflowDefinitionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "exportWorkflowDefinition")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/{version}/graph").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionGraph")
+		h.GetWorkflowDefinitionGraphHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionGraph")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workflow-definitions/{name}/{version}/simulate").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "simulateWorkflowDefinition")
+		h.SimulateWorkflowDefinitionHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "simulateWorkflowDefinition")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflows").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflows")
 		h.GetWorkflowsHandler(r.Context(), w, r)
@@ -210,6 +455,20 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workflows/{workflowID}/graph").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowGraph")
+		h.GetWorkflowGraphHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowGraph")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflows/{workflowID}/jobs/{jobID}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowJob")
+		h.GetWorkflowJobHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowJob")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("POST").Path("/workflows/{workflowID}/resolved").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "resolveWorkflowByID")
 		h.ResolveWorkflowByIDHandler(r.Context(), w, r)
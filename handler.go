@@ -2,9 +2,16 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
 
 	"github.com/Clever/workflow-manager/executor"
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -12,10 +19,43 @@ import (
 	"github.com/Clever/workflow-manager/store"
 )
 
+var accountIDRegex = regexp.MustCompile(`^[0-9]{12}$`)
+
 // Handler implements the wag Controller
 type Handler struct {
 	store   store.Store
 	manager executor.WorkflowManager
+	// accountID is the account state machines run in for namespaces that don't configure one
+	accountID string
+	// requireRegisteredNamespaces rejects workflows, state resources and namespace configs in
+	// namespaces that weren't registered with PutNamespace. It is on unless
+	// ALLOW_UNREGISTERED_NAMESPACES is set while the namespaces in use are being registered.
+	requireRegisteredNamespaces bool
+	// random splits the workflows started through an alias between its version and its canary
+	// version. It's shared by concurrent requests, so its source must be safe for concurrent use.
+	random *rand.Rand
+}
+
+// lockedSource is a rand.Source that's safe for concurrent use
+type lockedSource struct {
+	mu     sync.Mutex
+	source rand.Source
+}
+
+func newLockedSource(seed int64) *lockedSource {
+	return &lockedSource{source: rand.NewSource(seed)}
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.source.Int63()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.source.Seed(seed)
 }
 
 // HealthCheck returns 200 if workflow-manager can respond to requests
@@ -25,7 +65,6 @@ func (h Handler) HealthCheck(ctx context.Context) error {
 
 // NewWorkflowDefinition creates a new workflow definition
 func (h Handler) NewWorkflowDefinition(ctx context.Context, workflowDefReq *models.NewWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
-	//TODO: validate states
 	if len(workflowDefReq.StateMachine.States) == 0 {
 		return nil, fmt.Errorf("Must define at least one state")
 	}
@@ -39,12 +78,65 @@ func (h Handler) NewWorkflowDefinition(ctx context.Context, workflowDefReq *mode
 	}
 
 	if err := h.store.SaveWorkflowDefinition(ctx, *workflowDef); err != nil {
-		return nil, err
+		if _, ok := err.(models.Conflict); !ok {
+			return nil, err
+		}
+		// a definition whose versions were all deleted is numbered after them
+		if _, latestErr := h.store.LatestWorkflowDefinition(ctx, workflowDef.Name); latestErr == nil {
+			return nil, err
+		}
+		updated, err := h.store.UpdateWorkflowDefinition(ctx, *workflowDef)
+		if err != nil {
+			return nil, err
+		}
+		return &updated, nil
 	}
 
 	return workflowDef, nil
 }
 
+// ImportWorkflowDefinition creates a new workflow definition from AWS States Language JSON,
+// converting activity and lambda ARNs back to shorthand
+func (h Handler) ImportWorkflowDefinition(ctx context.Context, req *models.ImportWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
+	if req.Name == nil || *req.Name == "" {
+		return nil, models.BadRequest{Message: "name is required"}
+	}
+	if req.Definition == nil {
+		return nil, models.BadRequest{Message: "definition is required"}
+	}
+
+	var awsStateMachine models.SLStateMachine
+	decoder := json.NewDecoder(strings.NewReader(*req.Definition))
+	// fields that the state machine model doesn't know about would be silently dropped
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&awsStateMachine); err != nil {
+		return nil, models.BadRequest{Message: fmt.Sprintf("definition is not a supported state machine: %s", err)}
+	}
+	stateMachine, err := executor.StateMachineFromAWS(awsStateMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.NewWorkflowDefinition(ctx, &models.NewWorkflowDefinitionRequest{
+		Name:         *req.Name,
+		Manager:      req.Manager,
+		StateMachine: stateMachine,
+		InputSchema:  req.InputSchema,
+		DefaultInput: req.DefaultInput,
+	})
+}
+
+// LintWorkflowDefinition checks a workflow definition for errors and risky patterns without saving it
+func (h Handler) LintWorkflowDefinition(ctx context.Context, workflowDefReq *models.NewWorkflowDefinitionRequest) (*models.DefinitionLintResult, error) {
+	if workflowDefReq == nil || workflowDefReq.StateMachine == nil {
+		return nil, models.BadRequest{Message: "stateMachine is required"}
+	}
+	return &models.DefinitionLintResult{
+		Errors:   resources.DefinitionProblems(resources.ValidateStateMachine(*workflowDefReq.StateMachine)),
+		Warnings: resources.DefinitionProblems(resources.LintStateMachine(*workflowDefReq.StateMachine)),
+	}, nil
+}
+
 // UpdateWorkflowDefinition creates a new version for an existing workflow
 func (h Handler) UpdateWorkflowDefinition(ctx context.Context, input *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error) {
 	workflowReq := input.NewWorkflowDefinitionRequest
@@ -55,10 +147,21 @@ func (h Handler) UpdateWorkflowDefinition(ctx context.Context, input *models.Upd
 		return &models.WorkflowDefinition{}, fmt.Errorf("Must define at least one state")
 	}
 
+	latest, err := h.store.LatestWorkflowDefinition(ctx, input.Name)
+	if err != nil {
+		return &models.WorkflowDefinition{}, err
+	}
+	if latest.Archived {
+		return &models.WorkflowDefinition{}, models.BadRequest{
+			Message: fmt.Sprintf("workflow definition %s is archived", input.Name),
+		}
+	}
+
 	workflow, err := newWorkflowDefinitionFromRequest(*workflowReq)
 	if err != nil {
 		return &models.WorkflowDefinition{}, err
 	}
+	resources.InheritWorkflowDefinitionMetadata(workflow, latest)
 
 	updatedWorkflow, err := h.store.UpdateWorkflowDefinition(ctx, *workflow)
 	if err != nil {
@@ -68,9 +171,27 @@ func (h Handler) UpdateWorkflowDefinition(ctx context.Context, input *models.Upd
 	return &updatedWorkflow, nil
 }
 
-// GetWorkflowDefinitions retrieves a list of the latest version of each workflow
-func (h Handler) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
-	return h.store.GetWorkflowDefinitions(ctx)
+// GetWorkflowDefinitions retrieves a list of the latest version of each workflow,
+// optionally filtered by owner, label or text
+func (h Handler) GetWorkflowDefinitions(ctx context.Context, input *models.GetWorkflowDefinitionsInput) ([]models.WorkflowDefinition, error) {
+	query, err := resources.NewWorkflowDefinitionQuery(
+		aws.StringValue(input.Team), aws.StringValue(input.Label), aws.StringValue(input.Search),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defs, err := h.store.GetWorkflowDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matches := []models.WorkflowDefinition{}
+	for _, def := range defs {
+		if query.Matches(def) {
+			matches = append(matches, def)
+		}
+	}
+	return matches, nil
 }
 
 // GetWorkflowDefinitionVersionsByName fetches either:
@@ -96,9 +217,500 @@ func (h Handler) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, inpu
 	return &wfd, nil
 }
 
+// DeleteWorkflowDefinitionVersion deletes a version of a WorkflowDefinition that no workflows
+// were started with, along with its state machines
+func (h Handler) DeleteWorkflowDefinitionVersion(ctx context.Context, i *models.DeleteWorkflowDefinitionVersionInput) error {
+	wfd, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(i.Version))
+	if err != nil {
+		return err
+	}
+	aliases, err := h.store.GetWorkflowDefinitionAliases(ctx, i.Name)
+	if err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		if alias.Version == i.Version || (alias.CanaryVersion != nil && *alias.CanaryVersion == i.Version) {
+			return models.Conflict{
+				Message: fmt.Sprintf("alias %s points at %s version %d", alias.Alias, i.Name, i.Version),
+			}
+		}
+	}
+	if err := h.store.DeleteWorkflowDefinition(ctx, i.Name, int(i.Version)); err != nil {
+		return err
+	}
+	return h.manager.DeleteStateMachines(ctx, wfd)
+}
+
+// ArchiveWorkflowDefinition hides a WorkflowDefinition from GetWorkflowDefinitions and stops it
+// from starting workflows. Its versions and workflows stay readable, but its state machines are
+// deleted.
+func (h Handler) ArchiveWorkflowDefinition(ctx context.Context, name string) error {
+	versions, err := h.store.GetWorkflowDefinitionVersions(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := h.store.ArchiveWorkflowDefinition(ctx, name, true); err != nil {
+		return err
+	}
+	for _, wfd := range versions {
+		if err := h.manager.DeleteStateMachines(ctx, wfd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnarchiveWorkflowDefinition restores an archived WorkflowDefinition
+func (h Handler) UnarchiveWorkflowDefinition(ctx context.Context, name string) error {
+	return h.store.ArchiveWorkflowDefinition(ctx, name, false)
+}
+
+// GetWorkflowDefinitionAliases returns the aliases of a WorkflowDefinition
+func (h Handler) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	return h.store.GetWorkflowDefinitionAliases(ctx, name)
+}
+
+// GetWorkflowDefinitionAlias returns the versions that an alias of a WorkflowDefinition points at
+func (h Handler) GetWorkflowDefinitionAlias(ctx context.Context, i *models.GetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	alias, err := h.store.GetWorkflowDefinitionAlias(ctx, i.Name, i.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return &alias, nil
+}
+
+// PutWorkflowDefinitionAlias points an alias of a WorkflowDefinition at a version, or splits it
+// between a version and a canary version
+func (h Handler) PutWorkflowDefinitionAlias(ctx context.Context, i *models.PutWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	if i.WorkflowDefinitionAlias == nil {
+		return &models.WorkflowDefinitionAlias{}, models.BadRequest{Message: "missing WorkflowDefinitionAlias"}
+	}
+	alias := *i.WorkflowDefinitionAlias
+	if (alias.Name != "" && alias.Name != i.Name) || (alias.Alias != "" && alias.Alias != i.Alias) {
+		return &models.WorkflowDefinitionAlias{}, models.BadRequest{
+			Message: "WorkflowDefinitionAlias name and alias do not match the path",
+		}
+	}
+	alias.Name = i.Name
+	alias.Alias = i.Alias
+	if err := resources.ValidateWorkflowDefinitionAlias(alias); err != nil {
+		return &models.WorkflowDefinitionAlias{}, err
+	}
+
+	// rollouts are only changed through the rollout endpoints
+	alias.Rollout = nil
+	existing, err := h.store.GetWorkflowDefinitionAlias(ctx, alias.Name, alias.Alias)
+	exists := err == nil
+	if exists {
+		if existing.Rollout != nil && existing.Rollout.Status == models.RolloutStatusRunning {
+			return &models.WorkflowDefinitionAlias{}, models.Conflict{
+				Message: fmt.Sprintf("alias %s has a rollout in progress", alias.Alias),
+			}
+		}
+		alias.Rollout = existing.Rollout
+		// the update fails if a rollout step changed the alias since it was read
+		alias.LastUpdated = existing.LastUpdated
+	} else if _, ok := err.(models.NotFound); !ok {
+		return &models.WorkflowDefinitionAlias{}, err
+	}
+
+	versions := []int64{alias.Version}
+	if alias.CanaryVersion != nil {
+		versions = append(versions, *alias.CanaryVersion)
+	}
+	for _, version := range versions {
+		if _, err := h.store.GetWorkflowDefinition(ctx, alias.Name, int(version)); err != nil {
+			return &models.WorkflowDefinitionAlias{}, err
+		}
+	}
+
+	if exists {
+		err = h.store.UpdateWorkflowDefinitionAlias(ctx, alias)
+	} else {
+		err = h.store.SaveWorkflowDefinitionAlias(ctx, alias)
+	}
+	if err != nil {
+		return &models.WorkflowDefinitionAlias{}, err
+	}
+	saved, err := h.store.GetWorkflowDefinitionAlias(ctx, alias.Name, alias.Alias)
+	if err != nil {
+		return &models.WorkflowDefinitionAlias{}, err
+	}
+	return &saved, nil
+}
+
+// GetWorkflowDefinitionRollout returns the current or last rollout of an alias
+func (h Handler) GetWorkflowDefinitionRollout(ctx context.Context, i *models.GetWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	alias, err := h.store.GetWorkflowDefinitionAlias(ctx, i.Name, i.Alias)
+	if err != nil {
+		return nil, err
+	}
+	if alias.Rollout == nil {
+		return nil, store.NewNotFound(fmt.Sprintf("rollout of %s alias %s", i.Name, i.Alias))
+	}
+	return alias.Rollout, nil
+}
+
+// StartWorkflowDefinitionRollout starts moving an alias to a new version. The rollout is then
+// advanced or rolled back by executor.PollForRollouts.
+func (h Handler) StartWorkflowDefinitionRollout(ctx context.Context, i *models.StartWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	if i.RolloutRequest == nil || i.RolloutRequest.ToVersion == nil {
+		return nil, models.BadRequest{Message: "toVersion is required"}
+	}
+	alias, err := h.store.GetWorkflowDefinitionAlias(ctx, i.Name, i.Alias)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(*i.RolloutRequest.ToVersion)); err != nil {
+		return nil, err
+	}
+
+	if err := resources.StartRollout(&alias, *i.RolloutRequest, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := h.store.UpdateWorkflowDefinitionAlias(ctx, alias); err != nil {
+		return nil, err
+	}
+	return alias.Rollout, nil
+}
+
+// CancelWorkflowDefinitionRollout stops the rollout of an alias and points it back at the old version
+func (h Handler) CancelWorkflowDefinitionRollout(ctx context.Context, i *models.CancelWorkflowDefinitionRolloutInput) (*models.Rollout, error) {
+	alias, err := h.store.GetWorkflowDefinitionAlias(ctx, i.Name, i.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resources.CancelRollout(&alias, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := h.store.UpdateWorkflowDefinitionAlias(ctx, alias); err != nil {
+		return nil, err
+	}
+	return alias.Rollout, nil
+}
+
+// DeleteWorkflowDefinitionAlias removes an alias of a WorkflowDefinition
+func (h Handler) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	return h.store.DeleteWorkflowDefinitionAlias(ctx, i.Name, i.Alias)
+}
+
+// GetWorkflowDefinitionTemplates lists all WorkflowDefinitionTemplates
+func (h Handler) GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error) {
+	return h.store.GetWorkflowDefinitionTemplates(ctx)
+}
+
+// GetWorkflowDefinitionTemplate fetches a WorkflowDefinitionTemplate by name
+func (h Handler) GetWorkflowDefinitionTemplate(ctx context.Context, name string) (*models.WorkflowDefinitionTemplate, error) {
+	template, err := h.store.GetWorkflowDefinitionTemplate(ctx, name)
+	if err != nil {
+		return &models.WorkflowDefinitionTemplate{}, err
+	}
+	return &template, nil
+}
+
+// PutWorkflowDefinitionTemplate creates a WorkflowDefinitionTemplate or saves a new version of it.
+// Instances of the template keep their state machine until they are regenerated.
+func (h Handler) PutWorkflowDefinitionTemplate(ctx context.Context, i *models.PutWorkflowDefinitionTemplateInput) (*models.WorkflowDefinitionTemplate, error) {
+	if i.WorkflowDefinitionTemplate == nil {
+		return &models.WorkflowDefinitionTemplate{}, models.BadRequest{Message: "missing WorkflowDefinitionTemplate"}
+	}
+	template := *i.WorkflowDefinitionTemplate
+	if template.Name != "" && template.Name != i.Name {
+		return &models.WorkflowDefinitionTemplate{}, models.BadRequest{
+			Message: "WorkflowDefinitionTemplate name does not match the path",
+		}
+	}
+	template.Name = i.Name
+	if err := resources.ValidateWorkflowDefinitionTemplate(template); err != nil {
+		return &models.WorkflowDefinitionTemplate{}, err
+	}
+
+	template.Version = 0
+	existing, err := h.store.GetWorkflowDefinitionTemplate(ctx, template.Name)
+	if err == nil {
+		template.Version = existing.Version + 1
+	} else if _, ok := err.(models.NotFound); !ok {
+		return &models.WorkflowDefinitionTemplate{}, err
+	}
+
+	if err := h.store.SaveWorkflowDefinitionTemplate(ctx, template); err != nil {
+		return &models.WorkflowDefinitionTemplate{}, err
+	}
+	return h.GetWorkflowDefinitionTemplate(ctx, template.Name)
+}
+
+// GetWorkflowDefinitionTemplateInstances lists the latest versions of the WorkflowDefinitions
+// that were generated from a template
+func (h Handler) GetWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	if _, err := h.store.GetWorkflowDefinitionTemplate(ctx, name); err != nil {
+		return nil, err
+	}
+	return h.templateInstances(ctx, name)
+}
+
+// InstantiateWorkflowDefinitionTemplate renders a template with parameter values into a new
+// WorkflowDefinition, or into a new version of a WorkflowDefinition generated from the same template
+func (h Handler) InstantiateWorkflowDefinitionTemplate(ctx context.Context, i *models.InstantiateWorkflowDefinitionTemplateInput) (*models.WorkflowDefinition, error) {
+	req := i.TemplateInstanceRequest
+	if req == nil || req.Name == nil || *req.Name == "" {
+		return &models.WorkflowDefinition{}, models.BadRequest{Message: "name is required"}
+	}
+	template, err := h.store.GetWorkflowDefinitionTemplate(ctx, i.Name)
+	if err != nil {
+		return &models.WorkflowDefinition{}, err
+	}
+	return h.instantiateWorkflowDefinitionTemplate(ctx, template, *req)
+}
+
+// RegenerateWorkflowDefinitionTemplateInstances re-renders the instances of a template that
+// were generated from an older version of it, with the parameter values they were generated with
+func (h Handler) RegenerateWorkflowDefinitionTemplateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	template, err := h.store.GetWorkflowDefinitionTemplate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := h.templateInstances(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	outdated := []models.WorkflowDefinition{}
+	for _, instance := range instances {
+		if instance.Template.Version >= template.Version {
+			continue
+		}
+		// render every instance before saving any, so that a parameter added to the template
+		// without a default doesn't leave the instances half regenerated
+		if _, _, err := resources.RenderWorkflowDefinitionTemplate(template, instance.Template.Parameters); err != nil {
+			return nil, models.BadRequest{
+				Message: fmt.Sprintf("can't regenerate %s: %s", instance.Name, err),
+			}
+		}
+		outdated = append(outdated, instance)
+	}
+
+	regenerated := []models.WorkflowDefinition{}
+	for _, instance := range outdated {
+		def, err := h.instantiateWorkflowDefinitionTemplate(ctx, template, models.TemplateInstanceRequest{
+			Name:       aws.String(instance.Name),
+			Parameters: instance.Template.Parameters,
+			Changelog:  fmt.Sprintf("regenerated from template %s version %d", template.Name, template.Version),
+		})
+		if err != nil {
+			return nil, err
+		}
+		regenerated = append(regenerated, *def)
+	}
+	return regenerated, nil
+}
+
+// templateInstances returns the latest versions of the unarchived WorkflowDefinitions generated from a template
+func (h Handler) templateInstances(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	defs, err := h.store.GetWorkflowDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instances := []models.WorkflowDefinition{}
+	for _, def := range defs {
+		if def.Template != nil && def.Template.Name == name {
+			instances = append(instances, def)
+		}
+	}
+	return instances, nil
+}
+
+func (h Handler) instantiateWorkflowDefinitionTemplate(
+	ctx context.Context, template models.WorkflowDefinitionTemplate, req models.TemplateInstanceRequest,
+) (*models.WorkflowDefinition, error) {
+	stateMachine, ref, err := resources.RenderWorkflowDefinitionTemplate(template, req.Parameters)
+	if err != nil {
+		return &models.WorkflowDefinition{}, err
+	}
+	def, err := newWorkflowDefinitionFromRequest(models.NewWorkflowDefinitionRequest{
+		Name:         *req.Name,
+		Manager:      template.Manager,
+		StateMachine: stateMachine,
+		InputSchema:  template.InputSchema,
+		DefaultInput: template.DefaultInput,
+		Description:  req.Description,
+		Team:         req.Team,
+		Contact:      req.Contact,
+		Labels:       req.Labels,
+		Changelog:    req.Changelog,
+	})
+	if err != nil {
+		return &models.WorkflowDefinition{}, err
+	}
+	def.Template = ref
+
+	latest, err := h.store.LatestWorkflowDefinition(ctx, def.Name)
+	if _, ok := err.(models.NotFound); ok {
+		if err := h.store.SaveWorkflowDefinition(ctx, *def); err != nil {
+			return &models.WorkflowDefinition{}, err
+		}
+		return def, nil
+	} else if err != nil {
+		return &models.WorkflowDefinition{}, err
+	}
+
+	if latest.Template == nil || latest.Template.Name != template.Name {
+		return &models.WorkflowDefinition{}, models.Conflict{
+			Message: fmt.Sprintf("workflow definition %s was not generated from template %s", def.Name, template.Name),
+		}
+	}
+	if latest.Archived {
+		return &models.WorkflowDefinition{}, models.BadRequest{
+			Message: fmt.Sprintf("workflow definition %s is archived", def.Name),
+		}
+	}
+	resources.InheritWorkflowDefinitionMetadata(def, latest)
+	updated, err := h.store.UpdateWorkflowDefinition(ctx, *def)
+	if err != nil {
+		return &models.WorkflowDefinition{}, err
+	}
+	return &updated, nil
+}
+
+// GetWorkflowDefinitionDiff returns the differences between the state machines of two versions of a WorkflowDefinition
+func (h Handler) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	from, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(i.From))
+	if err != nil {
+		return nil, err
+	}
+	to, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(i.To))
+	if err != nil {
+		return nil, err
+	}
+
+	diff := resources.DiffStateMachines(*from.StateMachine, *to.StateMachine)
+	diff.Name = i.Name
+	diff.FromVersion = from.Version
+	diff.ToVersion = to.Version
+	return diff, nil
+}
+
+// ExportWorkflowDefinition returns the AWS state machine that workflows of a WorkflowDefinition
+// run as in a namespace
+func (h Handler) ExportWorkflowDefinition(ctx context.Context, i *models.ExportWorkflowDefinitionInput) (*models.StateMachineExport, error) {
+	wfd, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(i.Version))
+	if err != nil {
+		return nil, err
+	}
+	return h.manager.ExportStateMachine(ctx, wfd, i.Namespace)
+}
+
+// GetWorkflowDefinitionGraph renders the state machine of a WorkflowDefinition as a graph
+func (h Handler) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.StateMachineGraph, error) {
+	wfd, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(i.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	format := graphFormat(i.Format)
+	graph, err := resources.RenderStateMachine(*wfd.StateMachine, format)
+	if err != nil {
+		return nil, err
+	}
+	return &models.StateMachineGraph{Format: format, Graph: graph}, nil
+}
+
+// GetWorkflowDefinitionStats sums the counts of the workflows of a WorkflowDefinition created in a
+// time window, which defaults to the last 24 hours
+func (h Handler) GetWorkflowDefinitionStats(ctx context.Context, i *models.GetWorkflowDefinitionStatsInput) (*models.WorkflowStats, error) {
+	if _, err := h.store.LatestWorkflowDefinition(ctx, i.Name); err != nil {
+		return nil, err
+	}
+
+	createdAfter, createdBefore, err := statsTimeWindow(i.CreatedAfter, i.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAfter, createdBefore = statsPeriodsWindow(createdAfter, createdBefore)
+	periods, err := h.store.GetWorkflowStatsCounts(ctx, i.Name, createdAfter, createdBefore)
+	if err != nil {
+		return nil, err
+	}
+	stats := resources.NewWorkflowStats(i.Name, createdAfter, createdBefore, periods)
+	return &stats, nil
+}
+
+// GetWorkflowDefinitionStateStats sums the counts of the jobs of the stopped workflows of a
+// WorkflowDefinition created in a time window, which defaults to the last 24 hours, and computes
+// the stats of each state
+func (h Handler) GetWorkflowDefinitionStateStats(ctx context.Context, i *models.GetWorkflowDefinitionStateStatsInput) (*models.WorkflowDefinitionStateStats, error) {
+	if _, err := h.store.LatestWorkflowDefinition(ctx, i.Name); err != nil {
+		return nil, err
+	}
+
+	createdAfter, createdBefore, err := statsTimeWindow(i.CreatedAfter, i.CreatedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAfter, createdBefore = statsPeriodsWindow(createdAfter, createdBefore)
+	periods, err := h.store.GetWorkflowStatsCounts(ctx, i.Name, createdAfter, createdBefore)
+	if err != nil {
+		return nil, err
+	}
+	stats := resources.NewWorkflowDefinitionStateStats(i.Name, createdAfter, createdBefore, periods)
+	return &stats, nil
+}
+
+// SimulateWorkflowDefinition walks a WorkflowDefinition with the given input and canned Task
+// results, returning the states a workflow would pass through
+func (h Handler) SimulateWorkflowDefinition(ctx context.Context, i *models.SimulateWorkflowDefinitionInput) (*models.SimulationResult, error) {
+	wfd, err := h.store.GetWorkflowDefinition(ctx, i.Name, int(i.Version))
+	if err != nil {
+		return nil, err
+	}
+	req := i.SimulationRequest
+	if req == nil {
+		req = &models.SimulationRequest{}
+	}
+
+	input := req.Input
+	if input == "" {
+		input = "{}"
+	}
+	namespaceParameters := ""
+	if req.Namespace != "" {
+		config, err := h.store.GetNamespaceConfig(ctx, req.Namespace)
+		if err == nil {
+			namespaceParameters = config.Parameters
+		} else if _, ok := err.(models.NotFound); !ok {
+			return nil, err
+		}
+	}
+	effectiveInput, err := resources.EffectiveWorkflowInput(wfd.DefaultInput, namespaceParameters, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := resources.ValidateWorkflowInput(wfd, effectiveInput); err != nil {
+		return nil, err
+	}
+
+	return resources.SimulateStateMachine(*wfd.StateMachine, effectiveInput, req.TaskResults)
+}
+
 // PostStateResource creates a new state resource
 func (h Handler) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
-	stateResource := resources.NewStateResource(i.Name, i.Namespace, i.URI)
+	namespace, err := h.registeredNamespace(ctx, i.Namespace)
+	if err != nil {
+		return &models.StateResource{}, err
+	}
+	if err := resources.CheckNamespaceActive(namespace); err != nil {
+		return &models.StateResource{}, err
+	}
+
+	stateResource, err := resources.NewStateResource(i.Name, i.Namespace, i.URI)
+	if err != nil {
+		return &models.StateResource{}, err
+	}
+	if err := h.provisionActivity(ctx, stateResource); err != nil {
+		return &models.StateResource{}, err
+	}
 	if err := h.store.SaveStateResource(ctx, *stateResource); err != nil {
 		return &models.StateResource{}, err
 	}
@@ -117,8 +729,21 @@ func (h Handler) PutStateResource(ctx context.Context, i *models.PutStateResourc
 			Message: "StateResource.Namespace does not match namespace in path",
 		}
 	}
+	namespace, err := h.registeredNamespace(ctx, i.Namespace)
+	if err != nil {
+		return &models.StateResource{}, err
+	}
+	if err := resources.CheckNamespaceActive(namespace); err != nil {
+		return &models.StateResource{}, err
+	}
 
-	stateResource := resources.NewStateResource(i.NewStateResource.Name, i.NewStateResource.Namespace, i.NewStateResource.URI)
+	stateResource, err := resources.NewStateResource(i.NewStateResource.Name, i.NewStateResource.Namespace, i.NewStateResource.URI)
+	if err != nil {
+		return &models.StateResource{}, err
+	}
+	if err := h.provisionActivity(ctx, stateResource); err != nil {
+		return &models.StateResource{}, err
+	}
 	if err := h.store.SaveStateResource(ctx, *stateResource); err != nil {
 		return &models.StateResource{}, err
 	}
@@ -126,6 +751,48 @@ func (h Handler) PutStateResource(ctx context.Context, i *models.PutStateResourc
 	return stateResource, nil
 }
 
+// provisionActivity creates the Step Functions activity backing an activity StateResource and
+// fills in its URI. Other types of resources are managed outside of workflow-manager.
+func (h Handler) provisionActivity(ctx context.Context, stateResource *models.StateResource) error {
+	if stateResource.Type != models.StateResourceTypeActivityARN {
+		return nil
+	}
+	arn, err := h.manager.CreateActivity(ctx, stateResource.Namespace, resources.ActivityName(*stateResource))
+	if err != nil {
+		return err
+	}
+	if stateResource.URI != "" && stateResource.URI != arn {
+		return models.BadRequest{
+			Message: fmt.Sprintf("activity %s is not in the account and region of namespace %s",
+				stateResource.URI, stateResource.Namespace),
+		}
+	}
+	stateResource.URI = arn
+	return nil
+}
+
+// GetStateResources lists StateResources, optionally only those of a namespace
+func (h Handler) GetStateResources(
+	ctx context.Context, i *models.GetStateResourcesInput,
+) ([]models.StateResource, string, error) {
+	limit := aws.Int64Value(i.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+	stateResources, nextPageToken, err := h.store.GetStateResources(
+		ctx, aws.StringValue(i.Namespace), limit, aws.StringValue(i.PageToken),
+	)
+	if err != nil {
+		if _, ok := err.(store.InvalidPageTokenError); ok {
+			return stateResources, "", models.BadRequest{
+				Message: err.Error(),
+			}
+		}
+		return []models.StateResource{}, "", err
+	}
+	return stateResources, nextPageToken, nil
+}
+
 // GetStateResource fetches a StateResource given a name and namespace
 func (h Handler) GetStateResource(ctx context.Context, i *models.GetStateResourceInput) (*models.StateResource, error) {
 	stateResource, err := h.store.GetStateResource(ctx, i.Name, i.Namespace)
@@ -136,22 +803,169 @@ func (h Handler) GetStateResource(ctx context.Context, i *models.GetStateResourc
 }
 
 // DeleteStateResource removes a StateResource given a name and namespace
+// If deleteActivity is set, the Step Functions activity backing the resource is deleted too.
 func (h Handler) DeleteStateResource(ctx context.Context, i *models.DeleteStateResourceInput) error {
-	return h.store.DeleteStateResource(ctx, i.Name, i.Namespace)
+	stateResource, err := h.store.GetStateResource(ctx, i.Name, i.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := h.store.DeleteStateResource(ctx, i.Name, i.Namespace); err != nil {
+		return err
+	}
+	if aws.BoolValue(i.DeleteActivity) && stateResource.Type == models.StateResourceTypeActivityARN && stateResource.URI != "" {
+		return h.manager.DeleteActivity(ctx, i.Namespace, stateResource.URI)
+	}
+	return nil
+}
+
+// GetActivityWorkers lists the workers seen picking up tasks of a StateResource
+func (h Handler) GetActivityWorkers(ctx context.Context, i *models.GetActivityWorkersInput) ([]models.ActivityWorker, error) {
+	if _, err := h.store.GetStateResource(ctx, i.Name, i.Namespace); err != nil {
+		return []models.ActivityWorker{}, err
+	}
+	return h.store.GetActivityWorkers(ctx, i.Namespace, i.Name)
+}
+
+// GetStalledActivities lists the activities with queued jobs but no worker seen recently
+func (h Handler) GetStalledActivities(ctx context.Context, i *models.GetStalledActivitiesInput) ([]models.StalledActivity, error) {
+	workerTimeout := aws.Int64Value(i.WorkerTimeout)
+	if workerTimeout == 0 {
+		workerTimeout = 600
+	}
+	return executor.StalledActivities(
+		ctx, h.store, aws.StringValue(i.Namespace), time.Duration(workerTimeout)*time.Second, time.Now(),
+	)
+}
+
+// GetNamespaces lists the registered namespaces
+func (h Handler) GetNamespaces(ctx context.Context) ([]models.Namespace, error) {
+	return h.store.GetNamespaces(ctx)
+}
+
+// GetNamespace fetches a registered namespace
+func (h Handler) GetNamespace(ctx context.Context, name string) (*models.Namespace, error) {
+	namespace, err := h.store.GetNamespace(ctx, name)
+	if err != nil {
+		return &models.Namespace{}, err
+	}
+	return &namespace, nil
+}
+
+// PutNamespace registers a namespace, or updates its status and allowed definitions
+func (h Handler) PutNamespace(ctx context.Context, i *models.PutNamespaceInput) (*models.Namespace, error) {
+	if i.RegisteredNamespace == nil {
+		return &models.Namespace{}, models.BadRequest{Message: "missing Namespace"}
+	}
+	namespace := *i.RegisteredNamespace
+	if namespace.Name != "" && namespace.Name != i.Namespace {
+		return &models.Namespace{}, models.BadRequest{
+			Message: "Namespace name does not match namespace in path",
+		}
+	}
+	namespace.Name = i.Namespace
+	if namespace.Status == "" {
+		namespace.Status = models.NamespaceStatusActive
+	}
+	if err := resources.ValidateNamespace(namespace); err != nil {
+		return &models.Namespace{}, err
+	}
+
+	if err := h.store.SaveNamespace(ctx, namespace); err != nil {
+		return &models.Namespace{}, err
+	}
+	return h.GetNamespace(ctx, namespace.Name)
+}
+
+// DeleteNamespace removes a namespace and its AWS configuration
+func (h Handler) DeleteNamespace(ctx context.Context, name string) error {
+	if err := h.store.DeleteNamespace(ctx, name); err != nil {
+		return err
+	}
+	if err := h.store.DeleteNamespaceConfig(ctx, name); err != nil {
+		if _, ok := err.(models.NotFound); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// registeredNamespace fetches a namespace that a request refers to. Unknown namespaces are
+// rejected if namespaces must be registered, and are otherwise active without restrictions.
+func (h Handler) registeredNamespace(ctx context.Context, name string) (models.Namespace, error) {
+	namespace, err := h.store.GetNamespace(ctx, name)
+	if _, ok := err.(models.NotFound); ok {
+		if !h.requireRegisteredNamespaces {
+			return models.Namespace{Name: name, Status: models.NamespaceStatusActive}, nil
+		}
+		return models.Namespace{}, models.BadRequest{
+			Message: fmt.Sprintf("unknown namespace %q: namespaces must be registered with PUT /namespaces/{namespace}", name),
+		}
+	}
+	return namespace, err
+}
+
+// GetNamespaceConfigs returns the AWS configuration of every namespace that overrides the defaults
+func (h Handler) GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error) {
+	return h.store.GetNamespaceConfigs(ctx)
+}
+
+// GetNamespaceConfig fetches the AWS configuration for a namespace
+func (h Handler) GetNamespaceConfig(ctx context.Context, namespace string) (*models.NamespaceConfig, error) {
+	config, err := h.store.GetNamespaceConfig(ctx, namespace)
+	if err != nil {
+		return &models.NamespaceConfig{}, err
+	}
+	return &config, nil
+}
+
+// PutNamespaceConfig creates or updates the AWS configuration for a namespace
+func (h Handler) PutNamespaceConfig(ctx context.Context, i *models.PutNamespaceConfigInput) (*models.NamespaceConfig, error) {
+	if i.NamespaceConfig == nil || i.Namespace != i.NamespaceConfig.Namespace {
+		return &models.NamespaceConfig{}, models.BadRequest{
+			Message: "NamespaceConfig.Namespace does not match namespace in path",
+		}
+	}
+	if err := validateNamespaceConfig(*i.NamespaceConfig, h.accountID); err != nil {
+		return &models.NamespaceConfig{}, err
+	}
+	if _, err := h.registeredNamespace(ctx, i.Namespace); err != nil {
+		return &models.NamespaceConfig{}, err
+	}
+
+	if err := h.store.SaveNamespaceConfig(ctx, *i.NamespaceConfig); err != nil {
+		return &models.NamespaceConfig{}, err
+	}
+
+	config, err := h.store.GetNamespaceConfig(ctx, i.Namespace)
+	if err != nil {
+		return &models.NamespaceConfig{}, err
+	}
+	return &config, nil
+}
+
+// DeleteNamespaceConfig removes the AWS configuration for a namespace, reverting it to the defaults
+func (h Handler) DeleteNamespaceConfig(ctx context.Context, namespace string) error {
+	return h.store.DeleteNamespaceConfig(ctx, namespace)
 }
 
 // StartWorkflow starts a new Workflow for the given WorkflowDefinition
 func (h Handler) StartWorkflow(ctx context.Context, req *models.StartWorkflowRequest) (*models.Workflow, error) {
-	var workflowDefinition models.WorkflowDefinition
-	var err error
-	if req.WorkflowDefinition.Version < 0 {
-		workflowDefinition, err = h.store.LatestWorkflowDefinition(ctx, req.WorkflowDefinition.Name)
-	} else {
-		workflowDefinition, err = h.store.GetWorkflowDefinition(ctx, req.WorkflowDefinition.Name, int(req.WorkflowDefinition.Version))
+	workflowDefinition, err := h.workflowDefinitionForRef(ctx, *req.WorkflowDefinition)
+	if err != nil {
+		return &models.Workflow{}, err
+	}
+	if workflowDefinition.Archived {
+		return &models.Workflow{}, models.BadRequest{
+			Message: fmt.Sprintf("workflow definition %s is archived", workflowDefinition.Name),
+		}
 	}
+	namespace, err := h.registeredNamespace(ctx, req.Namespace)
 	if err != nil {
 		return &models.Workflow{}, err
 	}
+	if err := resources.CheckNamespaceAllowsWorkflows(namespace, workflowDefinition.Name); err != nil {
+		return &models.Workflow{}, err
+	}
 
 	if req.Queue == "" {
 		req.Queue = "default"
@@ -169,7 +983,24 @@ func (h Handler) StartWorkflow(ctx context.Context, req *models.StartWorkflowReq
 		req.Input = "{}"
 	}
 
-	return h.manager.CreateWorkflow(ctx, workflowDefinition, req.Input, req.Namespace, req.Queue, req.Tags)
+	return h.manager.CreateWorkflow(ctx, workflowDefinition, req.WorkflowDefinition.Alias, req.Input, req.Namespace, req.Queue, req.Tags)
+}
+
+// workflowDefinitionForRef looks up the WorkflowDefinition version that a new workflow runs:
+// the version an alias picks, the latest version if version is negative, or else that version.
+func (h Handler) workflowDefinitionForRef(ctx context.Context, ref models.WorkflowDefinitionRef) (models.WorkflowDefinition, error) {
+	switch {
+	case ref.Alias != "":
+		alias, err := h.store.GetWorkflowDefinitionAlias(ctx, ref.Name, ref.Alias)
+		if err != nil {
+			return models.WorkflowDefinition{}, err
+		}
+		return h.store.GetWorkflowDefinition(ctx, ref.Name, int(resources.AliasVersion(alias, h.random.Intn(100))))
+	case ref.Version < 0:
+		return h.store.LatestWorkflowDefinition(ctx, ref.Name)
+	default:
+		return h.store.GetWorkflowDefinition(ctx, ref.Name, int(ref.Version))
+	}
 }
 
 // GetWorkflows returns a summary of all workflows matching the given query.
@@ -184,7 +1015,8 @@ func (h Handler) GetWorkflows(
 
 	workflows, nextPageToken, err := h.store.GetWorkflows(ctx, workflowQuery)
 	if err != nil {
-		if _, ok := err.(store.InvalidPageTokenError); ok {
+		switch err.(type) {
+		case store.InvalidPageTokenError, store.InvalidQueryStructureError:
 			return workflows, "", models.BadRequest{
 				Message: err.Error(),
 			}
@@ -196,12 +1028,6 @@ func (h Handler) GetWorkflows(
 }
 
 func paramsToWorkflowsQuery(input *models.GetWorkflowsInput) (*models.WorkflowQuery, error) {
-	// due to limitations of DynamoDB indices, only search by status or resolvedByUser, not both at once
-	if input.Status != nil && input.ResolvedByUser != nil {
-		return &models.WorkflowQuery{}, models.BadRequest{
-			Message: "Request cannot contain both status and resolvedByUser.",
-		}
-	}
 	resolvedByUserInformation := &models.ResolvedByUserWrapper{}
 	if input.ResolvedByUser != nil {
 		resolvedByUserInformation = &models.ResolvedByUserWrapper{
@@ -209,14 +1035,39 @@ func paramsToWorkflowsQuery(input *models.GetWorkflowsInput) (*models.WorkflowQu
 			IsSet: true,
 		}
 	}
+	// workflows are indexed by definition and by namespace, so one of them scopes every query
+	if aws.StringValue(input.WorkflowDefinitionName) == "" && aws.StringValue(input.Namespace) == "" {
+		return &models.WorkflowQuery{}, models.BadRequest{
+			Message: "Request must contain workflowDefinitionName or namespace.",
+		}
+	}
+	tags, err := parseTagsFilter(aws.StringValue(input.Tags))
+	if err != nil {
+		return &models.WorkflowQuery{}, err
+	}
 	query := &models.WorkflowQuery{
-		WorkflowDefinitionName: aws.String(input.WorkflowDefinitionName),
-		Limit:                 aws.Int64Value(input.Limit),
-		OldestFirst:           aws.BoolValue(input.OldestFirst),
-		PageToken:             aws.StringValue(input.PageToken),
-		Status:                models.WorkflowStatus(aws.StringValue(input.Status)),
-		ResolvedByUserWrapper: resolvedByUserInformation,
-		SummaryOnly:           input.SummaryOnly,
+		WorkflowDefinitionName: input.WorkflowDefinitionName,
+		Namespace:              aws.StringValue(input.Namespace),
+		Queue:                  aws.StringValue(input.Queue),
+		Tags:                   tags,
+		Limit:                  aws.Int64Value(input.Limit),
+		OldestFirst:            aws.BoolValue(input.OldestFirst),
+		PageToken:              aws.StringValue(input.PageToken),
+		Status:                 models.WorkflowStatus(aws.StringValue(input.Status)),
+		ResolvedByUserWrapper:  resolvedByUserInformation,
+		SummaryOnly:            input.SummaryOnly,
+	}
+	if input.CreatedAfter != nil {
+		query.CreatedAfter = *input.CreatedAfter
+	}
+	if input.CreatedBefore != nil {
+		query.CreatedBefore = *input.CreatedBefore
+	}
+	if input.CreatedAfter != nil && input.CreatedBefore != nil &&
+		time.Time(query.CreatedAfter).After(time.Time(query.CreatedBefore)) {
+		return &models.WorkflowQuery{}, models.BadRequest{
+			Message: "createdAfter must not be after createdBefore.",
+		}
 	}
 
 	if err := query.Validate(nil); err != nil {
@@ -243,6 +1094,36 @@ func (h Handler) GetWorkflowByID(ctx context.Context, workflowID string) (*model
 	return &workflow, nil
 }
 
+// GetWorkflowJob returns a Job of a Workflow with its complete failure cause and attempts
+func (h Handler) GetWorkflowJob(ctx context.Context, i *models.GetWorkflowJobInput) (*models.Job, error) {
+	workflow, err := h.GetWorkflowByID(ctx, i.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range workflow.Jobs {
+		if job.ID == i.JobID {
+			return job, nil
+		}
+	}
+	return nil, store.NewNotFound(fmt.Sprintf("job %s of workflow %s", i.JobID, i.WorkflowID))
+}
+
+// GetWorkflowGraph renders the state machine of a Workflow as a graph, showing the status of
+// its Jobs
+func (h Handler) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.StateMachineGraph, error) {
+	workflow, err := h.GetWorkflowByID(ctx, i.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	format := graphFormat(i.Format)
+	graph, err := resources.RenderWorkflow(*workflow, format)
+	if err != nil {
+		return nil, err
+	}
+	return &models.StateMachineGraph{Format: format, Graph: graph}, nil
+}
+
 // CancelWorkflow cancels all the jobs currently running or queued for the Workflow and
 // marks the workflow as cancelled
 func (h Handler) CancelWorkflow(ctx context.Context, input *models.CancelWorkflowInput) error {
@@ -289,6 +1170,13 @@ func (h Handler) ResumeWorkflowByID(ctx context.Context, input *models.ResumeWor
 			break
 		}
 	}
+	// the input of any other state includes the outputs of the states before it, so only
+	// resumes from the start are checked against the InputSchema of the workflow
+	if input.Overrides.StartAt == workflow.WorkflowDefinition.StateMachine.StartAt {
+		if err := resources.ValidateWorkflowInput(*workflow.WorkflowDefinition, effectiveInput); err != nil {
+			return &models.Workflow{}, err
+		}
+	}
 
 	return h.manager.RetryWorkflow(ctx, workflow, input.Overrides.StartAt, effectiveInput)
 }
@@ -318,6 +1206,11 @@ func newWorkflowDefinitionFromRequest(req models.NewWorkflowDefinitionRequest) (
 		return nil, fmt.Errorf("StartAt is a required field")
 	}
 
+	if err := resources.StateMachineProblemsError(resources.ValidateStateMachine(*req.StateMachine)); err != nil {
+		return nil, err
+	}
+	lintWarnings := resources.LintStateMachine(*req.StateMachine)
+
 	// ensure all states are defined and have a transition path
 	numStates := len(req.StateMachine.States)
 	if err := resources.RemoveInactiveStates(req.StateMachine); err != nil {
@@ -328,7 +1221,52 @@ func newWorkflowDefinitionFromRequest(req models.NewWorkflowDefinitionRequest) (
 			numStates-len(req.StateMachine.States))
 	}
 
-	return resources.NewWorkflowDefinition(req.Name, req.Manager, req.StateMachine)
+	if err := resources.ValidateInputSchema(req.InputSchema); err != nil {
+		return nil, err
+	}
+	if err := resources.ValidateInputObject("defaultInput", req.DefaultInput); err != nil {
+		return nil, err
+	}
+
+	workflowDefinition, err := resources.NewWorkflowDefinition(req.Name, req.Manager, req.StateMachine)
+	if err != nil {
+		return nil, err
+	}
+	workflowDefinition.InputSchema = req.InputSchema
+	workflowDefinition.DefaultInput = req.DefaultInput
+	workflowDefinition.Description = req.Description
+	workflowDefinition.Team = req.Team
+	workflowDefinition.Contact = req.Contact
+	workflowDefinition.Labels = req.Labels
+	workflowDefinition.Changelog = req.Changelog
+	if len(lintWarnings) > 0 {
+		workflowDefinition.LintWarnings = resources.DefinitionProblems(lintWarnings)
+	}
+	return workflowDefinition, nil
+}
+
+// validateNamespaceConfig ensures that the account, roles and parameters of a NamespaceConfig are well formed,
+// and that an account other than the default account can be reached
+func validateNamespaceConfig(config models.NamespaceConfig, defaultAccountID string) error {
+	if config.AccountID != "" && !accountIDRegex.MatchString(config.AccountID) {
+		return models.BadRequest{
+			Message: fmt.Sprintf("accountID must be a 12 digit AWS account ID: %s", config.AccountID),
+		}
+	}
+	// the credentials of workflow-manager can only reach its own account
+	if config.AccountID != "" && config.AccountID != defaultAccountID && config.AssumeRoleARN == "" {
+		return models.BadRequest{
+			Message: fmt.Sprintf("accountID %s requires an assumeRoleARN", config.AccountID),
+		}
+	}
+	for _, roleARN := range []string{config.RoleARN, config.AssumeRoleARN} {
+		if roleARN != "" && !strings.HasPrefix(roleARN, "arn:aws:iam::") {
+			return models.BadRequest{
+				Message: fmt.Sprintf("invalid IAM role ARN: %s", roleARN),
+			}
+		}
+	}
+	return resources.ValidateInputObject("parameters", config.Parameters)
 }
 
 // validateTagsMap ensures that all tags values are strings
@@ -340,3 +1278,56 @@ func validateTagsMap(apiTags map[string]interface{}) error {
 	}
 	return nil
 }
+
+// parseTagsFilter parses the comma-separated key:value pairs of the tags query parameter
+func parseTagsFilter(param string) (map[string]string, error) {
+	if param == "" {
+		return nil, nil
+	}
+	tags := map[string]string{}
+	for _, pair := range strings.Split(param, ",") {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, models.BadRequest{
+				Message: fmt.Sprintf("invalid tag filter %q: tags must be comma-separated key:value pairs", pair),
+			}
+		}
+		tags[parts[0]] = parts[1]
+	}
+	return tags, nil
+}
+
+// graphFormat returns the requested graph format, defaulting to DOT
+func graphFormat(format *string) models.GraphFormat {
+	if format == nil || *format == "" {
+		return models.GraphFormatDot
+	}
+	return models.GraphFormat(*format)
+}
+
+// statsTimeWindow returns the time window of a stats request, which defaults to the 24 hours
+// before createdBefore, and createdBefore defaults to now.
+func statsTimeWindow(createdAfter, createdBefore *strfmt.DateTime) (time.Time, time.Time, error) {
+	before := time.Now()
+	if createdBefore != nil {
+		before = time.Time(*createdBefore)
+	}
+	after := before.Add(-24 * time.Hour)
+	if createdAfter != nil {
+		after = time.Time(*createdAfter)
+	}
+	if !after.Before(before) {
+		return time.Time{}, time.Time{}, models.BadRequest{Message: "createdAfter must be before createdBefore"}
+	}
+	return after, before, nil
+}
+
+// statsPeriodsWindow rounds the time window of a stats request out to whole periods, since the
+// counts are kept by period.
+func statsPeriodsWindow(createdAfter, createdBefore time.Time) (time.Time, time.Time) {
+	end := resources.WorkflowStatsPeriodStart(createdBefore)
+	if end.Before(createdBefore) {
+		end = end.Add(resources.WorkflowStatsPeriod)
+	}
+	return resources.WorkflowStatsPeriodStart(createdAfter), end
+}
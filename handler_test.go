@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	"math/rand"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
 	"github.com/Clever/workflow-manager/mocks"
 	"github.com/Clever/workflow-manager/resources"
 	"github.com/Clever/workflow-manager/store/memory"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +48,22 @@ func TestNewWorkflowDefinitionFromRequest(t *testing.T) {
 	_, err := newWorkflowDefinitionFromRequest(workflowReq)
 	t.Log("No error converting from new workflow request to resource")
 	assert.Nil(t, err)
+
+	t.Log("Every States Language violation is reported")
+	workflowReq.StateMachine.States["second-state"] = models.SLState{
+		Type:     models.SLStateTypeTask,
+		Next:     "end-state",
+		End:      true,
+		Resource: "test-resource-2",
+		Retry: []*models.SLRetrier{{
+			ErrorEquals: []models.SLErrorEquals{"States.ALL"},
+			MaxAttempts: swag.Int64(100),
+		}},
+	}
+	_, err = newWorkflowDefinitionFromRequest(workflowReq)
+	require.IsType(t, models.BadRequest{}, err)
+	assert.Contains(t, err.(models.BadRequest).Message, "States.second-state: must set exactly one of Next and End")
+	assert.Contains(t, err.(models.BadRequest).Message, "States.second-state.Retry[0].MaxAttempts")
 }
 
 func TestValidateTagsMap(t *testing.T) {
@@ -56,8 +77,8 @@ func TestParamsToWorkflowsQuery(t *testing.T) {
 	boolTrue := true
 	boolFalse := false
 	failedString := "failed"
-	definitionName := "defName"
-	// error if status and resolvedByUser are both sent
+	definitionName := swag.String("defName")
+	// if status and resolvedByUser are both sent, verify that both are in the query
 	inputWithStatusAndResolvedTrue := &models.GetWorkflowsInput{
 		ResolvedByUser:         &boolTrue,
 		Status:                 &failedString,
@@ -65,17 +86,21 @@ func TestParamsToWorkflowsQuery(t *testing.T) {
 	}
 
 	workflowQuery, err := paramsToWorkflowsQuery(inputWithStatusAndResolvedTrue)
-	assert.Error(t, err)
-	assert.IsType(t, models.BadRequest{}, err)
+	assert.NoError(t, err)
+	assert.Equal(t, models.WorkflowStatusFailed, workflowQuery.Status)
+	assert.Equal(t, true, workflowQuery.ResolvedByUserWrapper.IsSet)
+	assert.Equal(t, true, workflowQuery.ResolvedByUserWrapper.Value)
 
-	// if status and resolvedByUser are sent, verify error
 	inputWithStatusAndResolvedFalse := &models.GetWorkflowsInput{
 		ResolvedByUser:         &boolFalse,
 		Status:                 &failedString,
 		WorkflowDefinitionName: definitionName,
 	}
 	workflowQuery, err = paramsToWorkflowsQuery(inputWithStatusAndResolvedFalse)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.Equal(t, models.WorkflowStatusFailed, workflowQuery.Status)
+	assert.Equal(t, true, workflowQuery.ResolvedByUserWrapper.IsSet)
+	assert.Equal(t, false, workflowQuery.ResolvedByUserWrapper.Value)
 
 	// if resolvedByUser is sent, verify that the wrapper is created correctly
 	inputWithResolvedTrue := &models.GetWorkflowsInput{
@@ -103,6 +128,38 @@ func TestParamsToWorkflowsQuery(t *testing.T) {
 	workflowQuery, err = paramsToWorkflowsQuery(inputWithNameOnly)
 	assert.NoError(t, err)
 	assert.Equal(t, false, workflowQuery.ResolvedByUserWrapper.IsSet)
+
+	// queries across definitions must be scoped to a namespace
+	_, err = paramsToWorkflowsQuery(&models.GetWorkflowsInput{})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	createdAfter := strfmt.DateTime(time.Now().Add(-time.Hour))
+	workflowQuery, err = paramsToWorkflowsQuery(&models.GetWorkflowsInput{
+		Namespace:    swag.String("production"),
+		Queue:        swag.String("default"),
+		Tags:         swag.String("team:eng,url:http://example.com"),
+		CreatedAfter: &createdAfter,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, workflowQuery.WorkflowDefinitionName)
+	assert.Equal(t, "production", workflowQuery.Namespace)
+	assert.Equal(t, "default", workflowQuery.Queue)
+	assert.Equal(t, map[string]string{"team": "eng", "url": "http://example.com"}, workflowQuery.Tags)
+	assert.Equal(t, createdAfter, workflowQuery.CreatedAfter)
+	assert.True(t, time.Time(workflowQuery.CreatedBefore).IsZero())
+
+	createdBefore := strfmt.DateTime(time.Now().Add(-2 * time.Hour))
+	_, err = paramsToWorkflowsQuery(&models.GetWorkflowsInput{
+		Namespace:     swag.String("production"),
+		CreatedAfter:  &createdAfter,
+		CreatedBefore: &createdBefore,
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	for _, tags := range []string{"team", "team:eng,", ":eng"} {
+		_, err = paramsToWorkflowsQuery(&models.GetWorkflowsInput{Namespace: swag.String("production"), Tags: &tags})
+		assert.IsType(t, models.BadRequest{}, err, tags)
+	}
 }
 
 func TestStartWorkflow(t *testing.T) {
@@ -114,6 +171,10 @@ func TestStartWorkflow(t *testing.T) {
 
 	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
 	require.NoError(t, store.SaveWorkflowDefinition(context.Background(), *workflowDefinition))
+	require.NoError(t, store.SaveNamespace(context.Background(), models.Namespace{
+		Name:   "staging",
+		Status: models.NamespaceStatusActive,
+	}))
 
 	h := Handler{
 		manager: mockWFM,
@@ -123,11 +184,12 @@ func TestStartWorkflow(t *testing.T) {
 	t.Log("Verify that StartWorkflow handler converts empty string to empty dictionary")
 	for _, input := range []string{"", "{}"} {
 		mockWFM.EXPECT().
-			CreateWorkflow(gomock.Any(), gomock.Any(), "{}", gomock.Any(), gomock.Any(), gomock.Any()).
+			CreateWorkflow(gomock.Any(), gomock.Any(), "", "{}", gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(&models.Workflow{}, nil)
 
 		_, err := h.StartWorkflow(context.Background(), &models.StartWorkflowRequest{
-			Input: input,
+			Namespace: "staging",
+			Input:     input,
 			WorkflowDefinition: &models.WorkflowDefinitionRef{
 				Name:    workflowDefinition.Name,
 				Version: -1,
@@ -136,3 +198,1129 @@ func TestStartWorkflow(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestResumeWorkflowByID(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	workflowDefinition.InputSchema = `{"type": "object", "required": ["school"]}`
+	workflow := resources.NewWorkflow(workflowDefinition, `{"school": "abc"}`, "staging", "queue", nil)
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+	workflow.Status = models.WorkflowStatusFailed
+	workflow.Jobs = []*models.Job{
+		{State: "start-state", Status: models.JobStatusSucceeded, Input: `{"district": "abc"}`},
+		{State: "second-state", Status: models.JobStatusFailed, Input: `{"district": "abc"}`},
+	}
+	require.NoError(t, store.UpdateWorkflow(ctx, *workflow))
+
+	t.Log("Resuming from the start checks the input against the inputSchema")
+	_, err := h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "start-state"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Resuming from a later state doesn't, since its input includes earlier outputs")
+	mockWFM.EXPECT().
+		RetryWorkflow(gomock.Any(), gomock.Any(), "second-state", `{"district": "abc"}`).
+		Return(&models.Workflow{}, nil)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "second-state"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestPutNamespaceConfig(t *testing.T) {
+	h := Handler{
+		store:                       memory.New(),
+		accountID:                   "123456789012",
+		requireRegisteredNamespaces: true,
+	}
+	ctx := context.Background()
+
+	t.Log("Namespace in path must match the config")
+	_, err := h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "production"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Namespaces must be registered")
+	_, err = h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "staging", Region: "us-east-1"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Unless unknown namespaces are allowed")
+	permissive := Handler{store: memory.New(), accountID: "123456789012"}
+	_, err = permissive.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "staging", Region: "us-east-1"},
+	})
+	require.NoError(t, err)
+
+	_, err = h.PutNamespace(ctx, &models.PutNamespaceInput{
+		Namespace:           "staging",
+		RegisteredNamespace: &models.Namespace{},
+	})
+	require.NoError(t, err)
+
+	t.Log("Account IDs and role ARNs are validated")
+	_, err = h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "staging", AccountID: "1234"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "staging", RoleARN: "sfn-role"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Other accounts require an assumed role")
+	_, err = h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "staging", AccountID: "222222222222"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Parameters must be a JSON object")
+	_, err = h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace:       "staging",
+		NamespaceConfig: &models.NamespaceConfig{Namespace: "staging", Parameters: `["bucket"]`},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	config, err := h.PutNamespaceConfig(ctx, &models.PutNamespaceConfigInput{
+		Namespace: "staging",
+		NamespaceConfig: &models.NamespaceConfig{
+			Namespace: "staging",
+			Region:    "us-east-1",
+			AccountID: "123456789012",
+			RoleARN:   "arn:aws:iam::123456789012:role/sfn",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", config.Region)
+	assert.NotZero(t, config.LastUpdated)
+
+	configs, err := h.GetNamespaceConfigs(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 1)
+}
+
+func TestSimulateWorkflowDefinition(t *testing.T) {
+	store := memory.New()
+	h := Handler{
+		store: store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	workflowDefinition.DefaultInput = `{"dryRun": true}`
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	require.NoError(t, store.SaveNamespaceConfig(ctx, models.NamespaceConfig{
+		Namespace:  "staging",
+		Parameters: `{"bucket": "staging-bucket"}`,
+	}))
+
+	result, err := h.SimulateWorkflowDefinition(ctx, &models.SimulateWorkflowDefinitionInput{
+		Name:    workflowDefinition.Name,
+		Version: workflowDefinition.Version,
+		SimulationRequest: &models.SimulationRequest{
+			Input:     `{"district": "abc"}`,
+			Namespace: "staging",
+			TaskResults: []*models.SimulatedTaskResult{
+				{State: swag.String("start-state"), Error: "States.TaskFailed"},
+				{State: swag.String("end-state"), Output: `{"done": true}`},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.SimulationStatusSucceeded, result.Status)
+	assert.JSONEq(t, `{"district": "abc", "dryRun": true, "bucket": "staging-bucket"}`, result.Input)
+	assert.Len(t, result.Steps, 4)
+	assert.JSONEq(t, `{"done": true}`, result.Output)
+
+	t.Log("Input must satisfy the inputSchema")
+	workflowDefinition.InputSchema = `{"type": "object", "required": ["school"]}`
+	updatedDefinition, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+	_, err = h.SimulateWorkflowDefinition(ctx, &models.SimulateWorkflowDefinitionInput{
+		Name:              updatedDefinition.Name,
+		Version:           updatedDefinition.Version,
+		SimulationRequest: &models.SimulationRequest{Input: `{"district": "abc"}`},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+}
+
+func TestLintWorkflowDefinition(t *testing.T) {
+	h := Handler{
+		store: memory.New(),
+	}
+	ctx := context.Background()
+	workflowReq := &models.NewWorkflowDefinitionRequest{
+		Name:    "lint-workflow",
+		Manager: models.ManagerStepFunctions,
+		StateMachine: &models.SLStateMachine{
+			StartAt: "start-state",
+			States: map[string]models.SLState{
+				"start-state": models.SLState{
+					Type:     models.SLStateTypeTask,
+					Resource: "test-resource",
+					End:      true,
+				},
+				"wait-state": models.SLState{
+					Type: models.SLStateTypeWait,
+					End:  true,
+				},
+			},
+		},
+	}
+
+	result, err := h.LintWorkflowDefinition(ctx, workflowReq)
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "States.wait-state", result.Errors[0].Path)
+	require.Len(t, result.Warnings, 2)
+	assert.Equal(t, "States.start-state", result.Warnings[0].Path)
+	assert.Equal(t, "States.wait-state", result.Warnings[1].Path)
+
+	t.Log("Warnings are returned with created definitions")
+	delete(workflowReq.StateMachine.States, "wait-state")
+	workflowDefinition, err := h.NewWorkflowDefinition(ctx, workflowReq)
+	require.NoError(t, err)
+	require.Len(t, workflowDefinition.LintWarnings, 1)
+	assert.Equal(t, "States.start-state", workflowDefinition.LintWarnings[0].Path)
+
+	t.Log("Unreachable states are reported by the linter, and rejected")
+	workflowReq.Name = "lint-workflow-unreachable"
+	workflowReq.StateMachine.States["unreachable-state"] = models.SLState{
+		Type: models.SLStateTypePass,
+		End:  true,
+	}
+	result, err = h.LintWorkflowDefinition(ctx, workflowReq)
+	require.NoError(t, err)
+	require.Len(t, result.Warnings, 2)
+	assert.Equal(t, "States.unreachable-state", result.Warnings[1].Path)
+	_, err = h.NewWorkflowDefinition(ctx, workflowReq)
+	assert.Error(t, err)
+}
+
+func TestGetWorkflowDefinitionDiff(t *testing.T) {
+	store := memory.New()
+	h := Handler{
+		store: store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	newVersion := resources.CopyWorkflowDefinition(*workflowDefinition)
+	endState := newVersion.StateMachine.States["end-state"]
+	endState.Resource = "fake-resource-4"
+	newVersion.StateMachine.States["end-state"] = endState
+	updatedDefinition, err := store.UpdateWorkflowDefinition(ctx, newVersion)
+	require.NoError(t, err)
+
+	diff, err := h.GetWorkflowDefinitionDiff(ctx, &models.GetWorkflowDefinitionDiffInput{
+		Name: workflowDefinition.Name,
+		From: workflowDefinition.Version,
+		To:   updatedDefinition.Version,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, workflowDefinition.Version, diff.FromVersion)
+	assert.Equal(t, updatedDefinition.Version, diff.ToVersion)
+	assert.Empty(t, diff.StatesAdded)
+	assert.Empty(t, diff.StatesRemoved)
+	require.Len(t, diff.StatesChanged, 1)
+	assert.Equal(t, "end-state", diff.StatesChanged[0].State)
+	assert.Equal(t, []*models.FieldChange{
+		{Field: "Resource", From: `"fake-resource-3"`, To: `"fake-resource-4"`},
+	}, diff.StatesChanged[0].Changes)
+}
+
+func TestGetWorkflowDefinitionGraph(t *testing.T) {
+	store := memory.New()
+	h := Handler{
+		store: store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+
+	t.Log("Graphs are rendered as DOT by default")
+	graph, err := h.GetWorkflowDefinitionGraph(ctx, &models.GetWorkflowDefinitionGraphInput{
+		Name:    workflowDefinition.Name,
+		Version: workflowDefinition.Version,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.GraphFormatDot, graph.Format)
+	assert.Contains(t, graph.Graph, `"start-state" -> "second-state";`)
+
+	graph, err = h.GetWorkflowDefinitionGraph(ctx, &models.GetWorkflowDefinitionGraphInput{
+		Name:    workflowDefinition.Name,
+		Version: workflowDefinition.Version,
+		Format:  swag.String("mermaid"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.GraphFormatMermaid, graph.Format)
+	assert.Contains(t, graph.Graph, "flowchart TD\n")
+}
+
+func TestGetWorkflowDefinitionStats(t *testing.T) {
+	store := memory.New()
+	h := Handler{
+		store: store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	running := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	running.Status = models.WorkflowStatusRunning
+	require.NoError(t, store.SaveWorkflow(ctx, *running))
+	failed := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	failed.Status = models.WorkflowStatusRunning
+	require.NoError(t, store.SaveWorkflow(ctx, *failed))
+	saved, err := store.GetWorkflowByID(ctx, failed.ID)
+	require.NoError(t, err)
+	saved.Status = models.WorkflowStatusFailed
+	saved.StoppedAt = strfmt.DateTime(time.Time(saved.CreatedAt).Add(time.Minute))
+	require.NoError(t, store.UpdateWorkflow(ctx, saved))
+
+	t.Log("Workflows created in the last 24 hours are counted by default")
+	stats, err := h.GetWorkflowDefinitionStats(ctx, &models.GetWorkflowDefinitionStatsInput{
+		Name: workflowDefinition.Name,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Total)
+	assert.Equal(t, map[string]int64{"running": 1, "failed": 1}, stats.StatusCounts)
+	assert.Equal(t, map[string]int64{"false": 2}, stats.ResolvedByUserCounts)
+	assert.Equal(t, int64(1), stats.Durations.Count)
+	assert.InEpsilon(t, 60, stats.Durations.P50, 0.1)
+	assert.True(t, time.Time(stats.CreatedBefore).Equal(time.Time(stats.CreatedBefore).Truncate(time.Hour)))
+
+	t.Log("Earlier time windows don't count the workflows")
+	before := strfmt.DateTime(time.Now().Add(-2 * time.Hour))
+	stats, err = h.GetWorkflowDefinitionStats(ctx, &models.GetWorkflowDefinitionStatsInput{
+		Name:          workflowDefinition.Name,
+		CreatedBefore: &before,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Total)
+	assert.Empty(t, stats.StatusCounts)
+
+	_, err = h.GetWorkflowDefinitionStats(ctx, &models.GetWorkflowDefinitionStatsInput{
+		Name:          workflowDefinition.Name,
+		CreatedAfter:  &before,
+		CreatedBefore: &before,
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	_, err = h.GetWorkflowDefinitionStats(ctx, &models.GetWorkflowDefinitionStatsInput{Name: "unknown"})
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+func TestGetWorkflowDefinitionStateStats(t *testing.T) {
+	store := memory.New()
+	h := Handler{
+		store: store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	now := time.Now()
+	at := func(ago time.Duration) strfmt.DateTime { return strfmt.DateTime(now.Add(-ago)) }
+	for i := 0; i < 3; i++ {
+		workflow := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+		workflow.Status = models.WorkflowStatusSucceeded
+		workflow.StoppedAt = at(0)
+		workflow.Jobs = []*models.Job{{
+			State:     "start-state",
+			Status:    models.JobStatusSucceeded,
+			CreatedAt: at(3 * time.Minute),
+			StartedAt: at(2 * time.Minute),
+			StoppedAt: at(time.Minute),
+		}}
+		require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+		// the update loop counts the jobs of workflows once they stop
+		change, ok := resources.StateStatsChange(*workflow)
+		require.True(t, ok)
+		require.NoError(t, store.AddWorkflowStatsCounts(ctx, change))
+	}
+
+	stats, err := h.GetWorkflowDefinitionStateStats(ctx, &models.GetWorkflowDefinitionStateStatsInput{
+		Name: workflowDefinition.Name,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.Workflows)
+	require.Len(t, stats.States, 1)
+	assert.Equal(t, "start-state", stats.States[0].State)
+	assert.Equal(t, workflowDefinition.Version, stats.States[0].Version)
+	assert.Equal(t, int64(3), stats.States[0].Jobs)
+	assert.Equal(t, float64(0), stats.States[0].FailureRate)
+	assert.InEpsilon(t, 60, stats.States[0].QueueTime.P50, 0.1)
+	assert.InEpsilon(t, 60, stats.States[0].RunTime.P95, 0.1)
+
+	t.Log("Workflows created outside of the time window aren't counted")
+	before := strfmt.DateTime(now.Add(-2 * time.Hour))
+	stats, err = h.GetWorkflowDefinitionStateStats(ctx, &models.GetWorkflowDefinitionStateStatsInput{
+		Name:          workflowDefinition.Name,
+		CreatedBefore: &before,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Workflows)
+	assert.Empty(t, stats.States)
+
+	_, err = h.GetWorkflowDefinitionStateStats(ctx, &models.GetWorkflowDefinitionStateStatsInput{Name: "unknown"})
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+func TestGetWorkflowGraph(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	workflow := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	mockWFM.EXPECT().UpdateWorkflowSummary(gomock.Any(), gomock.Any()).Return(nil)
+	mockWFM.EXPECT().UpdateWorkflowHistory(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, workflow *models.Workflow) {
+			workflow.Jobs = []*models.Job{
+				{State: "start-state", Status: models.JobStatusSucceeded, Attempts: []*models.JobAttempt{{}}},
+				{State: "second-state", Status: models.JobStatusRunning},
+			}
+		}).
+		Return(nil)
+
+	graph, err := h.GetWorkflowGraph(ctx, &models.GetWorkflowGraphInput{WorkflowID: workflow.ID})
+	require.NoError(t, err)
+	assert.Contains(t, graph.Graph, `succeeded\nattempts: 2`)
+	assert.Contains(t, graph.Graph, `second-state\nfake-resource-2\nrunning`)
+}
+
+func TestGetWorkflowJob(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	workflow := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	cause := strings.Repeat("frame\n", 100) + "KeyError: 'district'"
+	mockWFM.EXPECT().UpdateWorkflowSummary(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockWFM.EXPECT().UpdateWorkflowHistory(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, workflow *models.Workflow) {
+			workflow.Jobs = []*models.Job{
+				{ID: "2", State: "start-state", Status: models.JobStatusSucceeded},
+				{ID: "7", State: "second-state", Status: models.JobStatusFailed, ErrorName: "KeyError", Cause: cause},
+			}
+		}).
+		Return(nil).
+		Times(2)
+
+	job, err := h.GetWorkflowJob(ctx, &models.GetWorkflowJobInput{WorkflowID: workflow.ID, JobID: "7"})
+	require.NoError(t, err)
+	assert.Equal(t, "KeyError", job.ErrorName)
+	assert.Equal(t, cause, job.Cause)
+
+	_, err = h.GetWorkflowJob(ctx, &models.GetWorkflowJobInput{WorkflowID: workflow.ID, JobID: "3"})
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+func TestImportWorkflowDefinition(t *testing.T) {
+	store := memory.New()
+	h := Handler{
+		store: store,
+	}
+	ctx := context.Background()
+
+	definition := `{
+  "StartAt": "fetch",
+  "States": {
+    "fetch": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:us-west-2:111111111111:activity:production--fetcher",
+      "TimeoutSeconds": 60,
+      "Next": "notify",
+      "Retry": [
+        {"ErrorEquals": ["sfncli.CommandTerminated"], "IntervalSeconds": 10, "MaxAttempts": 10, "BackoffRate": 1}
+      ]
+    },
+    "notify": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:111111111111:function:production--notifier",
+      "TimeoutSeconds": 60,
+      "End": true,
+      "Retry": []
+    }
+  }
+}`
+	wfd, err := h.ImportWorkflowDefinition(ctx, &models.ImportWorkflowDefinitionRequest{
+		Name:       swag.String("imported"),
+		Definition: swag.String(definition),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fetcher", wfd.StateMachine.States["fetch"].Resource)
+	assert.Empty(t, wfd.StateMachine.States["fetch"].Retry)
+	assert.Equal(t, "lambda:notifier", wfd.StateMachine.States["notify"].Resource)
+
+	saved, err := store.GetWorkflowDefinition(ctx, "imported", 0)
+	require.NoError(t, err)
+	assert.Equal(t, wfd.StateMachine, saved.StateMachine)
+
+	t.Log("Fields that aren't supported are rejected instead of dropped")
+	_, err = h.ImportWorkflowDefinition(ctx, &models.ImportWorkflowDefinitionRequest{
+		Name:       swag.String("parameters"),
+		Definition: swag.String(`{"StartAt": "a", "States": {"a": {"Type": "Pass", "Parameters": {}, "End": true}}}`),
+	})
+	require.IsType(t, models.BadRequest{}, err)
+	assert.Contains(t, err.(models.BadRequest).Message, "Parameters")
+}
+
+func TestExportWorkflowDefinition(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+
+	export := &models.StateMachineExport{Name: "state-machine"}
+	mockWFM.EXPECT().
+		ExportStateMachine(gomock.Any(), gomock.Any(), "staging").
+		Return(export, nil)
+	res, err := h.ExportWorkflowDefinition(ctx, &models.ExportWorkflowDefinitionInput{
+		Name:      workflowDefinition.Name,
+		Version:   workflowDefinition.Version,
+		Namespace: "staging",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, export, res)
+}
+
+func TestArchiveWorkflowDefinition(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	_, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveNamespace(ctx, models.Namespace{Name: "staging", Status: models.NamespaceStatusActive}))
+
+	t.Log("The state machines of every version are deleted")
+	mockWFM.EXPECT().
+		DeleteStateMachines(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(2)
+	require.NoError(t, h.ArchiveWorkflowDefinition(ctx, workflowDefinition.Name))
+
+	t.Log("Archived definitions can't start workflows or get new versions")
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		Namespace: "staging",
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:    workflowDefinition.Name,
+			Version: -1,
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name: workflowDefinition.Name,
+		NewWorkflowDefinitionRequest: &models.NewWorkflowDefinitionRequest{
+			Name:         workflowDefinition.Name,
+			Manager:      workflowDefinition.Manager,
+			StateMachine: workflowDefinition.StateMachine,
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Unarchived definitions can start workflows again")
+	require.NoError(t, h.UnarchiveWorkflowDefinition(ctx, workflowDefinition.Name))
+	mockWFM.EXPECT().
+		CreateWorkflow(gomock.Any(), gomock.Any(), "", "{}", gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&models.Workflow{}, nil)
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		Namespace: "staging",
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:    workflowDefinition.Name,
+			Version: -1,
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestDeleteWorkflowDefinitionVersion(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	latest, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+	workflow := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+	input := &models.DeleteWorkflowDefinitionVersionInput{
+		Name:    workflowDefinition.Name,
+		Version: workflowDefinition.Version,
+	}
+
+	t.Log("Versions with workflows are kept")
+	err = h.DeleteWorkflowDefinitionVersion(ctx, input)
+	assert.IsType(t, models.Conflict{}, err)
+
+	t.Log("Every other version can be deleted along with its state machines, including the latest")
+	mockWFM.EXPECT().
+		DeleteStateMachines(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, wfd models.WorkflowDefinition) {
+			assert.Equal(t, workflowDefinition.Name, wfd.Name)
+		}).
+		Return(nil).
+		Times(2)
+	require.NoError(t, h.DeleteWorkflowDefinitionVersion(ctx, &models.DeleteWorkflowDefinitionVersionInput{
+		Name:    latest.Name,
+		Version: latest.Version,
+	}))
+	require.NoError(t, store.DeleteWorkflowByID(ctx, workflow.ID))
+	require.NoError(t, h.DeleteWorkflowDefinitionVersion(ctx, input))
+	_, err = h.GetWorkflowDefinitionByNameAndVersion(ctx, &models.GetWorkflowDefinitionByNameAndVersionInput{
+		Name:    workflowDefinition.Name,
+		Version: workflowDefinition.Version,
+	})
+	assert.Error(t, err)
+
+	t.Log("A definition created again after all its versions were deleted is numbered after them")
+	recreated, err := h.NewWorkflowDefinition(ctx, &models.NewWorkflowDefinitionRequest{
+		Name:         workflowDefinition.Name,
+		Manager:      workflowDefinition.Manager,
+		StateMachine: workflowDefinition.StateMachine,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, latest.Version+1, recreated.Version)
+}
+
+func TestWorkflowDefinitionAliases(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+		random:  rand.New(rand.NewSource(1)),
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	canaryDefinition, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveNamespace(ctx, models.Namespace{Name: "staging", Status: models.NamespaceStatusActive}))
+
+	t.Log("Aliases must point at existing versions")
+	_, err = h.PutWorkflowDefinitionAlias(ctx, &models.PutWorkflowDefinitionAliasInput{
+		Name:  workflowDefinition.Name,
+		Alias: "stable",
+		WorkflowDefinitionAlias: &models.WorkflowDefinitionAlias{
+			Version:       workflowDefinition.Version,
+			CanaryVersion: swag.Int64(canaryDefinition.Version + 1),
+			CanaryWeight:  swag.Int64(5),
+		},
+	})
+	assert.Error(t, err)
+
+	alias, err := h.PutWorkflowDefinitionAlias(ctx, &models.PutWorkflowDefinitionAliasInput{
+		Name:  workflowDefinition.Name,
+		Alias: "stable",
+		WorkflowDefinitionAlias: &models.WorkflowDefinitionAlias{
+			Version:       workflowDefinition.Version,
+			CanaryVersion: swag.Int64(canaryDefinition.Version),
+			CanaryWeight:  swag.Int64(100),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, workflowDefinition.Name, alias.Name)
+	assert.Equal(t, "stable", alias.Alias)
+
+	t.Log("Workflows started through an alias run the version it picks and record the alias")
+	mockWFM.EXPECT().
+		CreateWorkflow(gomock.Any(), gomock.Any(), "stable", "{}", gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, def models.WorkflowDefinition, alias, input, namespace, queue string, tags map[string]interface{}) {
+			assert.Equal(t, canaryDefinition.Version, def.Version)
+		}).
+		Return(&models.Workflow{}, nil)
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		Namespace: "staging",
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:  workflowDefinition.Name,
+			Alias: "stable",
+		},
+	})
+	require.NoError(t, err)
+
+	t.Log("Versions that aliases point at can't be deleted")
+	err = h.DeleteWorkflowDefinitionVersion(ctx, &models.DeleteWorkflowDefinitionVersionInput{
+		Name:    workflowDefinition.Name,
+		Version: workflowDefinition.Version,
+	})
+	assert.IsType(t, models.Conflict{}, err)
+
+	t.Log("Aliases that changed since they were read aren't overwritten")
+	_, err = Handler{store: staleAliasStore{store}}.PutWorkflowDefinitionAlias(ctx, &models.PutWorkflowDefinitionAliasInput{
+		Name:                    workflowDefinition.Name,
+		Alias:                   "stable",
+		WorkflowDefinitionAlias: &models.WorkflowDefinitionAlias{Version: canaryDefinition.Version},
+	})
+	assert.IsType(t, models.Conflict{}, err)
+	alias, err = store.GetWorkflowDefinitionAlias(ctx, workflowDefinition.Name, "stable")
+	require.NoError(t, err)
+	assert.Equal(t, workflowDefinition.Version, alias.Version)
+
+	require.NoError(t, h.DeleteWorkflowDefinitionAlias(ctx, &models.DeleteWorkflowDefinitionAliasInput{
+		Name:  workflowDefinition.Name,
+		Alias: "stable",
+	}))
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		Namespace: "staging",
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:  workflowDefinition.Name,
+			Alias: "stable",
+		},
+	})
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+// staleAliasStore reads aliases as they were before their last update, like a read that raced
+// with another update.
+type staleAliasStore struct {
+	memory.MemoryStore
+}
+
+func (s staleAliasStore) GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error) {
+	res, err := s.MemoryStore.GetWorkflowDefinitionAlias(ctx, name, alias)
+	res.LastUpdated = strfmt.DateTime(time.Time(res.LastUpdated).Add(-time.Second))
+	return res, err
+}
+
+func TestWorkflowDefinitionRollout(t *testing.T) {
+	h := Handler{
+		store: memory.New(),
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, h.store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	newDefinition, err := h.store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+	_, err = h.PutWorkflowDefinitionAlias(ctx, &models.PutWorkflowDefinitionAliasInput{
+		Name:                    workflowDefinition.Name,
+		Alias:                   "stable",
+		WorkflowDefinitionAlias: &models.WorkflowDefinitionAlias{Version: workflowDefinition.Version},
+	})
+	require.NoError(t, err)
+
+	_, err = h.GetWorkflowDefinitionRollout(ctx, &models.GetWorkflowDefinitionRolloutInput{
+		Name:  workflowDefinition.Name,
+		Alias: "stable",
+	})
+	assert.IsType(t, models.NotFound{}, err)
+
+	rollout, err := h.StartWorkflowDefinitionRollout(ctx, &models.StartWorkflowDefinitionRolloutInput{
+		Name:           workflowDefinition.Name,
+		Alias:          "stable",
+		RolloutRequest: &models.RolloutRequest{ToVersion: swag.Int64(newDefinition.Version)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusRunning, rollout.Status)
+	alias, err := h.store.GetWorkflowDefinitionAlias(ctx, workflowDefinition.Name, "stable")
+	require.NoError(t, err)
+	assert.Equal(t, newDefinition.Version, *alias.CanaryVersion)
+
+	t.Log("Aliases can't be changed by hand during a rollout")
+	_, err = h.PutWorkflowDefinitionAlias(ctx, &models.PutWorkflowDefinitionAliasInput{
+		Name:                    workflowDefinition.Name,
+		Alias:                   "stable",
+		WorkflowDefinitionAlias: &models.WorkflowDefinitionAlias{Version: newDefinition.Version},
+	})
+	assert.IsType(t, models.Conflict{}, err)
+
+	rollout, err = h.CancelWorkflowDefinitionRollout(ctx, &models.CancelWorkflowDefinitionRolloutInput{
+		Name:  workflowDefinition.Name,
+		Alias: "stable",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.RolloutStatusCancelled, rollout.Status)
+	assert.Len(t, rollout.Decisions, 2)
+	alias, err = h.store.GetWorkflowDefinitionAlias(ctx, workflowDefinition.Name, "stable")
+	require.NoError(t, err)
+	assert.Equal(t, workflowDefinition.Version, alias.Version)
+	assert.Nil(t, alias.CanaryVersion)
+}
+
+func TestWorkflowDefinitionMetadata(t *testing.T) {
+	h := Handler{
+		store: memory.New(),
+	}
+	ctx := context.Background()
+
+	newRequest := func(name, team string) *models.NewWorkflowDefinitionRequest {
+		return &models.NewWorkflowDefinitionRequest{
+			Name:        name,
+			Manager:     models.ManagerStepFunctions,
+			Description: "Imports rosters from " + name,
+			Team:        team,
+			Contact:     "#oncall-" + team,
+			Labels:      map[string]string{"tier": "1"},
+			Changelog:   "first version",
+			StateMachine: &models.SLStateMachine{
+				StartAt: "start-state",
+				States: map[string]models.SLState{
+					"start-state": models.SLState{
+						Type:     models.SLStateTypeTask,
+						Resource: "test-resource",
+						End:      true,
+					},
+				},
+			},
+		}
+	}
+	_, err := h.NewWorkflowDefinition(ctx, newRequest("sync-district", "eng-infra"))
+	require.NoError(t, err)
+	_, err = h.NewWorkflowDefinition(ctx, newRequest("send-invoices", "eng-billing"))
+	require.NoError(t, err)
+
+	t.Log("New versions keep the metadata they don't override")
+	updateRequest := newRequest("sync-district", "")
+	updateRequest.Description = ""
+	updateRequest.Contact = ""
+	updateRequest.Labels = nil
+	updateRequest.Changelog = "retry failed imports"
+	updated, err := h.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name:                         "sync-district",
+		NewWorkflowDefinitionRequest: updateRequest,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Imports rosters from sync-district", updated.Description)
+	assert.Equal(t, "eng-infra", updated.Team)
+	assert.Equal(t, "#oncall-eng-infra", updated.Contact)
+	assert.Equal(t, map[string]string{"tier": "1"}, updated.Labels)
+	assert.Equal(t, "retry failed imports", updated.Changelog)
+
+	t.Log("Definitions can be filtered by team, label and text")
+	defs, err := h.GetWorkflowDefinitions(ctx, &models.GetWorkflowDefinitionsInput{
+		Team: swag.String("eng-billing"),
+	})
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	assert.Equal(t, "send-invoices", defs[0].Name)
+
+	defs, err = h.GetWorkflowDefinitions(ctx, &models.GetWorkflowDefinitionsInput{
+		Label:  swag.String("tier=1"),
+		Search: swag.String("oncall-eng-infra"),
+	})
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	assert.Equal(t, "sync-district", defs[0].Name)
+
+	defs, err = h.GetWorkflowDefinitions(ctx, &models.GetWorkflowDefinitionsInput{})
+	require.NoError(t, err)
+	assert.Len(t, defs, 2)
+
+	_, err = h.GetWorkflowDefinitions(ctx, &models.GetWorkflowDefinitionsInput{
+		Label: swag.String("tier"),
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+}
+
+func TestWorkflowDefinitionTemplates(t *testing.T) {
+	h := Handler{
+		store: memory.New(),
+	}
+	ctx := context.Background()
+
+	template := &models.WorkflowDefinitionTemplate{
+		Manager: models.ManagerStepFunctions,
+		Parameters: []*models.TemplateParameter{
+			{Name: swag.String("resource")},
+			{Name: swag.String("timeout"), Default: swag.String("60")},
+		},
+		StateMachine: `{
+			"StartAt": "sync",
+			"States": {
+				"sync": {"Type": "Task", "Resource": "{{resource}}", "TimeoutSeconds": {{timeout}}, "End": true}
+			}
+		}`,
+	}
+	saved, err := h.PutWorkflowDefinitionTemplate(ctx, &models.PutWorkflowDefinitionTemplateInput{
+		Name:                       "sync",
+		WorkflowDefinitionTemplate: template,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), saved.Version)
+
+	def, err := h.InstantiateWorkflowDefinitionTemplate(ctx, &models.InstantiateWorkflowDefinitionTemplateInput{
+		Name: "sync",
+		TemplateInstanceRequest: &models.TemplateInstanceRequest{
+			Name:       swag.String("sync-district"),
+			Parameters: map[string]string{"resource": "sync-district"},
+			Team:       "eng-infra",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "sync-district", def.StateMachine.States["sync"].Resource)
+	assert.Equal(t, int64(60), def.StateMachine.States["sync"].TimeoutSeconds)
+	assert.Equal(t, &models.WorkflowDefinitionTemplateRef{
+		Name:       "sync",
+		Version:    0,
+		Parameters: map[string]string{"resource": "sync-district", "timeout": "60"},
+	}, def.Template)
+
+	t.Log("Definitions that weren't generated from the template can't be instantiated over")
+	_, err = h.NewWorkflowDefinition(ctx, &models.NewWorkflowDefinitionRequest{
+		Name:         "sync-school",
+		Manager:      models.ManagerStepFunctions,
+		StateMachine: def.StateMachine,
+	})
+	require.NoError(t, err)
+	_, err = h.InstantiateWorkflowDefinitionTemplate(ctx, &models.InstantiateWorkflowDefinitionTemplateInput{
+		Name: "sync",
+		TemplateInstanceRequest: &models.TemplateInstanceRequest{
+			Name:       swag.String("sync-school"),
+			Parameters: map[string]string{"resource": "sync-school"},
+		},
+	})
+	assert.IsType(t, models.Conflict{}, err)
+
+	t.Log("Updating the template leaves instances alone until they are regenerated")
+	template.StateMachine = strings.Replace(template.StateMachine, `"End": true`, `"Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 2}], "End": true`, 1)
+	saved, err = h.PutWorkflowDefinitionTemplate(ctx, &models.PutWorkflowDefinitionTemplateInput{
+		Name:                       "sync",
+		WorkflowDefinitionTemplate: template,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), saved.Version)
+	instances, err := h.GetWorkflowDefinitionTemplateInstances(ctx, "sync")
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, int64(0), instances[0].Template.Version)
+
+	regenerated, err := h.RegenerateWorkflowDefinitionTemplateInstances(ctx, "sync")
+	require.NoError(t, err)
+	require.Len(t, regenerated, 1)
+	assert.Equal(t, int64(1), regenerated[0].Version)
+	assert.Equal(t, int64(1), regenerated[0].Template.Version)
+	assert.Equal(t, "eng-infra", regenerated[0].Team)
+	assert.Len(t, regenerated[0].StateMachine.States["sync"].Retry, 1)
+
+	regenerated, err = h.RegenerateWorkflowDefinitionTemplateInstances(ctx, "sync")
+	require.NoError(t, err)
+	assert.Len(t, regenerated, 0)
+}
+
+func TestNamespaces(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager:                     mockWFM,
+		store:                       store,
+		requireRegisteredNamespaces: true,
+	}
+	ctx := context.Background()
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	startRequest := &models.StartWorkflowRequest{
+		Namespace: "staging",
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:    workflowDefinition.Name,
+			Version: -1,
+		},
+	}
+	stateResource := &models.NewStateResource{Name: "echo", Namespace: "staging"}
+
+	t.Log("Unknown namespaces are allowed unless namespaces must be registered")
+	mockWFM.EXPECT().
+		CreateWorkflow(gomock.Any(), gomock.Any(), "", "{}", "staging", gomock.Any(), gomock.Any()).
+		Return(&models.Workflow{}, nil)
+	_, err := Handler{manager: mockWFM, store: store}.StartWorkflow(ctx, startRequest)
+	require.NoError(t, err)
+	_, err = h.StartWorkflow(ctx, startRequest)
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.PostStateResource(ctx, stateResource)
+	assert.IsType(t, models.BadRequest{}, err)
+
+	namespace, err := h.PutNamespace(ctx, &models.PutNamespaceInput{
+		Namespace:           "staging",
+		RegisteredNamespace: &models.Namespace{AllowedDefinitions: []string{"other-definition"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "staging", namespace.Name)
+	assert.Equal(t, models.NamespaceStatusActive, namespace.Status)
+
+	t.Log("Namespaces only start workflows of their allowed definitions")
+	_, err = h.StartWorkflow(ctx, startRequest)
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.PutNamespace(ctx, &models.PutNamespaceInput{
+		Namespace:           "staging",
+		RegisteredNamespace: &models.Namespace{AllowedDefinitions: []string{workflowDefinition.Name}},
+	})
+	require.NoError(t, err)
+	mockWFM.EXPECT().
+		CreateWorkflow(gomock.Any(), gomock.Any(), "", "{}", "staging", gomock.Any(), gomock.Any()).
+		Return(&models.Workflow{}, nil)
+	_, err = h.StartWorkflow(ctx, startRequest)
+	require.NoError(t, err)
+	mockWFM.EXPECT().
+		CreateActivity(gomock.Any(), "staging", "staging--echo").
+		Return("arn:aws:states:us-east-1:123456789012:activity:staging--echo", nil)
+	_, err = h.PostStateResource(ctx, stateResource)
+	require.NoError(t, err)
+
+	t.Log("Frozen namespaces reject new workflows and state resources")
+	_, err = h.PutNamespace(ctx, &models.PutNamespaceInput{
+		Namespace:           "staging",
+		RegisteredNamespace: &models.Namespace{Status: models.NamespaceStatusFrozen},
+	})
+	require.NoError(t, err)
+	_, err = h.StartWorkflow(ctx, startRequest)
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.PostStateResource(ctx, stateResource)
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Deleting a namespace deletes its config")
+	require.NoError(t, store.SaveNamespaceConfig(ctx, models.NamespaceConfig{Namespace: "staging"}))
+	require.NoError(t, h.DeleteNamespace(ctx, "staging"))
+	_, err = h.GetNamespaceConfig(ctx, "staging")
+	assert.IsType(t, models.NotFound{}, err)
+	_, err = h.GetNamespace(ctx, "staging")
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+func TestStateResources(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+	ctx := context.Background()
+	_, err := h.PutNamespace(ctx, &models.PutNamespaceInput{Namespace: "staging", RegisteredNamespace: &models.Namespace{}})
+	require.NoError(t, err)
+
+	t.Log("Activities are created in Step Functions")
+	activityARN := "arn:aws:states:us-east-1:123456789012:activity:staging--echo"
+	mockWFM.EXPECT().
+		CreateActivity(gomock.Any(), "staging", "staging--echo").
+		Return(activityARN, nil)
+	activity, err := h.PostStateResource(ctx, &models.NewStateResource{Name: "echo", Namespace: "staging"})
+	require.NoError(t, err)
+	assert.Equal(t, activityARN, activity.URI)
+	assert.Equal(t, models.StateResourceTypeActivityARN, activity.Type)
+
+	t.Log("Activities in another account are rejected")
+	mockWFM.EXPECT().
+		CreateActivity(gomock.Any(), "staging", "echo").
+		Return("arn:aws:states:us-east-1:123456789012:activity:echo", nil)
+	_, err = h.PostStateResource(ctx, &models.NewStateResource{
+		Name: "other-echo", Namespace: "staging", URI: "arn:aws:states:us-west-2:210987654321:activity:echo",
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Lambda functions and batch job definitions keep their URI")
+	lambda, err := h.PostStateResource(ctx, &models.NewStateResource{
+		Name: "resize", Namespace: "staging", URI: "arn:aws:lambda:us-east-1:123456789012:function:resize",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.StateResourceTypeLambdaFunctionARN, lambda.Type)
+	_, err = h.PostStateResource(ctx, &models.NewStateResource{
+		Name: "unknown", Namespace: "staging", URI: "arn:aws:sqs:us-east-1:123456789012:queue",
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	stateResources, nextPageToken, err := h.GetStateResources(ctx, &models.GetStateResourcesInput{Namespace: swag.String("staging")})
+	require.NoError(t, err)
+	assert.Len(t, stateResources, 2)
+	assert.Empty(t, nextPageToken)
+
+	t.Log("Workers are listed for registered StateResources")
+	require.NoError(t, store.RecordActivityWorker(ctx, models.ActivityWorker{
+		Namespace: "staging", StateResourceName: "echo", Name: "worker-1", Succeeded: 1,
+	}))
+	workers, err := h.GetActivityWorkers(ctx, &models.GetActivityWorkersInput{Namespace: "staging", Name: "echo"})
+	require.NoError(t, err)
+	require.Len(t, workers, 1)
+	assert.Equal(t, "worker-1", workers[0].Name)
+	_, err = h.GetActivityWorkers(ctx, &models.GetActivityWorkersInput{Namespace: "staging", Name: "unknown"})
+	assert.IsType(t, models.NotFound{}, err)
+
+	t.Log("Deleting a StateResource can delete its activity")
+	require.NoError(t, h.DeleteStateResource(ctx, &models.DeleteStateResourceInput{Name: "resize", Namespace: "staging", DeleteActivity: swag.Bool(true)}))
+	mockWFM.EXPECT().DeleteActivity(gomock.Any(), "staging", activityARN).Return(nil)
+	require.NoError(t, h.DeleteStateResource(ctx, &models.DeleteStateResourceInput{Name: "echo", Namespace: "staging", DeleteActivity: swag.Bool(true)}))
+	stateResources, _, err = h.GetStateResources(ctx, &models.GetStateResourcesInput{})
+	require.NoError(t, err)
+	assert.Len(t, stateResources, 0)
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"flag"
 	"log"
+	"math/rand"
 	"net/http"
 	"os"
 	"path"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/kardianos/osext"
 
@@ -21,12 +25,16 @@ import (
 	"github.com/Clever/workflow-manager/executor/sfncache"
 	"github.com/Clever/workflow-manager/gen-go/server"
 	dynamodbgen "github.com/Clever/workflow-manager/gen-go/server/db/dynamodb"
+	"github.com/Clever/workflow-manager/store/blob"
 	dynamodbstore "github.com/Clever/workflow-manager/store/dynamodb"
 	"gopkg.in/Clever/kayvee-go.v6/logger"
 )
 
 // Config contains the configuration for the workflow-manager app
 type Config struct {
+	BlobS3Bucket                    string
+	BlobS3Region                    string
+	DynamoPrefixNamespaceConfigs    string
 	DynamoPrefixStateResources      string
 	DynamoPrefixWorkflowDefinitions string
 	DynamoPrefixWorkflows           string
@@ -36,6 +44,8 @@ type Config struct {
 	SFNRoleARN                      string
 	SQSRegion                       string
 	SQSQueueURL                     string
+	AllowUnregisteredNamespaces     bool
+	BackfillWorkflowIndexKeys       bool
 }
 
 func setupRouting() {
@@ -60,6 +70,7 @@ func main() {
 		Config: aws.Config{Region: aws.String(c.DynamoRegion)},
 	})))
 	db := dynamodbstore.New(svc, dynamodbstore.TableConfig{
+		PrefixNamespaceConfigs:    c.DynamoPrefixNamespaceConfigs,
 		PrefixStateResources:      c.DynamoPrefixStateResources,
 		PrefixWorkflowDefinitions: c.DynamoPrefixWorkflowDefinitions,
 		PrefixWorkflows:           c.DynamoPrefixWorkflows,
@@ -75,6 +86,18 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	// the indexes of the workflows table are created outside of workflow-manager, so they're
+	// checked up front rather than failing the queries that use them
+	if err := db.CheckWorkflowIndexes(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if c.BackfillWorkflowIndexKeys {
+		go backfillWorkflowIndexKeys(db)
+	}
+	if c.BlobS3Bucket != "" {
+		s3api := s3.New(session.New(), aws.NewConfig().WithRegion(c.BlobS3Region))
+		db.Blobs = blob.NewS3(s3api, c.BlobS3Bucket, "workflow-manager")
+	}
 
 	sfnapi := sfn.New(session.New(), aws.NewConfig().WithRegion(c.SFNRegion))
 	countedSFNAPI := sfncounter.New(sfnapi)
@@ -84,10 +107,13 @@ func main() {
 	}
 
 	sqsapi := sqs.New(session.New(), aws.NewConfig().WithRegion(c.SQSRegion))
-	wfmSFN := executor.NewSFNWorkflowManager(cachedSFNAPI, sqsapi, db, c.SFNRoleARN, c.SFNRegion, c.SFNAccountID, c.SQSQueueURL)
+	wfmSFN := executor.NewSFNWorkflowManager(cachedSFNAPI, newSFNAPI, sqsapi, db, c.SFNRoleARN, c.SFNRegion, c.SFNAccountID, c.SQSQueueURL)
 	h := Handler{
-		store:   db,
-		manager: wfmSFN,
+		store:                       db,
+		manager:                     wfmSFN,
+		accountID:                   c.SFNAccountID,
+		requireRegisteredNamespaces: !c.AllowUnregisteredNamespaces,
+		random:                      rand.New(newLockedSource(time.Now().UnixNano())),
 	}
 	timeout := 5 * time.Second
 	s := server.NewWithMiddleware(h, *addr, []func(http.Handler) http.Handler{
@@ -102,9 +128,11 @@ func main() {
 				handler.ServeHTTP(w, r)
 			})
 		},
+		yamlMiddleware,
 	})
 
 	go executor.PollForPendingWorkflowsAndUpdateStore(context.Background(), wfmSFN, db, sqsapi, c.SQSQueueURL)
+	go executor.PollForRollouts(context.Background(), db, time.Minute)
 	go logSFNCounts(countedSFNAPI)
 
 	if err := s.Serve(); err != nil {
@@ -114,6 +142,28 @@ func main() {
 	log.Println("workflow-manager exited without error")
 }
 
+// backfillWorkflowIndexKeys adds workflows written before indexes of the workflows table were
+// added to those indexes.
+func backfillWorkflowIndexKeys(db dynamodbstore.DynamoDB) {
+	updated, err := db.BackfillWorkflowIndexKeys(context.Background())
+	if err != nil {
+		log.Printf("backfilling workflow index keys failed after %d workflows: %s", updated, err)
+		return
+	}
+	log.Printf("backfilled the index keys of %d workflows", updated)
+}
+
+// newSFNAPI creates a cached Step Functions client for namespaces that are configured to run
+// in a different region or account than the default.
+func newSFNAPI(region, assumeRoleARN string) (sfniface.SFNAPI, error) {
+	sess := session.New()
+	config := aws.NewConfig().WithRegion(region)
+	if assumeRoleARN != "" {
+		config = config.WithCredentials(stscreds.NewCredentials(sess, assumeRoleARN))
+	}
+	return sfncache.New(sfn.New(sess, config))
+}
+
 func awsSession(c Config) *session.Session {
 	options := session.Options{
 		Config:            aws.Config{Region: aws.String("us-east-1")},
@@ -125,6 +175,12 @@ func awsSession(c Config) *session.Session {
 
 func loadConfig() Config {
 	return Config{
+		BlobS3Bucket: os.Getenv("AWS_S3_BLOB_BUCKET"),
+		BlobS3Region: os.Getenv("AWS_S3_BLOB_REGION"),
+		DynamoPrefixNamespaceConfigs: getEnvVarOrDefault(
+			"AWS_DYNAMO_PREFIX_NAMESPACE_CONFIGS",
+			"workflow-manager-test",
+		),
 		DynamoPrefixStateResources: getEnvVarOrDefault(
 			"AWS_DYNAMO_PREFIX_STATE_RESOURCES",
 			"workflow-manager-test",
@@ -143,6 +199,9 @@ func loadConfig() Config {
 		SFNRoleARN:   os.Getenv("AWS_SFN_ROLE_ARN"),
 		SQSRegion:    os.Getenv("AWS_SQS_REGION"),
 		SQSQueueURL:  os.Getenv("AWS_SQS_URL"),
+
+		AllowUnregisteredNamespaces: os.Getenv("ALLOW_UNREGISTERED_NAMESPACES") == "true",
+		BackfillWorkflowIndexKeys:   os.Getenv("BACKFILL_WORKFLOW_INDEX_KEYS") == "true",
 	}
 }
 
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// EvaluateChoiceRule evaluates a Choice rule against the (InputPath-filtered) input of a
+// Choice state. As in Step Functions, comparing a value of the wrong type is false, but
+// referencing a Variable that doesn't exist in the input is an error.
+func EvaluateChoiceRule(rule *models.SLChoice, input interface{}) (bool, error) {
+	if rule == nil {
+		return false, fmt.Errorf("empty choice rule")
+	}
+
+	switch {
+	case len(rule.And) > 0:
+		for _, r := range rule.And {
+			matched, err := EvaluateChoiceRule(r, input)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	case len(rule.Or) > 0:
+		for _, r := range rule.Or {
+			matched, err := EvaluateChoiceRule(r, input)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case rule.Not != nil:
+		matched, err := EvaluateChoiceRule(rule.Not, input)
+		return !matched && err == nil, err
+	}
+
+	if rule.Variable == "" {
+		return false, fmt.Errorf("choice rule has no Variable")
+	}
+	value, err := getPath(input, rule.Variable)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case rule.StringEquals != nil:
+		s, ok := value.(string)
+		return ok && s == *rule.StringEquals, nil
+	case rule.StringLessThan != nil:
+		s, ok := value.(string)
+		return ok && s < *rule.StringLessThan, nil
+	case rule.StringGreaterThan != nil:
+		s, ok := value.(string)
+		return ok && s > *rule.StringGreaterThan, nil
+	case rule.StringLessThanEquals != nil:
+		s, ok := value.(string)
+		return ok && s <= *rule.StringLessThanEquals, nil
+	case rule.StringGreaterThanEquals != nil:
+		s, ok := value.(string)
+		return ok && s >= *rule.StringGreaterThanEquals, nil
+
+	case rule.NumericEquals != nil:
+		n, ok := value.(float64)
+		return ok && n == float64(*rule.NumericEquals), nil
+	case rule.NumericLessThan != nil:
+		n, ok := value.(float64)
+		return ok && n < *rule.NumericLessThan, nil
+	case rule.NumericGreaterThan != nil:
+		n, ok := value.(float64)
+		return ok && n > *rule.NumericGreaterThan, nil
+	case rule.NumericLessThanEquals != nil:
+		n, ok := value.(float64)
+		return ok && n <= float64(*rule.NumericLessThanEquals), nil
+	case rule.NumericGreaterThanEquals != nil:
+		n, ok := value.(float64)
+		return ok && n >= float64(*rule.NumericGreaterThanEquals), nil
+
+	case rule.BooleanEquals != nil:
+		b, ok := value.(bool)
+		return ok && b == *rule.BooleanEquals, nil
+
+	case rule.TimestampEquals != nil:
+		t, ok := timestampValue(value)
+		return ok && t.Equal(time.Time(*rule.TimestampEquals)), nil
+	case rule.TimestampLessThan != nil:
+		t, ok := timestampValue(value)
+		return ok && t.Before(time.Time(*rule.TimestampLessThan)), nil
+	case rule.TimestampGreaterThan != nil:
+		t, ok := timestampValue(value)
+		return ok && t.After(time.Time(*rule.TimestampGreaterThan)), nil
+	case rule.TimestampLessThanEquals != nil:
+		t, ok := timestampValue(value)
+		return ok && !t.After(time.Time(*rule.TimestampLessThanEquals)), nil
+	case rule.TimestampGreaterThanEquals != nil:
+		t, ok := timestampValue(value)
+		return ok && !t.Before(time.Time(*rule.TimestampGreaterThanEquals)), nil
+	}
+
+	return false, fmt.Errorf("choice rule for %s has no comparison", rule.Variable)
+}
+
+// timestampValue parses a JSON value as an RFC3339 timestamp.
+func timestampValue(value interface{}) (time.Time, bool) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	return t, err == nil
+}
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestEvaluateChoiceRule(t *testing.T) {
+	var input interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"district": "abc",
+		"count": 5,
+		"dryRun": true,
+		"startedAt": "2018-03-01T12:00:00Z",
+		"schools": [{"name": "first"}]
+	}`), &input))
+	startedAt := strfmt.DateTime(time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC))
+	later := strfmt.DateTime(time.Date(2018, 3, 2, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		rule     *models.SLChoice
+		expected bool
+	}{
+		{"StringEquals", &models.SLChoice{Variable: "$.district", StringEquals: swag.String("abc")}, true},
+		{"StringLessThan", &models.SLChoice{Variable: "$.district", StringLessThan: swag.String("abb")}, false},
+		{"StringGreaterThanEquals", &models.SLChoice{Variable: "$.district", StringGreaterThanEquals: swag.String("abc")}, true},
+		{"StringEquals on a number", &models.SLChoice{Variable: "$.count", StringEquals: swag.String("5")}, false},
+		{"NumericEquals", &models.SLChoice{Variable: "$.count", NumericEquals: swag.Int64(5)}, true},
+		{"NumericLessThan", &models.SLChoice{Variable: "$.count", NumericLessThan: swag.Float64(5)}, false},
+		{"NumericGreaterThan", &models.SLChoice{Variable: "$.count", NumericGreaterThan: swag.Float64(4.5)}, true},
+		{"NumericLessThanEquals", &models.SLChoice{Variable: "$.count", NumericLessThanEquals: swag.Int64(5)}, true},
+		{"BooleanEquals", &models.SLChoice{Variable: "$.dryRun", BooleanEquals: swag.Bool(false)}, false},
+		{"TimestampEquals", &models.SLChoice{Variable: "$.startedAt", TimestampEquals: &startedAt}, true},
+		{"TimestampLessThan", &models.SLChoice{Variable: "$.startedAt", TimestampLessThan: &later}, true},
+		{"TimestampGreaterThanEquals", &models.SLChoice{Variable: "$.startedAt", TimestampGreaterThanEquals: &later}, false},
+		{"Array index", &models.SLChoice{Variable: "$.schools[0].name", StringEquals: swag.String("first")}, true},
+		{"And", &models.SLChoice{And: []*models.SLChoice{
+			{Variable: "$.district", StringEquals: swag.String("abc")},
+			{Variable: "$.dryRun", BooleanEquals: swag.Bool(false)},
+		}}, false},
+		{"Or", &models.SLChoice{Or: []*models.SLChoice{
+			{Variable: "$.district", StringEquals: swag.String("xyz")},
+			{Variable: "$.dryRun", BooleanEquals: swag.Bool(true)},
+		}}, true},
+		{"Not", &models.SLChoice{Not: &models.SLChoice{Variable: "$.count", NumericEquals: swag.Int64(5)}}, false},
+	}
+	for _, test := range tests {
+		matched, err := EvaluateChoiceRule(test.rule, input)
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, matched, test.name)
+	}
+
+	t.Log("Variables that aren't in the input are an error")
+	_, err := EvaluateChoiceRule(&models.SLChoice{Variable: "$.missing", BooleanEquals: swag.Bool(true)}, input)
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// DiffStateMachines returns the structural differences between two state machines: states
+// that were added and removed, and field-level changes to top-level fields and to the states
+// present in both. Nested fields such as Retry and Choices are compared element by element.
+func DiffStateMachines(from, to models.SLStateMachine) *models.WorkflowDefinitionDiff {
+	diff := &models.WorkflowDefinitionDiff{
+		Changes:       []*models.FieldChange{},
+		StatesAdded:   []string{},
+		StatesRemoved: []string{},
+		StatesChanged: []*models.StateDiff{},
+	}
+
+	fromTopLevel, toTopLevel := from, to
+	fromTopLevel.States, toTopLevel.States = nil, nil
+	diff.Changes = diffJSONValues("", jsonValue(fromTopLevel), jsonValue(toTopLevel))
+
+	for _, name := range sortedStateNames(from) {
+		if _, ok := to.States[name]; !ok {
+			diff.StatesRemoved = append(diff.StatesRemoved, name)
+		}
+	}
+	for _, name := range sortedStateNames(to) {
+		fromState, ok := from.States[name]
+		if !ok {
+			diff.StatesAdded = append(diff.StatesAdded, name)
+			continue
+		}
+		changes := diffJSONValues("", jsonValue(fromState), jsonValue(to.States[name]))
+		if len(changes) > 0 {
+			diff.StatesChanged = append(diff.StatesChanged, &models.StateDiff{
+				State:   name,
+				Changes: changes,
+			})
+		}
+	}
+	return diff
+}
+
+// jsonValue converts a model to the generic representation produced by json.Unmarshal, so
+// that only fields present in its JSON are compared.
+func jsonValue(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// diffJSONValues compares two values produced by json.Unmarshal. Objects are compared key by
+// key and arrays index by index; any other difference is reported at the given path.
+func diffJSONValues(path string, from, to interface{}) []*models.FieldChange {
+	changes := []*models.FieldChange{}
+
+	fromObj, fromIsObj := from.(map[string]interface{})
+	toObj, toIsObj := to.(map[string]interface{})
+	if fromIsObj && toIsObj {
+		keys := map[string]bool{}
+		for key := range fromObj {
+			keys[key] = true
+		}
+		for key := range toObj {
+			keys[key] = true
+		}
+		sortedKeys := []string{}
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+
+		for _, key := range sortedKeys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			fromValue, inFrom := fromObj[key]
+			toValue, inTo := toObj[key]
+			switch {
+			case !inFrom:
+				changes = append(changes, &models.FieldChange{Field: keyPath, To: marshalJSONValue(toValue)})
+			case !inTo:
+				changes = append(changes, &models.FieldChange{Field: keyPath, From: marshalJSONValue(fromValue)})
+			default:
+				changes = append(changes, diffJSONValues(keyPath, fromValue, toValue)...)
+			}
+		}
+		return changes
+	}
+
+	fromArr, fromIsArr := from.([]interface{})
+	toArr, toIsArr := to.([]interface{})
+	if fromIsArr && toIsArr {
+		for i := 0; i < len(fromArr) || i < len(toArr); i++ {
+			indexPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(fromArr):
+				changes = append(changes, &models.FieldChange{Field: indexPath, To: marshalJSONValue(toArr[i])})
+			case i >= len(toArr):
+				changes = append(changes, &models.FieldChange{Field: indexPath, From: marshalJSONValue(fromArr[i])})
+			default:
+				changes = append(changes, diffJSONValues(indexPath, fromArr[i], toArr[i])...)
+			}
+		}
+		return changes
+	}
+
+	fromJSON, toJSON := marshalJSONValue(from), marshalJSONValue(to)
+	if fromJSON != toJSON {
+		changes = append(changes, &models.FieldChange{Field: path, From: fromJSON, To: toJSON})
+	}
+	return changes
+}
@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestDiffStateMachines(t *testing.T) {
+	from := simulationStateMachine()
+
+	t.Log("Identical state machines have no differences")
+	diff := DiffStateMachines(from, simulationStateMachine())
+	assert.Empty(t, diff.Changes)
+	assert.Empty(t, diff.StatesAdded)
+	assert.Empty(t, diff.StatesRemoved)
+	assert.Empty(t, diff.StatesChanged)
+
+	to := simulationStateMachine()
+	to.StartAt = "prepare"
+	to.States["prepare"] = models.SLState{Type: models.SLStateTypePass, Next: "fetch"}
+	delete(to.States, "process")
+	fetch := to.States["fetch"]
+	fetch.Resource = "new-fetcher"
+	fetch.Retry = []*models.SLRetrier{
+		{ErrorEquals: []models.SLErrorEquals{"States.Timeout"}, IntervalSeconds: 5, MaxAttempts: swag.Int64(3)},
+		{ErrorEquals: []models.SLErrorEquals{"States.ALL"}},
+	}
+	to.States["fetch"] = fetch
+	route := to.States["route"]
+	route.Choices = []*models.SLChoice{{
+		Variable:           "$.fetched.count",
+		NumericGreaterThan: swag.Float64(10),
+		Next:               "done",
+	}}
+	to.States["route"] = route
+
+	diff = DiffStateMachines(from, to)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, &models.FieldChange{Field: "StartAt", From: `"fetch"`, To: `"prepare"`}, diff.Changes[0])
+	assert.Equal(t, []string{"prepare"}, diff.StatesAdded)
+	assert.Equal(t, []string{"process"}, diff.StatesRemoved)
+
+	require.Len(t, diff.StatesChanged, 2)
+	assert.Equal(t, "fetch", diff.StatesChanged[0].State)
+	assert.Equal(t, []*models.FieldChange{
+		{Field: "Resource", From: `"fetcher"`, To: `"new-fetcher"`},
+		{Field: "Retry[0].MaxAttempts", From: "1", To: "3"},
+		{Field: "Retry[1]", To: `{"ErrorEquals":["States.ALL"]}`},
+	}, diff.StatesChanged[0].Changes)
+	assert.Equal(t, "route", diff.StatesChanged[1].State)
+	assert.Equal(t, []*models.FieldChange{
+		{Field: "Choices[0].Next", From: `"process"`, To: `"done"`},
+		{Field: "Choices[0].NumericGreaterThan", From: "0", To: "10"},
+	}, diff.StatesChanged[1].Changes)
+}
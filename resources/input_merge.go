@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// ValidateInputObject checks that a field holding input defaults (a definition's defaultInput
+// or a namespace's parameters) is either empty or a JSON object.
+func ValidateInputObject(field, input string) error {
+	if input == "" {
+		return nil
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &obj); err != nil {
+		return models.BadRequest{
+			Message: fmt.Sprintf("%s must be a JSON object: %s", field, err),
+		}
+	}
+	return nil
+}
+
+// EffectiveWorkflowInput computes the input a workflow is started with by deep-merging the
+// default input of its definition, the parameters of its namespace and the caller's input.
+// Later layers take precedence. Empty layers are skipped, so if only the caller's input is
+// set it is returned unchanged.
+func EffectiveWorkflowInput(defaultInput, namespaceParameters, input string) (string, error) {
+	if defaultInput == "" && namespaceParameters == "" {
+		return input, nil
+	}
+
+	layers := []struct {
+		field string
+		value string
+	}{
+		{"defaultInput", defaultInput},
+		{"namespace parameters", namespaceParameters},
+		{"input", input},
+	}
+
+	merged := map[string]interface{}{}
+	for _, layer := range layers {
+		if layer.value == "" {
+			continue
+		}
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(layer.value), &obj); err != nil {
+			return "", models.BadRequest{
+				Message: fmt.Sprintf("%s must be a JSON object: %s", layer.field, err),
+			}
+		}
+		mergeInputObjects(merged, obj)
+	}
+
+	effectiveInput, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(effectiveInput), nil
+}
+
+// mergeInputObjects merges src into dst. Nested objects are merged key by key; any other
+// value in src replaces the one in dst.
+func mergeInputObjects(dst, src map[string]interface{}) {
+	for key, srcValue := range src {
+		srcObj, srcIsObj := srcValue.(map[string]interface{})
+		dstObj, dstIsObj := dst[key].(map[string]interface{})
+		if srcIsObj && dstIsObj {
+			mergeInputObjects(dstObj, srcObj)
+		} else {
+			dst[key] = srcValue
+		}
+	}
+}
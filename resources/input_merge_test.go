@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestValidateInputObject(t *testing.T) {
+	assert.NoError(t, ValidateInputObject("defaultInput", ""))
+	assert.NoError(t, ValidateInputObject("defaultInput", `{"a": 1}`))
+	assert.IsType(t, models.BadRequest{}, ValidateInputObject("defaultInput", `[1, 2]`))
+	assert.IsType(t, models.BadRequest{}, ValidateInputObject("defaultInput", `not json`))
+}
+
+func TestEffectiveWorkflowInput(t *testing.T) {
+	t.Log("Input is unchanged without defaults or parameters")
+	input, err := EffectiveWorkflowInput("", "", `{"b":  2}`)
+	require.NoError(t, err)
+	assert.Equal(t, `{"b":  2}`, input)
+
+	t.Log("Caller input wins over namespace parameters, which win over defaults")
+	input, err = EffectiveWorkflowInput(
+		`{"dryRun": true, "bucket": "default-bucket", "opts": {"retries": 1, "verbose": false}}`,
+		`{"bucket": "prod-bucket", "opts": {"retries": 3}}`,
+		`{"dryRun": false, "opts": {"verbose": true}}`,
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"bucket": "prod-bucket",
+		"opts": {"retries": 3, "verbose": true}
+	}`, input)
+
+	t.Log("Non-object values replace objects")
+	input, err = EffectiveWorkflowInput(`{"opts": {"retries": 1}}`, "", `{"opts": null}`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"opts": null}`, input)
+
+	t.Log("Layers must be JSON objects")
+	_, err = EffectiveWorkflowInput(`{"a": 1}`, "", `"a string"`)
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = EffectiveWorkflowInput("", `[1]`, `{}`)
+	assert.IsType(t, models.BadRequest{}, err)
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// ValidateInputSchema checks that a workflow definition's inputSchema is a valid JSON Schema.
+func ValidateInputSchema(inputSchema string) error {
+	if inputSchema == "" {
+		return nil
+	}
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(inputSchema)); err != nil {
+		return models.BadRequest{
+			Message: fmt.Sprintf("inputSchema is not a valid JSON Schema: %s", err),
+		}
+	}
+	return nil
+}
+
+// ValidateWorkflowInput checks the input of a workflow against the inputSchema of its definition.
+// It returns a BadRequest listing every violation, or nil if the definition has no inputSchema.
+func ValidateWorkflowInput(wfd models.WorkflowDefinition, input string) error {
+	if wfd.InputSchema == "" {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewStringLoader(wfd.InputSchema),
+		gojsonschema.NewStringLoader(input),
+	)
+	if err != nil {
+		return models.BadRequest{
+			Message: fmt.Sprintf("could not validate input against inputSchema: %s", err),
+		}
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	violations := []string{}
+	for _, resultErr := range result.Errors() {
+		violations = append(violations, resultErr.String())
+	}
+	return models.BadRequest{
+		Message: fmt.Sprintf("input does not match the inputSchema of %s version %d: %s",
+			wfd.Name, wfd.Version, strings.Join(violations, "; ")),
+	}
+}
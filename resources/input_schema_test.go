@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestValidateInputSchema(t *testing.T) {
+	assert.NoError(t, ValidateInputSchema(""))
+	assert.NoError(t, ValidateInputSchema(`{"type": "object"}`))
+	assert.IsType(t, models.BadRequest{}, ValidateInputSchema(`{"type": 5}`))
+	assert.IsType(t, models.BadRequest{}, ValidateInputSchema(`not json`))
+}
+
+func TestValidateWorkflowInput(t *testing.T) {
+	wfd := KitchenSinkWorkflowDefinition(t)
+
+	t.Log("Any input is valid without an inputSchema")
+	assert.NoError(t, ValidateWorkflowInput(*wfd, `{"anything": 1}`))
+
+	wfd.InputSchema = `{
+		"type": "object",
+		"required": ["district", "dryRun"],
+		"properties": {
+			"district": {"type": "string"},
+			"dryRun": {"type": "boolean"}
+		}
+	}`
+	assert.NoError(t, ValidateWorkflowInput(*wfd, `{"district": "abc", "dryRun": true}`))
+
+	t.Log("All violations are listed")
+	err := ValidateWorkflowInput(*wfd, `{"district": 5}`)
+	require.IsType(t, models.BadRequest{}, err)
+	message := err.(models.BadRequest).Message
+	assert.Contains(t, message, "district")
+	assert.Contains(t, message, "dryRun")
+}
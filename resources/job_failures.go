@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// JobFailure is a structured failure cause. Activities report one by failing with a JSON object
+// as their cause, e.g.
+//
+//	{"errorMessage": "...", "exitCode": 1, "taskARN": "...", "logURL": "..."}
+//
+// The errorMessage, errorType and stackTrace fields match the failures of Lambda functions, so
+// those are parsed too.
+type JobFailure struct {
+	ErrorMessage         string          `json:"errorMessage"`
+	ErrorType            string          `json:"errorType"`
+	StackTrace           json.RawMessage `json:"stackTrace"`
+	ExitCode             *int64          `json:"exitCode"`
+	ContainerInstanceARN string          `json:"containerInstanceARN"`
+	TaskARN              string          `json:"taskARN"`
+	LogURL               string          `json:"logURL"`
+}
+
+// ParseJobFailure parses a structured failure cause. It returns false if the cause isn't a JSON
+// object with any of the fields of a JobFailure.
+func ParseJobFailure(cause string) (JobFailure, bool) {
+	var failure JobFailure
+	if !strings.HasPrefix(strings.TrimSpace(cause), "{") {
+		return failure, false
+	}
+	if err := json.Unmarshal([]byte(cause), &failure); err != nil {
+		return JobFailure{}, false
+	}
+	if failure.ErrorMessage == "" && failure.ErrorType == "" && failure.StackTrace == nil &&
+		failure.ExitCode == nil && failure.ContainerInstanceARN == "" && failure.TaskARN == "" && failure.LogURL == "" {
+		return JobFailure{}, false
+	}
+	return failure, true
+}
+
+// Message returns the error message of the failure, followed by its stack trace if that is a
+// list of lines.
+func (f JobFailure) Message() string {
+	var lines []string
+	if err := json.Unmarshal(f.StackTrace, &lines); err != nil || len(lines) == 0 {
+		return f.ErrorMessage
+	}
+	return strings.TrimSpace(f.ErrorMessage + "\n" + strings.Join(lines, "\n"))
+}
+
+// SetJobFailure records the failure cause and error name of a job. The details of a structured
+// cause are filled into the job, and only its message is kept as the cause.
+func SetJobFailure(job *models.Job, cause, errorName string) {
+	job.ErrorName = errorName
+	job.Cause = cause
+
+	failure, ok := ParseJobFailure(cause)
+	if !ok {
+		return
+	}
+	if job.ErrorName == "" {
+		job.ErrorName = failure.ErrorType
+	}
+	if message := failure.Message(); message != "" {
+		job.Cause = message
+	}
+	if failure.ExitCode != nil {
+		job.ExitCode = *failure.ExitCode
+	}
+	job.ContainerInstanceARN = failure.ContainerInstanceARN
+	job.TaskARN = failure.TaskARN
+	job.LogURL = failure.LogURL
+}
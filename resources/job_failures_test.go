@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetJobFailure(t *testing.T) {
+	t.Log("Structured causes fill in the details of the job")
+	job := &models.Job{}
+	SetJobFailure(job, `{
+		"errorMessage": "sync failed",
+		"stackTrace": ["  at sync (sync.js:1)", "  at main (main.js:2)"],
+		"exitCode": 137,
+		"containerInstanceARN": "arn:aws:ecs:us-east-1:123456789012:container-instance/abc",
+		"taskARN": "arn:aws:ecs:us-east-1:123456789012:task/def",
+		"logURL": "https://logs.example.com/def"
+	}`, "States.TaskFailed")
+	assert.Equal(t, &models.Job{
+		ErrorName:            "States.TaskFailed",
+		Cause:                "sync failed\n  at sync (sync.js:1)\n  at main (main.js:2)",
+		ExitCode:             137,
+		ContainerInstanceARN: "arn:aws:ecs:us-east-1:123456789012:container-instance/abc",
+		TaskARN:              "arn:aws:ecs:us-east-1:123456789012:task/def",
+		LogURL:               "https://logs.example.com/def",
+	}, job)
+
+	t.Log("Lambda failures provide the error name")
+	job = &models.Job{}
+	SetJobFailure(job, `{"errorMessage": "'district'", "errorType": "KeyError", "stackTrace": [["main.py", 1, "handler", "sync()"]]}`, "")
+	assert.Equal(t, "KeyError", job.ErrorName)
+	assert.Equal(t, "'district'", job.Cause)
+
+	t.Log("Other causes are kept as they are")
+	for _, cause := range []string{"line1\nline2", `{"unknown": "field"}`, `{"errorMessage": `} {
+		job = &models.Job{}
+		SetJobFailure(job, cause, "Error")
+		assert.Equal(t, &models.Job{ErrorName: "Error", Cause: cause}, job, cause)
+	}
+}
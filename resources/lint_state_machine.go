@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// LintStateMachine returns warnings for patterns that are valid States Language but are
+// likely mistakes: loops that can't exit, Tasks that can hang or fail on the first error,
+// catchers that hide failures, unreachable states and Choice states without a Default.
+func LintStateMachine(sm models.SLStateMachine) []StateMachineProblem {
+	warnings := []StateMachineProblem{}
+
+	reachable := reachableStates(sm)
+	for _, name := range sortedStateNames(sm) {
+		state := sm.States[name]
+		path := fmt.Sprintf("States.%s", name)
+
+		if !reachable[name] {
+			warnings = append(warnings, StateMachineProblem{
+				Path:    path,
+				Message: "is not reachable from StartAt, so the definition will be rejected",
+			})
+		}
+
+		switch state.Type {
+		case models.SLStateTypeTask:
+			if len(state.Retry) == 0 && state.TimeoutSeconds == 0 {
+				warnings = append(warnings, StateMachineProblem{
+					Path:    path,
+					Message: "Task has neither Retry nor TimeoutSeconds, so it fails on the first error and can wait forever",
+				})
+			}
+			for i, catcher := range state.Catch {
+				if catcher == nil || !catchesAll(catcher) {
+					continue
+				}
+				if next, ok := sm.States[catcher.Next]; ok && next.Type == models.SLStateTypeSucceed {
+					warnings = append(warnings, StateMachineProblem{
+						Path:    fmt.Sprintf("%s.Catch[%d]", path, i),
+						Message: fmt.Sprintf("catches %s into Succeed state %s, hiding every failure", errorStatesAll, catcher.Next),
+					})
+				}
+			}
+		case models.SLStateTypeChoice:
+			if state.Default == "" {
+				warnings = append(warnings, StateMachineProblem{
+					Path:    path + ".Default",
+					Message: fmt.Sprintf("is not set, so input that matches no choice rule fails with %s", errorStatesNoChoiceMatched),
+				})
+			}
+		}
+	}
+
+	for _, loop := range stateLoops(sm) {
+		if loopCanExit(sm, loop) {
+			continue
+		}
+		warnings = append(warnings, StateMachineProblem{
+			Path:    fmt.Sprintf("States.%s", loop[0]),
+			Message: fmt.Sprintf("loop through %s has no Wait state and no Choice that leaves it, so it never ends", strings.Join(loop, ", ")),
+		})
+	}
+	return warnings
+}
+
+// DefinitionProblems converts problems found in a state machine to their API model.
+func DefinitionProblems(problems []StateMachineProblem) []*models.DefinitionProblem {
+	res := []*models.DefinitionProblem{}
+	for _, problem := range problems {
+		res = append(res, &models.DefinitionProblem{
+			Path:    problem.Path,
+			Message: problem.Message,
+		})
+	}
+	return res
+}
+
+func sortedStateNames(sm models.SLStateMachine) []string {
+	names := []string{}
+	for name := range sm.States {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func catchesAll(catcher *models.SLCatcher) bool {
+	for _, errorName := range catcher.ErrorEquals {
+		if errorName == errorStatesAll {
+			return true
+		}
+	}
+	return false
+}
+
+// stateTransitions lists the states that a state can transition to, including through Catch.
+func stateTransitions(state models.SLState) []string {
+	transitions := []string{}
+	if state.Next != "" {
+		transitions = append(transitions, state.Next)
+	}
+	for _, choice := range state.Choices {
+		if choice != nil && choice.Next != "" {
+			transitions = append(transitions, choice.Next)
+		}
+	}
+	if state.Default != "" {
+		transitions = append(transitions, state.Default)
+	}
+	for _, catcher := range state.Catch {
+		if catcher != nil && catcher.Next != "" {
+			transitions = append(transitions, catcher.Next)
+		}
+	}
+	return transitions
+}
+
+// reachableStates returns the states reachable from StartAt.
+func reachableStates(sm models.SLStateMachine) map[string]bool {
+	reachable := map[string]bool{}
+	queue := []string{sm.StartAt}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		state, ok := sm.States[name]
+		if !ok || reachable[name] {
+			continue
+		}
+		reachable[name] = true
+		queue = append(queue, stateTransitions(state)...)
+	}
+	return reachable
+}
+
+// stateLoops returns the strongly connected components of the transition graph that contain
+// a cycle, each sorted by state name, using Tarjan's algorithm.
+func stateLoops(sm models.SLStateMachine) [][]string {
+	index := map[string]int{}
+	lowlink := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []string{}
+	loops := [][]string{}
+
+	var visit func(name string)
+	visit = func(name string) {
+		index[name] = len(index)
+		lowlink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+
+		selfLoop := false
+		for _, next := range stateTransitions(sm.States[name]) {
+			if _, ok := sm.States[next]; !ok {
+				continue
+			}
+			if next == name {
+				selfLoop = true
+			}
+			if _, visited := index[next]; !visited {
+				visit(next)
+				if lowlink[next] < lowlink[name] {
+					lowlink[name] = lowlink[next]
+				}
+			} else if onStack[next] && index[next] < lowlink[name] {
+				lowlink[name] = index[next]
+			}
+		}
+
+		if lowlink[name] != index[name] {
+			return
+		}
+		component := []string{}
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == name {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			loops = append(loops, component)
+		}
+	}
+
+	for _, name := range sortedStateNames(sm) {
+		if _, visited := index[name]; !visited {
+			visit(name)
+		}
+	}
+	sort.Slice(loops, func(i, j int) bool { return loops[i][0] < loops[j][0] })
+	return loops
+}
+
+// loopCanExit reports whether a loop contains a Wait state, or a Choice state with a
+// transition out of the loop.
+func loopCanExit(sm models.SLStateMachine, loop []string) bool {
+	inLoop := map[string]bool{}
+	for _, name := range loop {
+		inLoop[name] = true
+	}
+	for _, name := range loop {
+		state := sm.States[name]
+		switch state.Type {
+		case models.SLStateTypeWait:
+			return true
+		case models.SLStateTypeChoice:
+			for _, next := range stateTransitions(state) {
+				if !inLoop[next] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
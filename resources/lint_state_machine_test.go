@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestLintStateMachine(t *testing.T) {
+	t.Log("Well behaved state machines have no warnings")
+	assert.Empty(t, LintStateMachine(*KitchenSinkWorkflowDefinition(t).StateMachine))
+
+	sm := models.SLStateMachine{
+		StartAt: "poll",
+		States: map[string]models.SLState{
+			"poll": models.SLState{
+				Type:     models.SLStateTypeTask,
+				Resource: "poller",
+				Next:     "check",
+				Catch: []*models.SLCatcher{{
+					ErrorEquals: []models.SLErrorEquals{"States.ALL"},
+					Next:        "done",
+				}},
+			},
+			"check": models.SLState{
+				Type: models.SLStateTypeChoice,
+				Choices: []*models.SLChoice{{
+					Variable:      "$.ready",
+					BooleanEquals: swag.Bool(false),
+					Next:          "poll",
+				}},
+			},
+			"spin": models.SLState{Type: models.SLStateTypePass, Next: "spin"},
+			"done": models.SLState{Type: models.SLStateTypeSucceed},
+		},
+	}
+
+	warnings := LintStateMachine(sm)
+	assert.Equal(t, []string{
+		"States.check.Default",
+		"States.poll",
+		"States.poll.Catch[0]",
+		"States.spin",
+		"States.check",
+		"States.spin",
+	}, problemPaths(warnings))
+	assert.Contains(t, warnings[2].Message, "hiding every failure")
+	assert.Contains(t, warnings[3].Message, "not reachable")
+	assert.Contains(t, warnings[4].Message, "loop through check, poll")
+	assert.Contains(t, warnings[5].Message, "loop through spin")
+
+	t.Log("Loops with a Choice that leaves them or a Wait state are fine")
+	check := sm.States["check"]
+	check.Default = "done"
+	sm.States["check"] = check
+	sm.States["spin"] = models.SLState{Type: models.SLStateTypeWait, Seconds: 10, Next: "spin"}
+	warnings = LintStateMachine(sm)
+	require.Len(t, warnings, 3)
+	assert.Equal(t, []string{"States.poll", "States.poll.Catch[0]", "States.spin"}, problemPaths(warnings))
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// ValidateNamespace checks that a namespace has a name and a known status
+func ValidateNamespace(namespace models.Namespace) error {
+	if namespace.Name == "" {
+		return models.BadRequest{Message: "Namespace requires a name"}
+	}
+	switch namespace.Status {
+	case models.NamespaceStatusActive, models.NamespaceStatusFrozen:
+		return nil
+	default:
+		return models.BadRequest{Message: fmt.Sprintf("invalid namespace status: %s", namespace.Status)}
+	}
+}
+
+// CheckNamespaceActive returns an error if a namespace is frozen
+func CheckNamespaceActive(namespace models.Namespace) error {
+	if namespace.Status == models.NamespaceStatusFrozen {
+		return models.BadRequest{Message: fmt.Sprintf("namespace %s is frozen", namespace.Name)}
+	}
+	return nil
+}
+
+// CheckNamespaceAllowsWorkflows returns an error if workflows of a definition can't be
+// started in a namespace, because the namespace is frozen or doesn't allow the definition
+func CheckNamespaceAllowsWorkflows(namespace models.Namespace, definitionName string) error {
+	if err := CheckNamespaceActive(namespace); err != nil {
+		return err
+	}
+	if len(namespace.AllowedDefinitions) == 0 {
+		return nil
+	}
+	for _, allowed := range namespace.AllowedDefinitions {
+		if allowed == definitionName {
+			return nil
+		}
+	}
+	return models.BadRequest{
+		Message: fmt.Sprintf("namespace %s does not allow workflow definition %s", namespace.Name, definitionName),
+	}
+}
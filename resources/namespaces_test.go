@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNamespace(t *testing.T) {
+	assert.NoError(t, ValidateNamespace(models.Namespace{Name: "staging", Status: models.NamespaceStatusActive}))
+	assert.NoError(t, ValidateNamespace(models.Namespace{Name: "staging", Status: models.NamespaceStatusFrozen}))
+	assert.IsType(t, models.BadRequest{}, ValidateNamespace(models.Namespace{Status: models.NamespaceStatusActive}))
+	assert.IsType(t, models.BadRequest{}, ValidateNamespace(models.Namespace{Name: "staging", Status: "paused"}))
+}
+
+func TestCheckNamespaceAllowsWorkflows(t *testing.T) {
+	open := models.Namespace{Name: "staging", Status: models.NamespaceStatusActive}
+	assert.NoError(t, CheckNamespaceAllowsWorkflows(open, "sync"))
+
+	restricted := models.Namespace{
+		Name:               "production",
+		Status:             models.NamespaceStatusActive,
+		AllowedDefinitions: []string{"sync"},
+	}
+	assert.NoError(t, CheckNamespaceAllowsWorkflows(restricted, "sync"))
+	assert.IsType(t, models.BadRequest{}, CheckNamespaceAllowsWorkflows(restricted, "send-invoices"))
+
+	frozen := models.Namespace{Name: "staging", Status: models.NamespaceStatusFrozen}
+	assert.IsType(t, models.BadRequest{}, CheckNamespaceActive(frozen))
+	assert.IsType(t, models.BadRequest{}, CheckNamespaceAllowsWorkflows(frozen, "sync"))
+}
@@ -0,0 +1,317 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+const (
+	graphStartNode = "__start"
+	graphEndNode   = "__end"
+)
+
+// stateProgress summarizes the Jobs a workflow ran for one state.
+type stateProgress struct {
+	// status of the most recent Job for the state
+	status models.JobStatus
+	// attempts across every Job for the state, including retries
+	attempts int
+	// visits is the number of Jobs for the state, which is more than one in loops
+	visits int
+}
+
+// graphEdge is a transition between two states, labelled with what triggers it.
+type graphEdge struct {
+	from, to string
+	label    string
+	// catch is true for transitions taken when a Task fails
+	catch bool
+}
+
+// RenderStateMachine renders a state machine as a Graphviz DOT or Mermaid graph, with edges
+// for Next, each Choice rule, Default and Catch.
+func RenderStateMachine(sm models.SLStateMachine, format models.GraphFormat) (string, error) {
+	return renderGraph(sm, nil, format)
+}
+
+// RenderWorkflow renders the state machine of a workflow like RenderStateMachine, coloring
+// each state by the status of its latest Job and noting how many attempts it took.
+func RenderWorkflow(workflow models.Workflow, format models.GraphFormat) (string, error) {
+	if workflow.WorkflowDefinition == nil || workflow.WorkflowDefinition.StateMachine == nil {
+		return "", fmt.Errorf("workflow %s has no state machine", workflow.ID)
+	}
+	return renderGraph(*workflow.WorkflowDefinition.StateMachine, workflowProgress(workflow.Jobs), format)
+}
+
+// workflowProgress groups Jobs by state. Jobs are in the order the workflow ran them, so the
+// last Job for a state has its current status.
+func workflowProgress(jobs []*models.Job) map[string]stateProgress {
+	progress := map[string]stateProgress{}
+	for _, job := range jobs {
+		if job == nil || job.State == "" {
+			continue
+		}
+		p := progress[job.State]
+		p.status = job.Status
+		p.attempts += len(job.Attempts) + 1
+		p.visits++
+		progress[job.State] = p
+	}
+	return progress
+}
+
+func renderGraph(sm models.SLStateMachine, progress map[string]stateProgress, format models.GraphFormat) (string, error) {
+	switch format {
+	case models.GraphFormatDot:
+		return renderDOT(sm, progress), nil
+	case models.GraphFormatMermaid:
+		return renderMermaid(sm, progress), nil
+	default:
+		return "", models.BadRequest{Message: fmt.Sprintf("unknown graph format %q", format)}
+	}
+}
+
+func renderDOT(sm models.SLStateMachine, progress map[string]stateProgress) string {
+	var b strings.Builder
+	b.WriteString("digraph {\n")
+	fmt.Fprintf(&b, "  %s [shape=point];\n", dotQuote(graphStartNode))
+	fmt.Fprintf(&b, "  %s [shape=doublecircle label=\"\" width=0.2];\n", dotQuote(graphEndNode))
+
+	for _, name := range sortedStateNames(sm) {
+		state := sm.States[name]
+		attrs := []string{
+			"label=" + dotQuote(strings.Join(stateLabelLines(name, state, progress), "\n")),
+			"shape=" + dotShape(state.Type),
+		}
+		style := ""
+		if state.Type == models.SLStateTypeTask {
+			style = "rounded"
+		}
+		if p, ok := progress[name]; ok {
+			attrs = append(attrs, "fillcolor="+dotQuote(statusColors[statusClass(p.status)]))
+			style = strings.TrimPrefix(style+",filled", ",")
+		}
+		if style != "" {
+			attrs = append(attrs, "style="+dotQuote(style))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(name), strings.Join(attrs, " "))
+	}
+
+	for _, edge := range graphEdges(sm) {
+		attrs := []string{}
+		if edge.label != "" {
+			attrs = append(attrs, "label="+dotQuote(edge.label))
+		}
+		if edge.catch {
+			attrs = append(attrs, "style=dashed", "color=red")
+		}
+		if len(attrs) == 0 {
+			fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(edge.from), dotQuote(edge.to))
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(edge.from), dotQuote(edge.to), strings.Join(attrs, " "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func renderMermaid(sm models.SLStateMachine, progress map[string]stateProgress) string {
+	// Mermaid node IDs can't contain arbitrary characters, so states are numbered and their
+	// names only appear in labels.
+	ids := map[string]string{graphStartNode: "start", graphEndNode: "finish"}
+	names := sortedStateNames(sm)
+	for i, name := range names {
+		ids[name] = fmt.Sprintf("s%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	b.WriteString("  start((start))\n")
+	b.WriteString("  finish(((end)))\n")
+	for _, name := range names {
+		state := sm.States[name]
+		label := mermaidQuote(strings.Join(stateLabelLines(name, state, progress), "<br/>"))
+		switch state.Type {
+		case models.SLStateTypeChoice:
+			fmt.Fprintf(&b, "  %s{%s}\n", ids[name], label)
+		case models.SLStateTypeTask:
+			fmt.Fprintf(&b, "  %s[%s]\n", ids[name], label)
+		default:
+			fmt.Fprintf(&b, "  %s(%s)\n", ids[name], label)
+		}
+	}
+
+	for _, edge := range graphEdges(sm) {
+		arrow := "-->"
+		if edge.catch {
+			arrow = "-.->"
+		}
+		if edge.label == "" {
+			fmt.Fprintf(&b, "  %s %s %s\n", ids[edge.from], arrow, ids[edge.to])
+			continue
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", ids[edge.from], arrow, mermaidQuote(edge.label), ids[edge.to])
+	}
+
+	if len(progress) > 0 {
+		for _, class := range statusClasses {
+			fmt.Fprintf(&b, "  classDef %s fill:%s\n", class, statusColors[class])
+		}
+		for _, name := range names {
+			if p, ok := progress[name]; ok {
+				fmt.Fprintf(&b, "  class %s %s\n", ids[name], statusClass(p.status))
+			}
+		}
+	}
+	return b.String()
+}
+
+// graphEdges lists the transitions of a state machine, in state name order. Transitions to
+// states that don't exist are skipped, and states that end the workflow point at the end node.
+func graphEdges(sm models.SLStateMachine) []graphEdge {
+	edges := []graphEdge{}
+	if _, ok := sm.States[sm.StartAt]; ok {
+		edges = append(edges, graphEdge{from: graphStartNode, to: sm.StartAt})
+	}
+	for _, name := range sortedStateNames(sm) {
+		state := sm.States[name]
+		stateEdges := []graphEdge{}
+		if state.Next != "" {
+			stateEdges = append(stateEdges, graphEdge{from: name, to: state.Next})
+		}
+		for _, choice := range state.Choices {
+			if choice != nil {
+				stateEdges = append(stateEdges, graphEdge{from: name, to: choice.Next, label: describeChoiceRule(choice)})
+			}
+		}
+		if state.Default != "" {
+			stateEdges = append(stateEdges, graphEdge{from: name, to: state.Default, label: "Default"})
+		}
+		for _, catcher := range state.Catch {
+			if catcher == nil {
+				continue
+			}
+			errorNames := []string{}
+			for _, errorName := range catcher.ErrorEquals {
+				errorNames = append(errorNames, string(errorName))
+			}
+			stateEdges = append(stateEdges, graphEdge{
+				from:  name,
+				to:    catcher.Next,
+				label: strings.Join(errorNames, ", "),
+				catch: true,
+			})
+		}
+		for _, edge := range stateEdges {
+			if _, ok := sm.States[edge.to]; ok {
+				edges = append(edges, edge)
+			}
+		}
+
+		if state.End || state.Type == models.SLStateTypeSucceed || state.Type == models.SLStateTypeFail {
+			edges = append(edges, graphEdge{from: name, to: graphEndNode})
+		}
+	}
+	return edges
+}
+
+// describeChoiceRule summarizes a Choice rule for an edge label, e.g. `$.count NumericGreaterThan 10`.
+func describeChoiceRule(rule *models.SLChoice) string {
+	switch {
+	case len(rule.And) > 0:
+		return joinChoiceRules(rule.And, " and ")
+	case len(rule.Or) > 0:
+		return joinChoiceRules(rule.Or, " or ")
+	case rule.Not != nil:
+		return fmt.Sprintf("not (%s)", describeChoiceRule(rule.Not))
+	}
+
+	operators := choiceRuleOperators(rule)
+	if len(operators) == 0 {
+		return rule.Variable
+	}
+	fields, _ := jsonValue(rule).(map[string]interface{})
+	return fmt.Sprintf("%s %s %s", rule.Variable, operators[0], marshalJSONValue(fields[operators[0]]))
+}
+
+func joinChoiceRules(rules []*models.SLChoice, sep string) string {
+	descriptions := []string{}
+	for _, rule := range rules {
+		if rule != nil {
+			descriptions = append(descriptions, describeChoiceRule(rule))
+		}
+	}
+	return "(" + strings.Join(descriptions, sep) + ")"
+}
+
+// stateLabelLines describes a state: its name, its resource if it's a Task and, for workflows,
+// the status of its latest Job along with its attempts and visits when there were several.
+func stateLabelLines(name string, state models.SLState, progress map[string]stateProgress) []string {
+	lines := []string{name}
+	if state.Type == models.SLStateTypeTask && state.Resource != "" {
+		lines = append(lines, state.Resource)
+	}
+	p, ok := progress[name]
+	if !ok {
+		return lines
+	}
+	lines = append(lines, string(p.status))
+	if p.attempts > 1 {
+		lines = append(lines, fmt.Sprintf("attempts: %d", p.attempts))
+	}
+	if p.visits > 1 {
+		lines = append(lines, fmt.Sprintf("visits: %d", p.visits))
+	}
+	return lines
+}
+
+var statusClasses = []string{"succeeded", "failed", "running", "aborted"}
+
+var statusColors = map[string]string{
+	"succeeded": "#c6efce",
+	"failed":    "#ffc7ce",
+	"running":   "#bdd7ee",
+	"aborted":   "#d9d9d9",
+}
+
+// statusClass groups Job statuses into the classes used to color states.
+func statusClass(status models.JobStatus) string {
+	switch status {
+	case models.JobStatusSucceeded:
+		return "succeeded"
+	case models.JobStatusFailed:
+		return "failed"
+	case models.JobStatusAbortedDepsFailed, models.JobStatusAbortedByUser:
+		return "aborted"
+	default:
+		return "running"
+	}
+}
+
+func dotShape(stateType models.SLStateType) string {
+	switch stateType {
+	case models.SLStateTypeTask:
+		return "box"
+	case models.SLStateTypeChoice:
+		return "diamond"
+	default:
+		return "ellipse"
+	}
+}
+
+// dotQuote quotes a DOT ID, escaping newlines so that labels span several lines.
+func dotQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	return `"` + s + `"`
+}
+
+// mermaidQuote quotes Mermaid label text, using entity codes for characters that end labels.
+func mermaidQuote(s string) string {
+	s = strings.Replace(s, `"`, "#quot;", -1)
+	s = strings.Replace(s, "|", "#124;", -1)
+	return `"` + s + `"`
+}
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestRenderStateMachineDOT(t *testing.T) {
+	graph, err := RenderStateMachine(simulationStateMachine(), models.GraphFormatDot)
+	require.NoError(t, err)
+	assert.Equal(t, `digraph {
+  "__start" [shape=point];
+  "__end" [shape=doublecircle label="" width=0.2];
+  "done" [label="done" shape=ellipse];
+  "fetch" [label="fetch\nfetcher" shape=box style="rounded"];
+  "process" [label="process" shape=ellipse];
+  "report" [label="report" shape=ellipse];
+  "route" [label="route" shape=diamond];
+  "__start" -> "fetch";
+  "done" -> "__end";
+  "fetch" -> "route";
+  "fetch" -> "report" [label="States.ALL" style=dashed color=red];
+  "process" -> "done";
+  "report" -> "__end";
+  "route" -> "process" [label="$.fetched.count NumericGreaterThan 0"];
+  "route" -> "done" [label="Default"];
+}
+`, graph)
+
+	_, err = RenderStateMachine(simulationStateMachine(), models.GraphFormat("svg"))
+	assert.IsType(t, models.BadRequest{}, err)
+}
+
+func TestRenderWorkflowMermaid(t *testing.T) {
+	sm := simulationStateMachine()
+	workflow := models.Workflow{
+		ID:                 "workflow-id",
+		WorkflowDefinition: &models.WorkflowDefinition{StateMachine: &sm},
+		Jobs: []*models.Job{
+			{State: "fetch", Status: models.JobStatusFailed, Attempts: []*models.JobAttempt{{}}},
+			{State: "fetch", Status: models.JobStatusSucceeded},
+			{State: "route", Status: models.JobStatusSucceeded},
+			{State: "process", Status: models.JobStatusRunning},
+		},
+	}
+
+	graph, err := RenderWorkflow(workflow, models.GraphFormatMermaid)
+	require.NoError(t, err)
+	for _, line := range []string{
+		`  s1["fetch<br/>fetcher<br/>succeeded<br/>attempts: 3<br/>visits: 2"]`,
+		`  s2("process<br/>running")`,
+		`  s3("report")`,
+		`  s1 -.->|"States.ALL"| s3`,
+		`  s4 -->|"$.fetched.count NumericGreaterThan 0"| s2`,
+		`  class s1 succeeded`,
+		`  class s2 running`,
+		`  class s4 succeeded`,
+	} {
+		assert.Contains(t, graph, line+"\n")
+	}
+	assert.NotContains(t, graph, "class s3")
+
+	_, err = RenderWorkflow(models.Workflow{ID: "workflow-id"}, models.GraphFormatDot)
+	assert.Error(t, err)
+}
+
+func TestDescribeChoiceRule(t *testing.T) {
+	assert.Equal(t, `($.a StringEquals "a\"b" and not ($.b BooleanEquals true))`, describeChoiceRule(&models.SLChoice{
+		And: []*models.SLChoice{
+			{Variable: "$.a", StringEquals: swag.String(`a"b`)},
+			{Not: &models.SLChoice{Variable: "$.b", BooleanEquals: swag.Bool(true)}},
+		},
+	}))
+	assert.Equal(t, `"a\"b"`, dotQuote(`a"b`))
+	assert.Equal(t, `"a#quot;b #124; c"`, mermaidQuote(`a"b | c`))
+}
@@ -0,0 +1,208 @@
+package resources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+)
+
+var defaultRolloutSteps = []int64{5, 25, 50, 100}
+
+const (
+	defaultRolloutStepIntervalSeconds    = 600
+	defaultRolloutMinWorkflows           = 10
+	defaultRolloutMaxStepSeconds         = 86400
+	defaultRolloutMaxFailureRateIncrease = 0.05
+)
+
+// StartRollout starts moving an alias from its version to req.ToVersion, sending the share of
+// workflows in the first step to the new version as a canary.
+func StartRollout(alias *models.WorkflowDefinitionAlias, req models.RolloutRequest, now time.Time) error {
+	if alias.Rollout != nil && alias.Rollout.Status == models.RolloutStatusRunning {
+		return models.Conflict{Message: fmt.Sprintf("alias %s already has a rollout in progress", alias.Alias)}
+	}
+	if req.ToVersion == nil {
+		return models.BadRequest{Message: "toVersion is required"}
+	}
+	if *req.ToVersion == alias.Version {
+		return models.BadRequest{Message: fmt.Sprintf("alias %s already points at version %d", alias.Alias, alias.Version)}
+	}
+
+	steps := req.Steps
+	if len(steps) == 0 {
+		steps = append([]int64{}, defaultRolloutSteps...)
+	}
+	for i, step := range steps {
+		if step <= 0 || step > 100 || (i > 0 && step <= steps[i-1]) {
+			return models.BadRequest{Message: fmt.Sprintf("steps must increase from 1 to 100: %v", steps)}
+		}
+	}
+	if steps[len(steps)-1] != 100 {
+		return models.BadRequest{Message: fmt.Sprintf("the last step must be 100: %v", steps)}
+	}
+	if req.StepIntervalSeconds < 0 || req.MinWorkflows < 0 || req.MaxStepSeconds < 0 ||
+		req.MaxFailureRateIncrease < 0 || req.MaxFailureRateIncrease > 1 {
+		return models.BadRequest{Message: "stepIntervalSeconds, minWorkflows, maxStepSeconds and maxFailureRateIncrease can't be negative, and maxFailureRateIncrease is at most 1"}
+	}
+
+	rollout := &models.Rollout{
+		FromVersion:            alias.Version,
+		ToVersion:              *req.ToVersion,
+		Steps:                  steps,
+		StepIntervalSeconds:    req.StepIntervalSeconds,
+		MinWorkflows:           req.MinWorkflows,
+		MaxStepSeconds:         req.MaxStepSeconds,
+		MaxFailureRateIncrease: req.MaxFailureRateIncrease,
+		Status:                 models.RolloutStatusRunning,
+		StartedAt:              strfmt.DateTime(now),
+		StepStartedAt:          strfmt.DateTime(now),
+		Decisions:              []*models.RolloutDecision{},
+	}
+	if rollout.StepIntervalSeconds == 0 {
+		rollout.StepIntervalSeconds = defaultRolloutStepIntervalSeconds
+	}
+	if rollout.MinWorkflows == 0 {
+		rollout.MinWorkflows = defaultRolloutMinWorkflows
+	}
+	if rollout.MaxStepSeconds == 0 {
+		rollout.MaxStepSeconds = defaultRolloutMaxStepSeconds
+		if rollout.StepIntervalSeconds > rollout.MaxStepSeconds {
+			rollout.MaxStepSeconds = rollout.StepIntervalSeconds
+		}
+	}
+	if rollout.MaxStepSeconds < rollout.StepIntervalSeconds {
+		return models.BadRequest{Message: "maxStepSeconds must be at least stepIntervalSeconds"}
+	}
+	if rollout.MaxFailureRateIncrease == 0 {
+		rollout.MaxFailureRateIncrease = defaultRolloutMaxFailureRateIncrease
+	}
+	alias.Rollout = rollout
+	setRolloutCanaryWeight(alias, steps[0])
+	addRolloutDecision(alias, models.RolloutActionStart, "rollout started", nil, nil, now)
+	return nil
+}
+
+// CancelRollout stops the rollout of an alias and points the alias back at the old version.
+func CancelRollout(alias *models.WorkflowDefinitionAlias, now time.Time) error {
+	if alias.Rollout == nil || alias.Rollout.Status != models.RolloutStatusRunning {
+		return models.Conflict{Message: fmt.Sprintf("alias %s has no rollout in progress", alias.Alias)}
+	}
+	finishRollout(alias, alias.Rollout.FromVersion, models.RolloutStatusCancelled, now)
+	addRolloutDecision(alias, models.RolloutActionCancel, "rollout cancelled", nil, nil, now)
+	return nil
+}
+
+// EvaluateRollout judges the running rollout of an alias once the new version has finished enough
+// workflows. The alias rolls back to the old version if the failure rate of the new version is
+// too far above the old one's, and otherwise moves to the next step once it has spent the step
+// interval at the current one. Steps that don't finish enough workflows within the max step time
+// also roll back, since the new version can't be judged. It returns whether the alias changed.
+func EvaluateRollout(alias *models.WorkflowDefinitionAlias, from, to models.VersionStats, now time.Time) bool {
+	rollout := alias.Rollout
+	if rollout == nil || rollout.Status != models.RolloutStatusRunning {
+		return false
+	}
+	if finished := to.Succeeded + to.Failed; finished < rollout.MinWorkflows {
+		maxStepSeconds := rollout.MaxStepSeconds
+		if maxStepSeconds == 0 {
+			maxStepSeconds = defaultRolloutMaxStepSeconds
+		}
+		maxStep := time.Duration(maxStepSeconds) * time.Second
+		if now.Sub(time.Time(rollout.StepStartedAt)) < maxStep {
+			return false
+		}
+		finishRollout(alias, rollout.FromVersion, models.RolloutStatusRolledBack, now)
+		addRolloutDecision(alias, models.RolloutActionRollback, fmt.Sprintf(
+			"version %d finished %d workflows in %s, fewer than the %d needed to judge it",
+			to.Version, finished, maxStep, rollout.MinWorkflows,
+		), &from, &to, now)
+		return true
+	}
+
+	if to.FailureRate-from.FailureRate > rollout.MaxFailureRateIncrease {
+		finishRollout(alias, rollout.FromVersion, models.RolloutStatusRolledBack, now)
+		addRolloutDecision(alias, models.RolloutActionRollback, fmt.Sprintf(
+			"failure rate of version %d is %.1f%%, more than %.1f points above the %.1f%% of version %d",
+			to.Version, to.FailureRate*100, rollout.MaxFailureRateIncrease*100, from.FailureRate*100, from.Version,
+		), &from, &to, now)
+		return true
+	}
+
+	stepInterval := time.Duration(rollout.StepIntervalSeconds) * time.Second
+	if now.Sub(time.Time(rollout.StepStartedAt)) < stepInterval {
+		return false
+	}
+	if int(rollout.CurrentStep)+1 >= len(rollout.Steps) {
+		finishRollout(alias, rollout.ToVersion, models.RolloutStatusSucceeded, now)
+		addRolloutDecision(alias, models.RolloutActionComplete, fmt.Sprintf(
+			"alias points at version %d", rollout.ToVersion,
+		), &from, &to, now)
+		return true
+	}
+
+	rollout.CurrentStep++
+	rollout.StepStartedAt = strfmt.DateTime(now)
+	weight := rollout.Steps[rollout.CurrentStep]
+	setRolloutCanaryWeight(alias, weight)
+	addRolloutDecision(alias, models.RolloutActionAdvance, fmt.Sprintf(
+		"failure rate of version %d is %.1f%%, within %.1f points of the %.1f%% of version %d",
+		to.Version, to.FailureRate*100, rollout.MaxFailureRateIncrease*100, from.FailureRate*100, from.Version,
+	), &from, &to, now)
+	return true
+}
+
+// NewVersionStatsFromCounts summarizes the finished workflows that an alias started with a version
+// in workflow stats counts.
+func NewVersionStatsFromCounts(alias string, version int64, counts WorkflowStatsCounts) models.VersionStats {
+	return NewVersionStats(
+		version,
+		counts.AliasVersionStatuses[AliasVersionStatusKey(alias, version, models.WorkflowStatusSucceeded)],
+		counts.AliasVersionStatuses[AliasVersionStatusKey(alias, version, models.WorkflowStatusFailed)],
+	)
+}
+
+// NewVersionStats summarizes the finished workflows of a version.
+func NewVersionStats(version, succeeded, failed int64) models.VersionStats {
+	stats := models.VersionStats{
+		Version:   version,
+		Succeeded: succeeded,
+		Failed:    failed,
+	}
+	if succeeded+failed > 0 {
+		stats.FailureRate = float64(failed) / float64(succeeded+failed)
+	}
+	return stats
+}
+
+func setRolloutCanaryWeight(alias *models.WorkflowDefinitionAlias, weight int64) {
+	toVersion := alias.Rollout.ToVersion
+	alias.CanaryVersion = &toVersion
+	alias.CanaryWeight = &weight
+}
+
+func finishRollout(alias *models.WorkflowDefinitionAlias, version int64, status models.RolloutStatus, now time.Time) {
+	finishedAt := strfmt.DateTime(now)
+	alias.Version = version
+	alias.CanaryVersion = nil
+	alias.CanaryWeight = nil
+	alias.Rollout.Status = status
+	alias.Rollout.FinishedAt = &finishedAt
+}
+
+func addRolloutDecision(alias *models.WorkflowDefinitionAlias, action models.RolloutAction, reason string,
+	from, to *models.VersionStats, now time.Time) {
+	weight := int64(0)
+	if alias.CanaryWeight != nil {
+		weight = *alias.CanaryWeight
+	}
+	alias.Rollout.Decisions = append(alias.Rollout.Decisions, &models.RolloutDecision{
+		At:           strfmt.DateTime(now),
+		Action:       action,
+		CanaryWeight: weight,
+		Reason:       reason,
+		FromStats:    from,
+		ToStats:      to,
+	})
+}
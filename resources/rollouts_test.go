@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestRollout(t *testing.T) {
+	start := time.Now()
+	alias := models.WorkflowDefinitionAlias{Name: "definition", Alias: "stable", Version: 1}
+	require.NoError(t, StartRollout(&alias, models.RolloutRequest{
+		ToVersion: swag.Int64(2),
+		Steps:     []int64{10, 100},
+	}, start))
+	assert.Equal(t, int64(2), *alias.CanaryVersion)
+	assert.Equal(t, int64(10), *alias.CanaryWeight)
+	assert.Equal(t, int64(defaultRolloutMinWorkflows), alias.Rollout.MinWorkflows)
+	assert.Equal(t, models.RolloutActionStart, alias.Rollout.Decisions[0].Action)
+
+	err := StartRollout(&alias, models.RolloutRequest{ToVersion: swag.Int64(3)}, start)
+	assert.IsType(t, models.Conflict{}, err)
+
+	t.Log("Steps wait for enough workflows and the step interval")
+	from := NewVersionStats(1, 95, 5)
+	assert.False(t, EvaluateRollout(&alias, from, NewVersionStats(2, 5, 0), start.Add(time.Hour)))
+	assert.False(t, EvaluateRollout(&alias, from, NewVersionStats(2, 10, 0), start.Add(time.Minute)))
+
+	assert.True(t, EvaluateRollout(&alias, from, NewVersionStats(2, 10, 0), start.Add(time.Hour)))
+	assert.Equal(t, int64(1), alias.Rollout.CurrentStep)
+	assert.Equal(t, int64(100), *alias.CanaryWeight)
+	assert.Equal(t, models.RolloutActionAdvance, alias.Rollout.Decisions[1].Action)
+
+	assert.True(t, EvaluateRollout(&alias, from, NewVersionStats(2, 19, 1), start.Add(2*time.Hour)))
+	assert.Equal(t, models.RolloutStatusSucceeded, alias.Rollout.Status)
+	assert.Equal(t, int64(2), alias.Version)
+	assert.Nil(t, alias.CanaryVersion)
+	assert.Equal(t, models.RolloutActionComplete, alias.Rollout.Decisions[2].Action)
+	assert.False(t, EvaluateRollout(&alias, from, NewVersionStats(2, 19, 1), start.Add(3*time.Hour)))
+}
+
+func TestRolloutRollback(t *testing.T) {
+	start := time.Now()
+	alias := models.WorkflowDefinitionAlias{Name: "definition", Alias: "stable", Version: 1}
+	require.NoError(t, StartRollout(&alias, models.RolloutRequest{
+		ToVersion:              swag.Int64(2),
+		MaxFailureRateIncrease: 0.1,
+	}, start))
+
+	assert.False(t, EvaluateRollout(&alias, NewVersionStats(1, 90, 10), NewVersionStats(2, 8, 2), start.Add(time.Minute)))
+	assert.True(t, EvaluateRollout(&alias, NewVersionStats(1, 90, 10), NewVersionStats(2, 7, 3), start.Add(time.Minute)))
+	assert.Equal(t, models.RolloutStatusRolledBack, alias.Rollout.Status)
+	assert.Equal(t, int64(1), alias.Version)
+	assert.Nil(t, alias.CanaryVersion)
+	decision := alias.Rollout.Decisions[1]
+	assert.Equal(t, models.RolloutActionRollback, decision.Action)
+	assert.Equal(t, 0.3, decision.ToStats.FailureRate)
+	assert.NotNil(t, alias.Rollout.FinishedAt)
+}
+
+func TestRolloutTimeout(t *testing.T) {
+	start := time.Now()
+	alias := models.WorkflowDefinitionAlias{Name: "definition", Alias: "stable", Version: 1}
+	require.NoError(t, StartRollout(&alias, models.RolloutRequest{ToVersion: swag.Int64(2)}, start))
+	assert.Equal(t, int64(defaultRolloutMaxStepSeconds), alias.Rollout.MaxStepSeconds)
+
+	t.Log("Steps without enough workflows roll back after the max step time")
+	from := NewVersionStats(1, 95, 5)
+	assert.False(t, EvaluateRollout(&alias, from, NewVersionStats(2, 3, 0), start.Add(23*time.Hour)))
+	assert.True(t, EvaluateRollout(&alias, from, NewVersionStats(2, 3, 0), start.Add(24*time.Hour)))
+	assert.Equal(t, models.RolloutStatusRolledBack, alias.Rollout.Status)
+	assert.Equal(t, int64(1), alias.Version)
+	assert.Equal(t, models.RolloutActionRollback, alias.Rollout.Decisions[1].Action)
+}
+
+func TestCancelRollout(t *testing.T) {
+	alias := models.WorkflowDefinitionAlias{Name: "definition", Alias: "stable", Version: 1}
+	assert.IsType(t, models.Conflict{}, CancelRollout(&alias, time.Now()))
+
+	require.NoError(t, StartRollout(&alias, models.RolloutRequest{ToVersion: swag.Int64(2)}, time.Now()))
+	require.NoError(t, CancelRollout(&alias, time.Now()))
+	assert.Equal(t, models.RolloutStatusCancelled, alias.Rollout.Status)
+	assert.Equal(t, int64(1), alias.Version)
+	assert.Nil(t, alias.CanaryWeight)
+}
+
+func TestStartRolloutValidation(t *testing.T) {
+	for _, req := range []models.RolloutRequest{
+		{},
+		{ToVersion: swag.Int64(1)},
+		{ToVersion: swag.Int64(2), Steps: []int64{50, 25, 100}},
+		{ToVersion: swag.Int64(2), Steps: []int64{5, 50}},
+		{ToVersion: swag.Int64(2), MaxFailureRateIncrease: 2},
+		{ToVersion: swag.Int64(2), StepIntervalSeconds: 3600, MaxStepSeconds: 600},
+	} {
+		alias := models.WorkflowDefinitionAlias{Name: "definition", Alias: "stable", Version: 1}
+		assert.IsType(t, models.BadRequest{}, StartRollout(&alias, req, time.Now()))
+	}
+}
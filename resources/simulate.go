@@ -0,0 +1,357 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// maxSimulationSteps bounds the simulation of state machines that loop forever.
+const maxSimulationSteps = 1000
+
+// Errors defined by the States Language: https://states-language.net/spec.html#appendix-a
+const (
+	errorStatesAll             = "States.ALL"
+	errorStatesTaskFailed      = "States.TaskFailed"
+	errorStatesTimeout         = "States.Timeout"
+	errorStatesRuntime         = "States.Runtime"
+	errorStatesNoChoiceMatched = "States.NoChoiceMatched"
+)
+
+// simulationFailure is an error that fails the simulated execution, as opposed to an error
+// in the state machine or the simulation request.
+type simulationFailure struct {
+	errorName string
+	cause     string
+}
+
+func (f simulationFailure) Error() string {
+	return fmt.Sprintf("%s: %s", f.errorName, f.cause)
+}
+
+func runtimeFailure(err error) simulationFailure {
+	return simulationFailure{errorName: errorStatesRuntime, cause: err.Error()}
+}
+
+type stateMachineSimulation struct {
+	taskResults map[string][]*models.SimulatedTaskResult
+	result      *models.SimulationResult
+}
+
+// SimulateStateMachine walks a state machine from StartAt with the given input and returns
+// the states it passes through. Task states use the canned taskResults for their state in
+// order, and output their input once those run out. Failures of the simulated execution are
+// reported in the result; errors are only returned for invalid requests or state machines.
+func SimulateStateMachine(sm models.SLStateMachine, input string, taskResults []*models.SimulatedTaskResult) (*models.SimulationResult, error) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(input), &data); err != nil {
+		return nil, models.BadRequest{Message: fmt.Sprintf("input must be valid JSON: %s", err)}
+	}
+
+	s := stateMachineSimulation{
+		taskResults: map[string][]*models.SimulatedTaskResult{},
+		result: &models.SimulationResult{
+			Input: input,
+			Steps: []*models.SimulationStep{},
+		},
+	}
+	for _, taskResult := range taskResults {
+		if taskResult == nil || taskResult.State == nil {
+			continue
+		}
+		s.taskResults[*taskResult.State] = append(s.taskResults[*taskResult.State], taskResult)
+	}
+
+	stateName := sm.StartAt
+	for {
+		if len(s.result.Steps) >= maxSimulationSteps {
+			return s.fail(simulationFailure{
+				errorName: errorStatesRuntime,
+				cause:     fmt.Sprintf("execution did not finish within %d steps", maxSimulationSteps),
+			}), nil
+		}
+		state, ok := sm.States[stateName]
+		if !ok {
+			return nil, models.BadRequest{Message: fmt.Sprintf("state %s does not exist", stateName)}
+		}
+
+		output, next, err := s.runState(stateName, state, data)
+		if failure, ok := err.(simulationFailure); ok {
+			return s.fail(failure), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		data = output
+		if next == "" {
+			s.result.Status = models.SimulationStatusSucceeded
+			s.result.Output = marshalJSONValue(data)
+			return s.result, nil
+		}
+		stateName = next
+	}
+}
+
+func (s *stateMachineSimulation) fail(failure simulationFailure) *models.SimulationResult {
+	s.result.Status = models.SimulationStatusFailed
+	s.result.Error = failure.errorName
+	s.result.Cause = failure.cause
+	return s.result
+}
+
+// runState simulates a single state, recording its steps. It returns the output of the state
+// and the name of the next state, or "" if the execution ended.
+func (s *stateMachineSimulation) runState(name string, state models.SLState, input interface{}) (interface{}, string, error) {
+	if state.Type == models.SLStateTypeTask {
+		return s.runTask(name, state, input)
+	}
+
+	step := &models.SimulationStep{
+		State: name,
+		Type:  state.Type,
+		Input: marshalJSONValue(input),
+	}
+	s.result.Steps = append(s.result.Steps, step)
+
+	output, next, err := simulateNonTaskState(name, state, input)
+	if failure, ok := err.(simulationFailure); ok {
+		step.Error = failure.errorName
+		step.Cause = failure.cause
+		return nil, "", err
+	} else if err != nil {
+		return nil, "", err
+	}
+	step.Output = marshalJSONValue(output)
+	step.Next = next
+	return output, next, nil
+}
+
+func simulateNonTaskState(name string, state models.SLState, input interface{}) (interface{}, string, error) {
+	switch state.Type {
+	case models.SLStateTypePass:
+		effectiveInput, err := getPath(input, state.InputPath)
+		if err != nil {
+			return nil, "", runtimeFailure(err)
+		}
+		result := effectiveInput
+		if state.Result != "" {
+			if err := json.Unmarshal([]byte(state.Result), &result); err != nil {
+				result = state.Result
+			}
+		}
+		output, err := applyResult(input, state.ResultPath, result, state.OutputPath)
+		if err != nil {
+			return nil, "", runtimeFailure(err)
+		}
+		next, err := nextState(name, state)
+		return output, next, err
+
+	case models.SLStateTypeWait, models.SLStateTypeSucceed:
+		effectiveInput, err := getPath(input, state.InputPath)
+		if err != nil {
+			return nil, "", runtimeFailure(err)
+		}
+		output, err := getPath(effectiveInput, state.OutputPath)
+		if err != nil {
+			return nil, "", runtimeFailure(err)
+		}
+		if state.Type == models.SLStateTypeSucceed {
+			return output, "", nil
+		}
+		next, err := nextState(name, state)
+		return output, next, err
+
+	case models.SLStateTypeChoice:
+		effectiveInput, err := getPath(input, state.InputPath)
+		if err != nil {
+			return nil, "", runtimeFailure(err)
+		}
+		next := state.Default
+		for _, choice := range state.Choices {
+			matched, err := EvaluateChoiceRule(choice, effectiveInput)
+			if err != nil {
+				return nil, "", runtimeFailure(err)
+			}
+			if matched {
+				next = choice.Next
+				break
+			}
+		}
+		if next == "" {
+			return nil, "", simulationFailure{
+				errorName: errorStatesNoChoiceMatched,
+				cause:     fmt.Sprintf("no choice rule of state %s matched and it has no Default", name),
+			}
+		}
+		output, err := getPath(effectiveInput, state.OutputPath)
+		if err != nil {
+			return nil, "", runtimeFailure(err)
+		}
+		return output, next, nil
+
+	case models.SLStateTypeFail:
+		return nil, "", simulationFailure{errorName: state.Error, cause: state.Cause}
+	}
+
+	return nil, "", models.BadRequest{
+		Message: fmt.Sprintf("state %s has type %s, which can't be simulated", name, state.Type),
+	}
+}
+
+// runTask simulates every attempt of a Task state, applying its Retry and Catch fields to
+// canned results that have an error.
+func (s *stateMachineSimulation) runTask(name string, state models.SLState, input interface{}) (interface{}, string, error) {
+	retries := make([]int64, len(state.Retry))
+	for attempt := int64(1); ; attempt++ {
+		step := &models.SimulationStep{
+			State:   name,
+			Type:    state.Type,
+			Attempt: attempt,
+			Input:   marshalJSONValue(input),
+		}
+		s.result.Steps = append(s.result.Steps, step)
+
+		effectiveInput, err := getPath(input, state.InputPath)
+		if err != nil {
+			failure := runtimeFailure(err)
+			step.Error, step.Cause = failure.errorName, failure.cause
+			return nil, "", failure
+		}
+
+		taskResult := s.nextTaskResult(name)
+		if taskResult == nil || taskResult.Error == "" {
+			taskOutput := effectiveInput
+			if taskResult != nil && taskResult.Output != "" {
+				if err := json.Unmarshal([]byte(taskResult.Output), &taskOutput); err != nil {
+					return nil, "", models.BadRequest{
+						Message: fmt.Sprintf("task result for state %s must be valid JSON: %s", name, err),
+					}
+				}
+			}
+			output, err := applyResult(input, state.ResultPath, taskOutput, state.OutputPath)
+			if err != nil {
+				failure := runtimeFailure(err)
+				step.Error, step.Cause = failure.errorName, failure.cause
+				return nil, "", failure
+			}
+			next, err := nextState(name, state)
+			step.Output = marshalJSONValue(output)
+			step.Next = next
+			return output, next, err
+		}
+
+		step.Error, step.Cause = taskResult.Error, taskResult.Cause
+
+		// only the first retrier that matches the error applies
+		for i, retrier := range state.Retry {
+			if !errorMatches(retrier.ErrorEquals, taskResult.Error) {
+				continue
+			}
+			if retries[i] < retrierMaxAttempts(retrier) {
+				step.RetryIntervalSeconds = retrierInterval(retrier, retries[i])
+				step.Next = name
+				retries[i]++
+			}
+			break
+		}
+		if step.Next == name {
+			continue
+		}
+
+		for _, catcher := range state.Catch {
+			if !errorMatches(catcher.ErrorEquals, taskResult.Error) {
+				continue
+			}
+			output, err := setPath(input, catcher.ResultPath, map[string]interface{}{
+				"Error": taskResult.Error,
+				"Cause": taskResult.Cause,
+			})
+			if err != nil {
+				return nil, "", runtimeFailure(err)
+			}
+			step.Output = marshalJSONValue(output)
+			step.Next = catcher.Next
+			return output, catcher.Next, nil
+		}
+
+		return nil, "", simulationFailure{errorName: taskResult.Error, cause: taskResult.Cause}
+	}
+}
+
+// nextTaskResult pops the next canned result for a Task state.
+func (s *stateMachineSimulation) nextTaskResult(name string) *models.SimulatedTaskResult {
+	results := s.taskResults[name]
+	if len(results) == 0 {
+		return nil
+	}
+	s.taskResults[name] = results[1:]
+	return results[0]
+}
+
+// applyResult places the result of a state into its raw input at resultPath, then filters
+// that by outputPath.
+func applyResult(input interface{}, resultPath string, result interface{}, outputPath string) (interface{}, error) {
+	withResult, err := setPath(input, resultPath, result)
+	if err != nil {
+		return nil, err
+	}
+	return getPath(withResult, outputPath)
+}
+
+func nextState(name string, state models.SLState) (string, error) {
+	if state.End {
+		return "", nil
+	}
+	if state.Next == "" {
+		return "", models.BadRequest{Message: fmt.Sprintf("state %s has neither Next nor End", name)}
+	}
+	return state.Next, nil
+}
+
+// errorMatches reports whether an error name is matched by the ErrorEquals of a retrier or catcher.
+func errorMatches(errorEquals []models.SLErrorEquals, errorName string) bool {
+	for _, e := range errorEquals {
+		switch string(e) {
+		case errorName, errorStatesAll:
+			return true
+		case errorStatesTaskFailed:
+			if errorName != errorStatesTimeout {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// retrierMaxAttempts applies the States Language default of 3 attempts.
+func retrierMaxAttempts(retrier *models.SLRetrier) int64 {
+	if retrier.MaxAttempts == nil {
+		return 3
+	}
+	return *retrier.MaxAttempts
+}
+
+// retrierInterval returns the seconds waited before the retry following `retries` earlier
+// retries, applying the States Language defaults of a 1 second interval and a backoff rate of 2.
+func retrierInterval(retrier *models.SLRetrier, retries int64) float64 {
+	interval := float64(retrier.IntervalSeconds)
+	if interval == 0 {
+		interval = 1
+	}
+	backoffRate := retrier.BackoffRate
+	if backoffRate == 0 {
+		backoffRate = 2
+	}
+	return interval * math.Pow(backoffRate, float64(retries))
+}
+
+// marshalJSONValue renders a value produced by json.Unmarshal back to JSON.
+func marshalJSONValue(data interface{}) string {
+	out, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprintf("%v", data)
+	}
+	return string(out)
+}
@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func simulationStateMachine() models.SLStateMachine {
+	return models.SLStateMachine{
+		StartAt: "fetch",
+		States: map[string]models.SLState{
+			"fetch": models.SLState{
+				Type:       models.SLStateTypeTask,
+				Resource:   "fetcher",
+				InputPath:  "$.params",
+				ResultPath: "$.fetched",
+				Next:       "route",
+				Retry: []*models.SLRetrier{{
+					ErrorEquals:     []models.SLErrorEquals{"States.Timeout"},
+					IntervalSeconds: 5,
+					MaxAttempts:     swag.Int64(1),
+				}},
+				Catch: []*models.SLCatcher{{
+					ErrorEquals: []models.SLErrorEquals{"States.ALL"},
+					ResultPath:  "$.error",
+					Next:        "report",
+				}},
+			},
+			"route": models.SLState{
+				Type: models.SLStateTypeChoice,
+				Choices: []*models.SLChoice{{
+					Variable:           "$.fetched.count",
+					NumericGreaterThan: swag.Float64(0),
+					Next:               "process",
+				}},
+				Default: "done",
+			},
+			"process": models.SLState{
+				Type:       models.SLStateTypePass,
+				Result:     `{"processed": true}`,
+				ResultPath: "$.result",
+				OutputPath: "$.result",
+				Next:       "done",
+			},
+			"done": models.SLState{
+				Type: models.SLStateTypeSucceed,
+			},
+			"report": models.SLState{
+				Type:  models.SLStateTypeFail,
+				Error: "FetchFailed",
+			},
+		},
+	}
+}
+
+func simulatedStates(result *models.SimulationResult) []string {
+	states := []string{}
+	for _, step := range result.Steps {
+		states = append(states, step.State)
+	}
+	return states
+}
+
+func TestSimulateStateMachine(t *testing.T) {
+	sm := simulationStateMachine()
+	input := `{"params": {"district": "abc"}}`
+
+	t.Log("Task results feed into Choice rules")
+	result, err := SimulateStateMachine(sm, input, []*models.SimulatedTaskResult{
+		{State: swag.String("fetch"), Output: `{"count": 3}`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.SimulationStatusSucceeded, result.Status)
+	assert.Equal(t, []string{"fetch", "route", "process", "done"}, simulatedStates(result))
+	assert.JSONEq(t, `{"params": {"district": "abc"}, "fetched": {"count": 3}}`, result.Steps[0].Output)
+	assert.JSONEq(t, `{"processed": true}`, result.Output)
+
+	t.Log("Errors matching a retrier are retried")
+	result, err = SimulateStateMachine(sm, input, []*models.SimulatedTaskResult{
+		{State: swag.String("fetch"), Error: "States.Timeout"},
+		{State: swag.String("fetch"), Output: `{"count": 0}`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.SimulationStatusSucceeded, result.Status)
+	assert.Equal(t, []string{"fetch", "fetch", "route", "done"}, simulatedStates(result))
+	assert.Equal(t, int64(1), result.Steps[0].Attempt)
+	assert.Equal(t, "States.Timeout", result.Steps[0].Error)
+	assert.Equal(t, float64(5), result.Steps[0].RetryIntervalSeconds)
+	assert.Equal(t, int64(2), result.Steps[1].Attempt)
+	assert.JSONEq(t, `{"params": {"district": "abc"}, "fetched": {"count": 0}}`, result.Output)
+
+	t.Log("Errors are caught once retries are exhausted")
+	result, err = SimulateStateMachine(sm, input, []*models.SimulatedTaskResult{
+		{State: swag.String("fetch"), Error: "States.Timeout"},
+		{State: swag.String("fetch"), Error: "States.Timeout", Cause: "too slow"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.SimulationStatusFailed, result.Status)
+	assert.Equal(t, []string{"fetch", "fetch", "report"}, simulatedStates(result))
+	assert.JSONEq(t, `{
+		"params": {"district": "abc"},
+		"error": {"Error": "States.Timeout", "Cause": "too slow"}
+	}`, result.Steps[2].Input)
+	assert.Equal(t, "FetchFailed", result.Error)
+
+	t.Log("Tasks without a result output their input")
+	result, err = SimulateStateMachine(sm, input, nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.SimulationStatusFailed, result.Status)
+	assert.Equal(t, []string{"fetch", "route"}, simulatedStates(result))
+	assert.Equal(t, "States.Runtime", result.Error)
+	assert.Contains(t, result.Cause, "$.fetched.count")
+
+	t.Log("Invalid input is rejected")
+	_, err = SimulateStateMachine(sm, `{"params"`, nil)
+	assert.IsType(t, models.BadRequest{}, err)
+}
+
+func TestSimulateStateMachineLoops(t *testing.T) {
+	sm := models.SLStateMachine{
+		StartAt: "wait",
+		States: map[string]models.SLState{
+			"wait": models.SLState{Type: models.SLStateTypeWait, Seconds: 10, Next: "loop"},
+			"loop": models.SLState{Type: models.SLStateTypePass, Next: "wait"},
+		},
+	}
+	result, err := SimulateStateMachine(sm, `{}`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.SimulationStatusFailed, result.Status)
+	assert.Len(t, result.Steps, maxSimulationSteps)
+}
@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mohae/deepcopy"
+)
+
+// pathSegment is one step of a reference path: either an object key or an array index.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseReferencePath parses a States Language reference path such as "$.foo.bar[0]" or
+// "$['foo'].bar", which identifies a single node.
+// An empty path is treated as "$".
+func parseReferencePath(path string) ([]pathSegment, error) {
+	if path == "" || path == "$" {
+		return []pathSegment{}, nil
+	}
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path %s must start with $", path)
+	}
+
+	segments := []pathSegment{}
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("path %s has an empty field name", path)
+			}
+			segments = append(segments, pathSegment{key: key})
+			rest = rest[end+1:]
+		case '[':
+			if strings.HasPrefix(rest, "['") {
+				end := strings.Index(rest, "']")
+				if end == -1 {
+					return nil, fmt.Errorf("path %s has an unterminated ['", path)
+				}
+				segments = append(segments, pathSegment{key: rest[2:end]})
+				rest = rest[end+2:]
+				continue
+			}
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("path %s has an unterminated [", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("path %s has an invalid array index %s", path, rest[1:end])
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("path %s is not a valid reference path", path)
+		}
+	}
+	return segments, nil
+}
+
+// ValidateReferencePath checks that a path is a reference path that can be simulated.
+func ValidateReferencePath(path string) error {
+	_, err := parseReferencePath(path)
+	return err
+}
+
+// ValidatePath checks a path that the States Language allows to be any JSONPath, such as InputPath
+// and OutputPath, only by its root: "$" for the input, or "$$" for the context object.
+func ValidatePath(path string) error {
+	if path != "" && !strings.HasPrefix(path, "$") {
+		return fmt.Errorf("path %s must start with $", path)
+	}
+	return nil
+}
+
+// getPath returns the value that a reference path selects from data.
+func getPath(data interface{}, path string) (interface{}, error) {
+	segments, err := parseReferencePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	value := data
+	for _, segment := range segments {
+		if segment.isIndex {
+			arr, ok := value.([]interface{})
+			if !ok || segment.index >= len(arr) {
+				return nil, fmt.Errorf("path %s does not match the input", path)
+			}
+			value = arr[segment.index]
+			continue
+		}
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("path %s does not match the input", path)
+		}
+		if value, ok = obj[segment.key]; !ok {
+			return nil, fmt.Errorf("path %s does not match the input", path)
+		}
+	}
+	return value, nil
+}
+
+// setPath returns a copy of data with the value at a reference path replaced, creating
+// objects for any missing fields along the way.
+func setPath(data interface{}, path string, value interface{}) (interface{}, error) {
+	segments, err := parseReferencePath(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return value, nil
+	}
+
+	root := deepcopy.Copy(data)
+	if root == nil {
+		root = map[string]interface{}{}
+	}
+	parent := root
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		if segment.isIndex {
+			arr, ok := parent.([]interface{})
+			if !ok || segment.index >= len(arr) {
+				return nil, fmt.Errorf("path %s does not match the input", path)
+			}
+			if last {
+				arr[segment.index] = value
+			} else {
+				parent = arr[segment.index]
+			}
+			continue
+		}
+		obj, ok := parent.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("path %s does not match the input", path)
+		}
+		if last {
+			obj[segment.key] = value
+			continue
+		}
+		if _, ok := obj[segment.key]; !ok {
+			obj[segment.key] = map[string]interface{}{}
+		}
+		parent = obj[segment.key]
+	}
+	return root, nil
+}
@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -13,12 +15,48 @@ import (
 // when creating a new Workflow. StateResource allows for a dynamic lookup of the
 // URI by the `executor` package.
 
-func NewStateResource(name, namespace, arn string) *models.StateResource {
+func NewStateResource(name, namespace, arn string) (*models.StateResource, error) {
+	resourceType, err := StateResourceTypeForURI(arn)
+	if err != nil {
+		return nil, err
+	}
 	return &models.StateResource{
 		Name:        name,
 		Namespace:   namespace,
 		URI:         arn,
-		Type:        models.StateResourceTypeActivityARN,
+		Type:        resourceType,
 		LastUpdated: strfmt.DateTime(time.Now()),
+	}, nil
+}
+
+// StateResourceTypeForURI infers the type of a StateResource from its ARN. An empty URI
+// stands for an activity that hasn't been created yet.
+func StateResourceTypeForURI(uri string) (models.StateResourceType, error) {
+	if uri == "" {
+		return models.StateResourceTypeActivityARN, nil
+	}
+	parts := strings.SplitN(uri, ":", 6)
+	if len(parts) == 6 && parts[0] == "arn" {
+		switch {
+		case parts[2] == "states" && strings.HasPrefix(parts[5], "activity:"):
+			return models.StateResourceTypeActivityARN, nil
+		case parts[2] == "lambda" && strings.HasPrefix(parts[5], "function:"):
+			return models.StateResourceTypeLambdaFunctionARN, nil
+		case parts[2] == "batch" && strings.HasPrefix(parts[5], "job-definition/"):
+			return models.StateResourceTypeJobDefinitionARN, nil
+		}
+	}
+	return "", models.BadRequest{
+		Message: fmt.Sprintf("uri must be the ARN of an activity, Lambda function or Batch job definition: %s", uri),
+	}
+}
+
+// ActivityName returns the name of the activity that an ActivityARN StateResource refers to.
+// StateResources without a URI are named the way state machines refer to activities,
+// i.e. <namespace>--<name>.
+func ActivityName(stateResource models.StateResource) string {
+	if stateResource.URI == "" {
+		return fmt.Sprintf("%s--%s", stateResource.Namespace, stateResource.Name)
 	}
+	return stateResource.URI[strings.Index(stateResource.URI, ":activity:")+len(":activity:"):]
 }
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateResource(t *testing.T) {
+	for uri, resourceType := range map[string]models.StateResourceType{
+		"": models.StateResourceTypeActivityARN,
+		"arn:aws:states:us-west-2:123456789012:activity:production--echo": models.StateResourceTypeActivityARN,
+		"arn:aws:lambda:us-west-2:123456789012:function:production--echo": models.StateResourceTypeLambdaFunctionARN,
+		"arn:aws:batch:us-west-2:123456789012:job-definition/echo:3":      models.StateResourceTypeJobDefinitionARN,
+	} {
+		stateResource, err := NewStateResource("echo", "production", uri)
+		require.NoError(t, err, uri)
+		assert.Equal(t, resourceType, stateResource.Type, uri)
+	}
+
+	for _, uri := range []string{
+		"echo",
+		"arn:aws:states:us-west-2:123456789012:stateMachine:production--echo",
+		"arn:aws:s3:::bucket",
+	} {
+		_, err := NewStateResource("echo", "production", uri)
+		assert.IsType(t, models.BadRequest{}, err, uri)
+	}
+}
+
+func TestActivityName(t *testing.T) {
+	assert.Equal(t, "production--echo", ActivityName(models.StateResource{
+		Name:      "echo",
+		Namespace: "production",
+	}))
+	assert.Equal(t, "shared-echo", ActivityName(models.StateResource{
+		Name:      "echo",
+		Namespace: "production",
+		URI:       "arn:aws:states:us-west-2:123456789012:activity:shared-echo",
+	}))
+}
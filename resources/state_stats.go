@@ -0,0 +1,193 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// Names of the counts of the jobs of a state, which StateStatsKey combines with the state and the
+// WorkflowDefinition version of the jobs.
+const (
+	stateStatsJobs      = "jobs"
+	stateStatsSucceeded = "succeeded"
+	stateStatsFailed    = "failed"
+	stateStatsRetries   = "retries"
+	// queue and run times are duration histograms, followed by the DurationBucket they count
+	stateStatsQueueTimePrefix = "queueTime."
+	stateStatsRunTimePrefix   = "runTime."
+)
+
+// StateStatsKey returns the key of a count of the jobs of a state of a WorkflowDefinition version.
+// The state comes last since state names can contain any character.
+func StateStatsKey(version int64, count, state string) string {
+	return fmt.Sprintf("%d:%s:%s", version, count, state)
+}
+
+// stateVersion identifies a state of a version of a WorkflowDefinition.
+type stateVersion struct {
+	state   string
+	version int64
+}
+
+// parseStateStatsKey reverses StateStatsKey.
+func parseStateStatsKey(key string) (stateVersion, string, bool) {
+	parts := strings.SplitN(key, ":", 3)
+	if len(parts) != 3 {
+		return stateVersion{}, "", false
+	}
+	version, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return stateVersion{}, "", false
+	}
+	return stateVersion{parts[2], version}, parts[1], true
+}
+
+// StateStatsChange returns the counts of the jobs of a stopped workflow, and of their retried
+// attempts, to add to the counts of the period it was created in. The jobs are final once the
+// workflow is done, so they are counted once rather than as the workflow is written. It returns
+// false if the workflow hasn't stopped.
+func StateStatsChange(workflow models.Workflow) (WorkflowStatsCounts, bool) {
+	if workflow.WorkflowDefinition == nil || !workflowStopped(&workflow) {
+		return WorkflowStatsCounts{}, false
+	}
+
+	change := NewWorkflowStatsCounts(
+		workflow.WorkflowDefinition.Name,
+		WorkflowStatsPeriodStart(time.Time(workflow.CreatedAt)),
+	)
+	change.StateWorkflows = 1
+	version := workflow.WorkflowDefinition.Version
+	for _, job := range workflow.Jobs {
+		if job == nil || job.State == "" {
+			continue
+		}
+		count := func(name string, n int64) {
+			change.States[StateStatsKey(version, name, job.State)] += n
+		}
+
+		count(stateStatsJobs, 1)
+		switch job.Status {
+		case models.JobStatusSucceeded:
+			count(stateStatsSucceeded, 1)
+		case models.JobStatusFailed:
+			count(stateStatsFailed, 1)
+		}
+		if len(job.Attempts) > 0 {
+			count(stateStatsRetries, int64(len(job.Attempts)))
+		}
+		for _, attempt := range job.Attempts {
+			if attempt != nil {
+				countTimes(count, attempt.CreatedAt, attempt.StartedAt, attempt.StoppedAt)
+			}
+		}
+		countTimes(count, job.CreatedAt, job.StartedAt, job.StoppedAt)
+	}
+	return change, true
+}
+
+// countTimes counts the queue time of an attempt that started and the run time of one that stopped.
+func countTimes(count func(name string, n int64), createdAt, startedAt, stoppedAt strfmt.DateTime) {
+	if time.Time(startedAt).IsZero() {
+		return
+	}
+	if !time.Time(createdAt).IsZero() {
+		bucket := DurationBucket(time.Time(startedAt).Sub(time.Time(createdAt)))
+		count(stateStatsQueueTimePrefix+strconv.Itoa(bucket), 1)
+	}
+	if !time.Time(stoppedAt).IsZero() {
+		bucket := DurationBucket(time.Time(stoppedAt).Sub(time.Time(startedAt)))
+		count(stateStatsRunTimePrefix+strconv.Itoa(bucket), 1)
+	}
+}
+
+type stateCounts struct {
+	jobs, succeeded, failed, retries int64
+	// queueTimes and runTimes are duration histograms keyed by DurationBucket
+	queueTimes, runTimes map[int]int64
+}
+
+// add adds n to the count of a state with the given name.
+func (c *stateCounts) add(name string, n int64) {
+	switch {
+	case name == stateStatsJobs:
+		c.jobs += n
+	case name == stateStatsSucceeded:
+		c.succeeded += n
+	case name == stateStatsFailed:
+		c.failed += n
+	case name == stateStatsRetries:
+		c.retries += n
+	case strings.HasPrefix(name, stateStatsQueueTimePrefix):
+		if bucket, err := strconv.Atoi(strings.TrimPrefix(name, stateStatsQueueTimePrefix)); err == nil {
+			c.queueTimes[bucket] += n
+		}
+	case strings.HasPrefix(name, stateStatsRunTimePrefix):
+		if bucket, err := strconv.Atoi(strings.TrimPrefix(name, stateStatsRunTimePrefix)); err == nil {
+			c.runTimes[bucket] += n
+		}
+	}
+}
+
+// NewWorkflowDefinitionStateStats sums the job counts of the periods of a time window and computes
+// the stats of each state, sorted by state and then by version.
+func NewWorkflowDefinitionStateStats(
+	workflowDefinitionName string, createdAfter, createdBefore time.Time, periods []WorkflowStatsCounts,
+) models.WorkflowDefinitionStateStats {
+	total := NewWorkflowStatsCounts(workflowDefinitionName, createdAfter)
+	for _, period := range periods {
+		total.Add(period)
+	}
+
+	counts := map[stateVersion]*stateCounts{}
+	keys := []stateVersion{}
+	for key, n := range total.States {
+		state, name, ok := parseStateStatsKey(key)
+		if !ok {
+			continue
+		}
+		if _, ok := counts[state]; !ok {
+			counts[state] = &stateCounts{queueTimes: map[int]int64{}, runTimes: map[int]int64{}}
+			keys = append(keys, state)
+		}
+		counts[state].add(name, n)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].state != keys[j].state {
+			return keys[i].state < keys[j].state
+		}
+		return keys[i].version < keys[j].version
+	})
+
+	states := []*models.StateStats{}
+	for _, key := range keys {
+		counts := counts[key]
+		stats := &models.StateStats{
+			State:     key.state,
+			Version:   key.version,
+			Jobs:      counts.jobs,
+			Succeeded: counts.succeeded,
+			Failed:    counts.failed,
+			Retries:   counts.retries,
+			QueueTime: NewDurationPercentiles(counts.queueTimes),
+			RunTime:   NewDurationPercentiles(counts.runTimes),
+		}
+		if finished := counts.succeeded + counts.failed; finished > 0 {
+			stats.FailureRate = float64(counts.failed) / float64(finished)
+		}
+		states = append(states, stats)
+	}
+
+	return models.WorkflowDefinitionStateStats{
+		WorkflowDefinitionName: workflowDefinitionName,
+		CreatedAfter:           strfmt.DateTime(createdAfter),
+		CreatedBefore:          strfmt.DateTime(createdBefore),
+		Workflows:              total.StateWorkflows,
+		States:                 states,
+	}
+}
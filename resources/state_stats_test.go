@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflowDefinitionStateStats(t *testing.T) {
+	start := time.Date(2018, 3, 1, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) strfmt.DateTime {
+		return strfmt.DateTime(start.Add(time.Duration(seconds) * time.Second))
+	}
+	workflow := func(version int64, jobs ...*models.Job) models.Workflow {
+		return models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				CreatedAt:          at(0),
+				StoppedAt:          at(300),
+				Status:             models.WorkflowStatusFailed,
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "wf", Version: version},
+			},
+			Jobs: jobs,
+		}
+	}
+	stateStatsChange := func(workflow models.Workflow) WorkflowStatsCounts {
+		change, ok := StateStatsChange(workflow)
+		require.True(t, ok)
+		assert.Equal(t, start, change.Period)
+		return change
+	}
+
+	periods := []WorkflowStatsCounts{
+		stateStatsChange(workflow(1,
+			&models.Job{State: "sync", Status: models.JobStatusSucceeded, CreatedAt: at(0), StartedAt: at(10), StoppedAt: at(70)},
+			&models.Job{State: "notify", Status: models.JobStatusFailed, CreatedAt: at(70)},
+		)),
+		stateStatsChange(workflow(1,
+			&models.Job{
+				State:     "sync",
+				Status:    models.JobStatusFailed,
+				CreatedAt: at(200),
+				StartedAt: at(210),
+				StoppedAt: at(270),
+				Attempts:  []*models.JobAttempt{{CreatedAt: at(0), StartedAt: at(10), StoppedAt: at(70)}},
+			},
+		)),
+		stateStatsChange(workflow(2,
+			&models.Job{State: "sync", Status: models.JobStatusSucceeded, CreatedAt: at(0)},
+		)),
+	}
+
+	t.Log("The jobs of workflows that haven't stopped aren't counted yet")
+	running := workflow(1, &models.Job{State: "sync", Status: models.JobStatusRunning, CreatedAt: at(0)})
+	running.Status = models.WorkflowStatusRunning
+	running.StoppedAt = strfmt.DateTime{}
+	_, ok := StateStatsChange(running)
+	assert.False(t, ok)
+
+	stats := NewWorkflowDefinitionStateStats("wf", start, start.Add(time.Hour), periods)
+	assert.Equal(t, int64(3), stats.Workflows)
+	require.Len(t, stats.States, 3)
+
+	t.Log("States are sorted by name, then by version")
+	assert.Equal(t, "notify", stats.States[0].State)
+	assert.Equal(t, int64(0), stats.States[0].RunTime.Count)
+	assert.Equal(t, float64(1), stats.States[0].FailureRate)
+
+	sync := stats.States[1]
+	assert.Equal(t, "sync", sync.State)
+	assert.Equal(t, int64(1), sync.Version)
+	assert.Equal(t, int64(2), sync.Jobs)
+	assert.Equal(t, int64(1), sync.Succeeded)
+	assert.Equal(t, int64(1), sync.Failed)
+	assert.Equal(t, int64(1), sync.Retries)
+	assert.Equal(t, 0.5, sync.FailureRate)
+	t.Log("Retried attempts count towards queue and run times")
+	assert.Equal(t, int64(3), sync.QueueTime.Count)
+	assert.InEpsilon(t, 10, sync.QueueTime.P50, 0.1)
+	assert.Equal(t, int64(3), sync.RunTime.Count)
+	assert.InEpsilon(t, 60, sync.RunTime.P99, 0.1)
+
+	t.Log("Jobs without start times have no queue or run times")
+	assert.Equal(t, int64(2), stats.States[2].Version)
+	assert.Equal(t, int64(1), stats.States[2].Jobs)
+	assert.Equal(t, int64(0), stats.States[2].QueueTime.Count)
+}
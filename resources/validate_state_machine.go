@@ -0,0 +1,372 @@
+package resources
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+const (
+	// maxStateNameLength is the longest state name Step Functions accepts.
+	maxStateNameLength = 80
+	// maxRetrierAttempts matches the bounds of SLRetrier.MaxAttempts in swagger.yml.
+	maxRetrierAttempts = 10
+)
+
+// StateMachineProblem is a violation of the States Language, located by the path of the
+// offending field, e.g. "States.start.Retry[1].ErrorEquals".
+type StateMachineProblem struct {
+	Path    string
+	Message string
+}
+
+func (p StateMachineProblem) String() string {
+	return fmt.Sprintf("%s: %s", p.Path, p.Message)
+}
+
+type stateMachineValidation struct {
+	sm       models.SLStateMachine
+	problems []StateMachineProblem
+}
+
+func (v *stateMachineValidation) addProblem(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, StateMachineProblem{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateStateMachine checks a state machine against the Amazon States Language as supported
+// by workflow-manager, returning every problem found rather than stopping at the first.
+func ValidateStateMachine(sm models.SLStateMachine) []StateMachineProblem {
+	v := &stateMachineValidation{sm: sm, problems: []StateMachineProblem{}}
+
+	if sm.StartAt == "" {
+		v.addProblem("StartAt", "is required")
+	} else if _, ok := sm.States[sm.StartAt]; !ok {
+		v.addProblem("StartAt", "state %s does not exist", sm.StartAt)
+	}
+	if len(sm.States) == 0 {
+		v.addProblem("States", "must define at least one state")
+	}
+	if sm.TimeoutSeconds < 0 {
+		v.addProblem("TimeoutSeconds", "must not be negative")
+	}
+
+	for _, name := range sortedStateNames(sm) {
+		v.validateState(name, sm.States[name])
+	}
+	return v.problems
+}
+
+// StateMachineProblemsError combines problems into a single BadRequest, or returns nil if
+// there are none.
+func StateMachineProblemsError(problems []StateMachineProblem) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	messages := []string{}
+	for _, problem := range problems {
+		messages = append(messages, problem.String())
+	}
+	return models.BadRequest{
+		Message: fmt.Sprintf("invalid state machine: %s", strings.Join(messages, "; ")),
+	}
+}
+
+func (v *stateMachineValidation) validateState(name string, state models.SLState) {
+	path := fmt.Sprintf("States.%s", name)
+	if len(name) > maxStateNameLength {
+		v.addProblem(path, "state names must be at most %d characters", maxStateNameLength)
+	}
+
+	for _, field := range []struct {
+		name string
+		path string
+	}{
+		{"InputPath", state.InputPath},
+		{"OutputPath", state.OutputPath},
+	} {
+		if err := ValidatePath(field.path); err != nil {
+			v.addProblem(path+"."+field.name, "%s", err)
+		}
+	}
+	// ResultPath is the only path that the States Language limits to reference paths
+	if err := ValidateReferencePath(state.ResultPath); err != nil {
+		v.addProblem(path+".ResultPath", "%s", err)
+	}
+
+	switch state.Type {
+	case models.SLStateTypeTask:
+		if state.Resource == "" {
+			v.addProblem(path+".Resource", "is required for Task states")
+		}
+		if state.TimeoutSeconds < 0 {
+			v.addProblem(path+".TimeoutSeconds", "must not be negative")
+		}
+		if state.HeartbeatSeconds < 0 {
+			v.addProblem(path+".HeartbeatSeconds", "must not be negative")
+		}
+		if state.HeartbeatSeconds > 0 && state.TimeoutSeconds > 0 && state.HeartbeatSeconds >= state.TimeoutSeconds {
+			v.addProblem(path+".HeartbeatSeconds", "must be less than TimeoutSeconds (%d)", state.TimeoutSeconds)
+		}
+		v.validateNextOrEnd(path, state)
+		v.validateRetry(path, state.Retry)
+		v.validateCatch(path, state.Catch)
+	case models.SLStateTypePass:
+		v.validateNextOrEnd(path, state)
+	case models.SLStateTypeWait:
+		v.validateWait(path, state)
+		v.validateNextOrEnd(path, state)
+	case models.SLStateTypeChoice:
+		v.validateChoiceState(path, state)
+	case models.SLStateTypeSucceed, models.SLStateTypeFail:
+		if state.Next != "" {
+			v.addProblem(path+".Next", "%s states can't have a Next state", state.Type)
+		}
+		if state.End {
+			v.addProblem(path+".End", "%s states can't set End", state.Type)
+		}
+	case models.SLStateTypeParallel:
+		v.addProblem(path+".Type", "Parallel states are not supported")
+		return
+	case "":
+		v.addProblem(path+".Type", "is required")
+		return
+	default:
+		v.addProblem(path+".Type", "%s is not a valid state type", state.Type)
+		return
+	}
+
+	if state.Type != models.SLStateTypeTask {
+		if state.Resource != "" {
+			v.addProblem(path+".Resource", "is only allowed in Task states")
+		}
+		if len(state.Retry) > 0 {
+			v.addProblem(path+".Retry", "is only allowed in Task states")
+		}
+		if len(state.Catch) > 0 {
+			v.addProblem(path+".Catch", "is only allowed in Task states")
+		}
+	}
+	if state.Type != models.SLStateTypeChoice && len(state.Choices) > 0 {
+		v.addProblem(path+".Choices", "is only allowed in Choice states")
+	}
+}
+
+// validateNextOrEnd checks that a state either transitions to an existing state or ends the execution.
+func (v *stateMachineValidation) validateNextOrEnd(path string, state models.SLState) {
+	switch {
+	case state.Next != "" && state.End:
+		v.addProblem(path, "must set exactly one of Next and End")
+	case state.Next == "" && !state.End:
+		v.addProblem(path, "must set one of Next and End")
+	case state.Next != "":
+		v.validateTransition(path+".Next", state.Next)
+	}
+}
+
+func (v *stateMachineValidation) validateTransition(path, next string) {
+	if _, ok := v.sm.States[next]; !ok {
+		v.addProblem(path, "state %s does not exist", next)
+	}
+}
+
+func (v *stateMachineValidation) validateRetry(statePath string, retriers []*models.SLRetrier) {
+	for i, retrier := range retriers {
+		path := fmt.Sprintf("%s.Retry[%d]", statePath, i)
+		if retrier == nil {
+			v.addProblem(path, "must not be null")
+			continue
+		}
+		v.validateErrorEquals(path, retrier.ErrorEquals, i == len(retriers)-1)
+		if retrier.MaxAttempts != nil && (*retrier.MaxAttempts < 0 || *retrier.MaxAttempts > maxRetrierAttempts) {
+			v.addProblem(path+".MaxAttempts", "must be between 0 and %d", maxRetrierAttempts)
+		}
+		if retrier.IntervalSeconds < 0 {
+			v.addProblem(path+".IntervalSeconds", "must not be negative")
+		}
+		if retrier.BackoffRate != 0 && retrier.BackoffRate < 1 {
+			v.addProblem(path+".BackoffRate", "must be at least 1.0")
+		}
+	}
+}
+
+func (v *stateMachineValidation) validateCatch(statePath string, catchers []*models.SLCatcher) {
+	for i, catcher := range catchers {
+		path := fmt.Sprintf("%s.Catch[%d]", statePath, i)
+		if catcher == nil {
+			v.addProblem(path, "must not be null")
+			continue
+		}
+		v.validateErrorEquals(path, catcher.ErrorEquals, i == len(catchers)-1)
+		if catcher.Next == "" {
+			v.addProblem(path+".Next", "is required")
+		} else {
+			v.validateTransition(path+".Next", catcher.Next)
+		}
+		if err := ValidateReferencePath(catcher.ResultPath); err != nil {
+			v.addProblem(path+".ResultPath", "%s", err)
+		}
+	}
+}
+
+// validateErrorEquals checks that States.ALL appears alone, in the last retrier or catcher.
+func (v *stateMachineValidation) validateErrorEquals(path string, errorEquals []models.SLErrorEquals, last bool) {
+	if len(errorEquals) == 0 {
+		v.addProblem(path+".ErrorEquals", "must list at least one error")
+		return
+	}
+	for _, errorName := range errorEquals {
+		if errorName != errorStatesAll {
+			continue
+		}
+		if len(errorEquals) > 1 {
+			v.addProblem(path+".ErrorEquals", "%s must be the only error it lists", errorStatesAll)
+		}
+		if !last {
+			v.addProblem(path+".ErrorEquals", "%s must be in the last entry", errorStatesAll)
+		}
+	}
+}
+
+func (v *stateMachineValidation) validateWait(path string, state models.SLState) {
+	numFields := 0
+	if state.Seconds != 0 {
+		numFields++
+		if state.Seconds < 0 {
+			v.addProblem(path+".Seconds", "must not be negative")
+		}
+	}
+	if state.Timestamp != "" {
+		numFields++
+		if _, err := time.Parse(time.RFC3339, state.Timestamp); err != nil {
+			v.addProblem(path+".Timestamp", "must be an RFC3339 timestamp")
+		}
+	}
+	if state.SecondsPath != "" {
+		numFields++
+		if err := ValidatePath(state.SecondsPath); err != nil {
+			v.addProblem(path+".SecondsPath", "%s", err)
+		}
+	}
+	if state.TimestampPath != "" {
+		numFields++
+		if err := ValidatePath(state.TimestampPath); err != nil {
+			v.addProblem(path+".TimestampPath", "%s", err)
+		}
+	}
+	if numFields != 1 {
+		v.addProblem(path, "Wait states must set exactly one of Seconds, Timestamp, SecondsPath and TimestampPath")
+	}
+}
+
+func (v *stateMachineValidation) validateChoiceState(path string, state models.SLState) {
+	if state.Next != "" {
+		v.addProblem(path+".Next", "Choice states can't have a Next state")
+	}
+	if state.End {
+		v.addProblem(path+".End", "Choice states can't set End")
+	}
+	if len(state.Choices) == 0 {
+		v.addProblem(path+".Choices", "must list at least one choice rule")
+	}
+	for i, choice := range state.Choices {
+		choicePath := fmt.Sprintf("%s.Choices[%d]", path, i)
+		if choice == nil {
+			v.addProblem(choicePath, "must not be null")
+			continue
+		}
+		if choice.Next == "" {
+			v.addProblem(choicePath+".Next", "is required")
+		} else {
+			v.validateTransition(choicePath+".Next", choice.Next)
+		}
+		v.validateChoiceRule(choicePath, choice)
+	}
+	if state.Default != "" {
+		v.validateTransition(path+".Default", state.Default)
+	}
+}
+
+// validateChoiceRule checks that a choice rule uses exactly one operator. Nested rules
+// (inside And, Or and Not) can't have a Next state.
+func (v *stateMachineValidation) validateChoiceRule(path string, rule *models.SLChoice) {
+	operators := choiceRuleOperators(rule)
+	if len(operators) != 1 {
+		v.addProblem(path, "choice rules must use exactly one comparison operator, found %d (%s)",
+			len(operators), strings.Join(operators, ", "))
+	}
+
+	nested := []*models.SLChoice{}
+	nestedPaths := []string{}
+	for i, r := range rule.And {
+		nested = append(nested, r)
+		nestedPaths = append(nestedPaths, fmt.Sprintf("%s.And[%d]", path, i))
+	}
+	for i, r := range rule.Or {
+		nested = append(nested, r)
+		nestedPaths = append(nestedPaths, fmt.Sprintf("%s.Or[%d]", path, i))
+	}
+	if rule.Not != nil {
+		nested = append(nested, rule.Not)
+		nestedPaths = append(nestedPaths, path+".Not")
+	}
+	for i, r := range nested {
+		if r == nil {
+			v.addProblem(nestedPaths[i], "must not be null")
+			continue
+		}
+		if r.Next != "" {
+			v.addProblem(nestedPaths[i]+".Next", "nested choice rules can't have a Next state")
+		}
+		v.validateChoiceRule(nestedPaths[i], r)
+	}
+
+	if len(rule.And) > 0 || len(rule.Or) > 0 || rule.Not != nil {
+		if rule.Variable != "" {
+			v.addProblem(path+".Variable", "is not allowed with And, Or or Not")
+		}
+		return
+	}
+	if rule.Variable == "" {
+		v.addProblem(path+".Variable", "is required")
+	} else if err := ValidatePath(rule.Variable); err != nil {
+		v.addProblem(path+".Variable", "%s", err)
+	}
+}
+
+// choiceRuleOperators lists the operators a choice rule uses.
+func choiceRuleOperators(rule *models.SLChoice) []string {
+	operators := []string{}
+	for name, set := range map[string]bool{
+		"And":                        len(rule.And) > 0,
+		"Or":                         len(rule.Or) > 0,
+		"Not":                        rule.Not != nil,
+		"StringEquals":               rule.StringEquals != nil,
+		"StringLessThan":             rule.StringLessThan != nil,
+		"StringGreaterThan":          rule.StringGreaterThan != nil,
+		"StringLessThanEquals":       rule.StringLessThanEquals != nil,
+		"StringGreaterThanEquals":    rule.StringGreaterThanEquals != nil,
+		"NumericEquals":              rule.NumericEquals != nil,
+		"NumericLessThan":            rule.NumericLessThan != nil,
+		"NumericGreaterThan":         rule.NumericGreaterThan != nil,
+		"NumericLessThanEquals":      rule.NumericLessThanEquals != nil,
+		"NumericGreaterThanEquals":   rule.NumericGreaterThanEquals != nil,
+		"BooleanEquals":              rule.BooleanEquals != nil,
+		"TimestampEquals":            rule.TimestampEquals != nil,
+		"TimestampLessThan":          rule.TimestampLessThan != nil,
+		"TimestampGreaterThan":       rule.TimestampGreaterThan != nil,
+		"TimestampLessThanEquals":    rule.TimestampLessThanEquals != nil,
+		"TimestampGreaterThanEquals": rule.TimestampGreaterThanEquals != nil,
+	} {
+		if set {
+			operators = append(operators, name)
+		}
+	}
+	sort.Strings(operators)
+	return operators
+}
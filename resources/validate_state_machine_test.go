@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func problemPaths(problems []StateMachineProblem) []string {
+	paths := []string{}
+	for _, problem := range problems {
+		paths = append(paths, problem.Path)
+	}
+	return paths
+}
+
+func TestValidateStateMachineValid(t *testing.T) {
+	assert.Empty(t, ValidateStateMachine(*KitchenSinkWorkflowDefinition(t).StateMachine))
+	assert.Empty(t, ValidateStateMachine(simulationStateMachine()))
+
+	var sm models.SLStateMachine
+	require.NoError(t, json.Unmarshal([]byte(awsExampleChoiceStateMachine), &sm))
+	assert.Empty(t, ValidateStateMachine(sm))
+}
+
+func TestValidateStateMachine(t *testing.T) {
+	longName := strings.Repeat("a", maxStateNameLength+1)
+	sm := models.SLStateMachine{
+		StartAt: "task",
+		States: map[string]models.SLState{
+			"task": models.SLState{
+				Type:             models.SLStateTypeTask,
+				Resource:         "resource",
+				Next:             "choice",
+				TimeoutSeconds:   60,
+				HeartbeatSeconds: 60,
+				Retry: []*models.SLRetrier{
+					{ErrorEquals: []models.SLErrorEquals{"States.ALL"}},
+					{ErrorEquals: []models.SLErrorEquals{"States.Timeout"}, MaxAttempts: swag.Int64(11)},
+				},
+				Catch: []*models.SLCatcher{
+					{ErrorEquals: []models.SLErrorEquals{"States.ALL", "States.Timeout"}, Next: "missing"},
+				},
+			},
+			"choice": models.SLState{
+				Type: models.SLStateTypeChoice,
+				Choices: []*models.SLChoice{
+					{Variable: "$.a", StringEquals: swag.String("a"), NumericEquals: swag.Int64(1), Next: "wait"},
+					{Not: &models.SLChoice{Variable: "$.b", BooleanEquals: swag.Bool(true), Next: "wait"}, Next: longName},
+				},
+			},
+			"wait": models.SLState{
+				Type:        models.SLStateTypeWait,
+				Seconds:     10,
+				SecondsPath: "$.seconds",
+				Next:        "done",
+			},
+			"done": models.SLState{
+				Type: models.SLStateTypeSucceed,
+				Next: "task",
+			},
+			longName: models.SLState{
+				Type:      models.SLStateTypePass,
+				InputPath: "foo",
+				End:       true,
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"States." + longName,
+		"States." + longName + ".InputPath",
+		"States.choice.Choices[0]",
+		"States.choice.Choices[1].Not.Next",
+		"States.done.Next",
+		"States.task.HeartbeatSeconds",
+		"States.task.Retry[0].ErrorEquals",
+		"States.task.Retry[1].MaxAttempts",
+		"States.task.Catch[0].ErrorEquals",
+		"States.task.Catch[0].Next",
+		"States.wait",
+	}, problemPaths(ValidateStateMachine(sm)))
+
+	err := StateMachineProblemsError(ValidateStateMachine(sm))
+	require.IsType(t, models.BadRequest{}, err)
+	assert.Contains(t, err.(models.BadRequest).Message, "States.task.Catch[0].Next: state missing does not exist")
+	assert.NoError(t, StateMachineProblemsError(nil))
+}
+
+func TestValidateStateMachineStates(t *testing.T) {
+	assert.Equal(t, []string{"StartAt", "States"}, problemPaths(ValidateStateMachine(models.SLStateMachine{})))
+
+	sm := models.SLStateMachine{
+		StartAt: "parallel",
+		States: map[string]models.SLState{
+			"parallel": models.SLState{Type: models.SLStateTypeParallel, Next: "task"},
+			"task":     models.SLState{Type: models.SLStateTypeTask},
+			"pass":     models.SLState{Type: models.SLStateTypePass, Resource: "resource", End: true},
+		},
+	}
+	assert.Equal(t, []string{
+		"States.parallel.Type",
+		"States.pass.Resource",
+		"States.task.Resource",
+		"States.task",
+	}, problemPaths(ValidateStateMachine(sm)))
+}
+
+func TestValidateStateMachinePaths(t *testing.T) {
+	sm := models.SLStateMachine{
+		StartAt: "pass",
+		States: map[string]models.SLState{
+			"pass": models.SLState{
+				Type:       models.SLStateTypePass,
+				InputPath:  "$['items'][*]",
+				OutputPath: "$$.Execution.Input",
+				ResultPath: "$['result'].value[0]",
+				Next:       "filter",
+			},
+			"filter": models.SLState{
+				Type:       models.SLStateTypePass,
+				InputPath:  "$.items[?(@.size > 1)]",
+				ResultPath: "$.items[*]",
+				End:        true,
+			},
+		},
+	}
+	t.Log("InputPath and OutputPath can be any JSONPath, but ResultPath must identify a single node")
+	assert.Equal(t, []string{"States.filter.ResultPath"}, problemPaths(ValidateStateMachine(sm)))
+}
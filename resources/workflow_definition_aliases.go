@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/swag"
+)
+
+// AliasVersion picks the version that a workflow started through an alias runs. roll is a
+// number from 0 to 99, and rolls below the canary weight of the alias pick its canary version.
+func AliasVersion(alias models.WorkflowDefinitionAlias, roll int) int64 {
+	if alias.CanaryVersion != nil && int64(roll) < swag.Int64Value(alias.CanaryWeight) {
+		return *alias.CanaryVersion
+	}
+	return alias.Version
+}
+
+// ValidateWorkflowDefinitionAlias checks that an alias either points at a single version, or
+// splits workflows between its version and a different canary version.
+func ValidateWorkflowDefinitionAlias(alias models.WorkflowDefinitionAlias) error {
+	if alias.Name == "" || alias.Alias == "" {
+		return models.BadRequest{Message: "WorkflowDefinitionAlias requires a name and an alias"}
+	}
+	weight := swag.Int64Value(alias.CanaryWeight)
+	if weight < 0 || weight > 100 {
+		return models.BadRequest{Message: fmt.Sprintf("canaryWeight must be between 0 and 100: %d", weight)}
+	}
+	if alias.CanaryVersion == nil {
+		if weight > 0 {
+			return models.BadRequest{Message: "canaryWeight requires a canaryVersion"}
+		}
+		return nil
+	}
+	if *alias.CanaryVersion == alias.Version {
+		return models.BadRequest{Message: fmt.Sprintf("canaryVersion must differ from version %d", alias.Version)}
+	}
+	return nil
+}
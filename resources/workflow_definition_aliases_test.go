@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestAliasVersion(t *testing.T) {
+	stable := models.WorkflowDefinitionAlias{Name: "definition", Alias: "stable", Version: 3}
+	for roll := 0; roll < 100; roll++ {
+		assert.Equal(t, int64(3), AliasVersion(stable, roll))
+	}
+
+	canary := models.WorkflowDefinitionAlias{
+		Name:          "definition",
+		Alias:         "canary",
+		Version:       3,
+		CanaryVersion: swag.Int64(4),
+		CanaryWeight:  swag.Int64(5),
+	}
+	canaryWorkflows := 0
+	for roll := 0; roll < 100; roll++ {
+		if AliasVersion(canary, roll) == 4 {
+			canaryWorkflows++
+		}
+	}
+	assert.Equal(t, 5, canaryWorkflows)
+
+	canary.CanaryWeight = swag.Int64(100)
+	assert.Equal(t, int64(4), AliasVersion(canary, 99))
+}
+
+func TestValidateWorkflowDefinitionAlias(t *testing.T) {
+	assert.NoError(t, ValidateWorkflowDefinitionAlias(models.WorkflowDefinitionAlias{
+		Name: "definition", Alias: "stable", Version: 3,
+	}))
+	assert.NoError(t, ValidateWorkflowDefinitionAlias(models.WorkflowDefinitionAlias{
+		Name: "definition", Alias: "canary", Version: 3, CanaryVersion: swag.Int64(4), CanaryWeight: swag.Int64(5),
+	}))
+
+	for _, alias := range []models.WorkflowDefinitionAlias{
+		{Name: "definition", Version: 3},
+		{Name: "definition", Alias: "canary", Version: 3, CanaryWeight: swag.Int64(5)},
+		{Name: "definition", Alias: "canary", Version: 3, CanaryVersion: swag.Int64(3), CanaryWeight: swag.Int64(5)},
+		{Name: "definition", Alias: "canary", Version: 3, CanaryVersion: swag.Int64(4), CanaryWeight: swag.Int64(101)},
+	} {
+		assert.IsType(t, models.BadRequest{}, ValidateWorkflowDefinitionAlias(alias))
+	}
+}
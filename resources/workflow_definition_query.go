@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// WorkflowDefinitionQuery filters workflow definitions by their ownership and metadata.
+// Empty fields match every definition.
+type WorkflowDefinitionQuery struct {
+	Team       string
+	LabelKey   string
+	LabelValue string
+	Search     string
+}
+
+// NewWorkflowDefinitionQuery builds a query from the parameters of GetWorkflowDefinitions.
+// label has the form key=value.
+func NewWorkflowDefinitionQuery(team, label, search string) (WorkflowDefinitionQuery, error) {
+	query := WorkflowDefinitionQuery{
+		Team:   team,
+		Search: strings.ToLower(search),
+	}
+	if label != "" {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return WorkflowDefinitionQuery{}, models.BadRequest{
+				Message: fmt.Sprintf("label must have the form key=value: %s", label),
+			}
+		}
+		query.LabelKey, query.LabelValue = parts[0], parts[1]
+	}
+	return query, nil
+}
+
+// Matches returns true if the workflow definition satisfies every filter of the query.
+func (q WorkflowDefinitionQuery) Matches(def models.WorkflowDefinition) bool {
+	if q.Team != "" && !strings.EqualFold(def.Team, q.Team) {
+		return false
+	}
+	if q.LabelKey != "" {
+		if value, ok := def.Labels[q.LabelKey]; !ok || value != q.LabelValue {
+			return false
+		}
+	}
+	if q.Search != "" {
+		found := false
+		for _, field := range []string{def.Name, def.Description, def.Team, def.Contact} {
+			if strings.Contains(strings.ToLower(field), q.Search) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// InheritWorkflowDefinitionMetadata copies the description, owner and labels of the
+// previous version of a definition onto a new version that doesn't set them.
+// The changelog is specific to each version and is never inherited.
+func InheritWorkflowDefinitionMetadata(def *models.WorkflowDefinition, previous models.WorkflowDefinition) {
+	if def.Description == "" {
+		def.Description = previous.Description
+	}
+	if def.Team == "" {
+		def.Team = previous.Team
+	}
+	if def.Contact == "" {
+		def.Contact = previous.Contact
+	}
+	if def.Labels == nil {
+		def.Labels = previous.Labels
+	}
+}
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowDefinitionQuery(t *testing.T) {
+	def := models.WorkflowDefinition{
+		Name:        "sync-district",
+		Description: "Imports rosters from the SIS",
+		Team:        "eng-infra",
+		Contact:     "#oncall-infra",
+		Labels:      map[string]string{"tier": "1"},
+	}
+
+	for _, test := range []struct {
+		team, label, search string
+		matches             bool
+	}{
+		{matches: true},
+		{team: "eng-infra", matches: true},
+		{team: "ENG-INFRA", matches: true},
+		{team: "eng-ip", matches: false},
+		{label: "tier=1", matches: true},
+		{label: "tier=2", matches: false},
+		{label: "owner=", matches: false},
+		{search: "rosters", matches: true},
+		{search: "SYNC", matches: true},
+		{search: "oncall", matches: true},
+		{search: "billing", matches: false},
+		{team: "eng-infra", label: "tier=1", search: "district", matches: true},
+		{team: "eng-infra", label: "tier=1", search: "billing", matches: false},
+	} {
+		query, err := NewWorkflowDefinitionQuery(test.team, test.label, test.search)
+		require.NoError(t, err)
+		assert.Equal(t, test.matches, query.Matches(def), "%+v", test)
+	}
+
+	for _, label := range []string{"tier", "=1"} {
+		_, err := NewWorkflowDefinitionQuery("", label, "")
+		assert.IsType(t, models.BadRequest{}, err, label)
+	}
+}
+
+func TestInheritWorkflowDefinitionMetadata(t *testing.T) {
+	previous := models.WorkflowDefinition{
+		Description: "Imports rosters",
+		Team:        "eng-infra",
+		Contact:     "#oncall-infra",
+		Labels:      map[string]string{"tier": "1"},
+		Changelog:   "first version",
+	}
+
+	def := models.WorkflowDefinition{Team: "eng-ip", Changelog: "retry failed imports"}
+	InheritWorkflowDefinitionMetadata(&def, previous)
+	assert.Equal(t, models.WorkflowDefinition{
+		Description: "Imports rosters",
+		Team:        "eng-ip",
+		Contact:     "#oncall-infra",
+		Labels:      map[string]string{"tier": "1"},
+		Changelog:   "retry failed imports",
+	}, def)
+}
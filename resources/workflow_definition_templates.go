@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+var templateParameterNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// templatePlaceholderRegex matches a {{parameter}} placeholder in the state machine of a template
+var templatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// ValidateWorkflowDefinitionTemplate checks that the parameters of a template are well formed
+// and that its state machine only uses declared parameters.
+func ValidateWorkflowDefinitionTemplate(template models.WorkflowDefinitionTemplate) error {
+	if template.Name == "" {
+		return models.BadRequest{Message: "WorkflowDefinitionTemplate requires a name"}
+	}
+	if template.StateMachine == "" {
+		return models.BadRequest{Message: "WorkflowDefinitionTemplate requires a stateMachine"}
+	}
+
+	declared := map[string]bool{}
+	for _, param := range template.Parameters {
+		if param == nil || param.Name == nil || !templateParameterNameRegex.MatchString(*param.Name) {
+			return models.BadRequest{Message: "template parameter names must be letters, digits and underscores"}
+		}
+		if declared[*param.Name] {
+			return models.BadRequest{Message: fmt.Sprintf("template parameter %s is declared twice", *param.Name)}
+		}
+		declared[*param.Name] = true
+	}
+	for _, match := range templatePlaceholderRegex.FindAllStringSubmatch(template.StateMachine, -1) {
+		if !declared[match[1]] {
+			return models.BadRequest{Message: fmt.Sprintf("stateMachine uses undeclared parameter %s", match[1])}
+		}
+	}
+	if err := ValidateInputSchema(template.InputSchema); err != nil {
+		return err
+	}
+	return ValidateInputObject("defaultInput", template.DefaultInput)
+}
+
+// RenderWorkflowDefinitionTemplate replaces the placeholders in the state machine of a template
+// with parameter values, falling back to the defaults of the template. It returns the state
+// machine along with a reference to the template that records every parameter value used.
+func RenderWorkflowDefinitionTemplate(
+	template models.WorkflowDefinitionTemplate, params map[string]string,
+) (*models.SLStateMachine, *models.WorkflowDefinitionTemplateRef, error) {
+	values := map[string]string{}
+	for _, param := range template.Parameters {
+		if value, ok := params[*param.Name]; ok {
+			values[*param.Name] = value
+		} else if param.Default != nil {
+			values[*param.Name] = *param.Default
+		} else {
+			return nil, nil, models.BadRequest{
+				Message: fmt.Sprintf("template %s requires parameter %s", template.Name, *param.Name),
+			}
+		}
+	}
+	for name := range params {
+		if _, ok := values[name]; !ok {
+			return nil, nil, models.BadRequest{
+				Message: fmt.Sprintf("template %s has no parameter %s", template.Name, name),
+			}
+		}
+	}
+
+	rendered := templatePlaceholderRegex.ReplaceAllStringFunc(template.StateMachine, func(placeholder string) string {
+		name := templatePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+		// escape the value so that it can't break out of the JSON string it's placed in;
+		// values placed outside of a string, e.g. numbers, are unaffected
+		escaped, _ := json.Marshal(values[name])
+		return string(escaped[1 : len(escaped)-1])
+	})
+
+	var stateMachine models.SLStateMachine
+	decoder := json.NewDecoder(strings.NewReader(rendered))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&stateMachine); err != nil {
+		return nil, nil, models.BadRequest{
+			Message: fmt.Sprintf("template %s doesn't render to a valid state machine: %s", template.Name, err),
+		}
+	}
+
+	return &stateMachine, &models.WorkflowDefinitionTemplateRef{
+		Name:       template.Name,
+		Version:    template.Version,
+		Parameters: values,
+	}, nil
+}
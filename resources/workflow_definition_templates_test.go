@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWorkflowDefinitionTemplate() models.WorkflowDefinitionTemplate {
+	return models.WorkflowDefinitionTemplate{
+		Name:    "sync",
+		Version: 2,
+		Parameters: []*models.TemplateParameter{
+			{Name: swag.String("resource")},
+			{Name: swag.String("timeout"), Default: swag.String("60")},
+		},
+		StateMachine: `{
+			"StartAt": "sync",
+			"States": {
+				"sync": {"Type": "Task", "Resource": "{{resource}}", "TimeoutSeconds": {{ timeout }}, "End": true}
+			}
+		}`,
+	}
+}
+
+func TestValidateWorkflowDefinitionTemplate(t *testing.T) {
+	assert.NoError(t, ValidateWorkflowDefinitionTemplate(testWorkflowDefinitionTemplate()))
+
+	undeclared := testWorkflowDefinitionTemplate()
+	undeclared.Parameters = undeclared.Parameters[:1]
+	assert.IsType(t, models.BadRequest{}, ValidateWorkflowDefinitionTemplate(undeclared))
+
+	duplicate := testWorkflowDefinitionTemplate()
+	duplicate.Parameters = append(duplicate.Parameters, &models.TemplateParameter{Name: swag.String("resource")})
+	assert.IsType(t, models.BadRequest{}, ValidateWorkflowDefinitionTemplate(duplicate))
+
+	badName := testWorkflowDefinitionTemplate()
+	badName.Parameters = append(badName.Parameters, &models.TemplateParameter{Name: swag.String("resource-name")})
+	assert.IsType(t, models.BadRequest{}, ValidateWorkflowDefinitionTemplate(badName))
+}
+
+func TestRenderWorkflowDefinitionTemplate(t *testing.T) {
+	template := testWorkflowDefinitionTemplate()
+
+	stateMachine, ref, err := RenderWorkflowDefinitionTemplate(template, map[string]string{"resource": "sync-district"})
+	require.NoError(t, err)
+	assert.Equal(t, "sync-district", stateMachine.States["sync"].Resource)
+	assert.Equal(t, int64(60), stateMachine.States["sync"].TimeoutSeconds)
+	assert.Equal(t, &models.WorkflowDefinitionTemplateRef{
+		Name:       "sync",
+		Version:    2,
+		Parameters: map[string]string{"resource": "sync-district", "timeout": "60"},
+	}, ref)
+
+	t.Log("Values are escaped inside strings")
+	stateMachine, _, err = RenderWorkflowDefinitionTemplate(template, map[string]string{"resource": `sync"district`, "timeout": "300"})
+	require.NoError(t, err)
+	assert.Equal(t, `sync"district`, stateMachine.States["sync"].Resource)
+	assert.Equal(t, int64(300), stateMachine.States["sync"].TimeoutSeconds)
+
+	for _, params := range []map[string]string{
+		{},
+		{"resource": "sync-district", "unknown": "value"},
+		{"resource": "sync-district", "timeout": "soon"},
+	} {
+		_, _, err := RenderWorkflowDefinitionTemplate(template, params)
+		assert.IsType(t, models.BadRequest{}, err, "%v", params)
+	}
+}
@@ -30,6 +30,15 @@ func NewWorkflowDefinitionVersion(def *models.WorkflowDefinition, version int) *
 		CreatedAt:    strfmt.DateTime(time.Now()),
 		Manager:      def.Manager,
 		StateMachine: def.StateMachine,
+		InputSchema:  def.InputSchema,
+		DefaultInput: def.DefaultInput,
+		LintWarnings: def.LintWarnings,
+		Description:  def.Description,
+		Team:         def.Team,
+		Contact:      def.Contact,
+		Labels:       def.Labels,
+		Changelog:    def.Changelog,
+		Template:     def.Template,
 	}
 }
 
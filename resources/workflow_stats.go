@@ -0,0 +1,237 @@
+package resources
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// WorkflowStatsPeriod is the length of the periods that the workflows of a definition are counted
+// in, by their creation time. Time windows of WorkflowStats are rounded out to whole periods.
+const WorkflowStatsPeriod = time.Hour
+
+// durationBucketsPerDoubling is the number of duration histogram buckets between a duration and
+// twice that duration, so each bucket is 2^(1/4), or about 19%, wider than the previous one.
+const durationBucketsPerDoubling = 4
+
+// WorkflowStatsCounts counts the workflows of a definition created in one WorkflowStatsPeriod.
+// It also holds the change to those counts from saving, updating or deleting a workflow, in
+// which case the counts can be negative.
+type WorkflowStatsCounts struct {
+	WorkflowDefinitionName string
+	Period                 time.Time
+	// Statuses, ResolvedByUser and Versions count workflows by status, by whether they were
+	// resolved by a user ("true" or "false") and by definition version. VersionStatuses counts
+	// them by both version and status, keyed by VersionStatusKey.
+	Statuses        map[string]int64
+	ResolvedByUser  map[string]int64
+	Versions        map[string]int64
+	VersionStatuses map[string]int64
+	// AliasVersionStatuses counts the workflows started through an alias by alias, version and
+	// status, keyed by AliasVersionStatusKey, so that rollouts only compare the workflows that
+	// their alias split between versions.
+	AliasVersionStatuses map[string]int64
+	// Durations is a histogram of the times from creation to stopping of stopped workflows,
+	// keyed by DurationBucket.
+	Durations map[int]int64
+	// States counts the jobs of stopped workflows by state and definition version, keyed by
+	// StateStatsKey, and StateWorkflows is the number of workflows whose jobs are counted. They
+	// are added once per workflow, by StateStatsChange, rather than as workflows are written.
+	States         map[string]int64
+	StateWorkflows int64
+}
+
+// NewWorkflowStatsCounts returns empty counts for the workflows of a definition created in the
+// period starting at period.
+func NewWorkflowStatsCounts(workflowDefinitionName string, period time.Time) WorkflowStatsCounts {
+	return WorkflowStatsCounts{
+		WorkflowDefinitionName: workflowDefinitionName,
+		Period:                 period,
+		Statuses:               map[string]int64{},
+		ResolvedByUser:         map[string]int64{},
+		Versions:               map[string]int64{},
+		VersionStatuses:        map[string]int64{},
+		AliasVersionStatuses:   map[string]int64{},
+		Durations:              map[int]int64{},
+		States:                 map[string]int64{},
+	}
+}
+
+// VersionStatusKey returns the key of the count of workflows of a definition version with a status.
+func VersionStatusKey(version int64, status models.WorkflowStatus) string {
+	return fmt.Sprintf("%d:%s", version, status)
+}
+
+// AliasVersionStatusKey returns the key of the count of workflows started through an alias that
+// ran a definition version and have a status.
+func AliasVersionStatusKey(alias string, version int64, status models.WorkflowStatus) string {
+	return fmt.Sprintf("%s:%d:%s", alias, version, status)
+}
+
+// WorkflowStatsPeriodStart returns the start of the WorkflowStatsPeriod that t is in.
+func WorkflowStatsPeriodStart(t time.Time) time.Time {
+	return t.UTC().Truncate(WorkflowStatsPeriod)
+}
+
+// IsEmpty returns true if none of the counts are set.
+func (c WorkflowStatsCounts) IsEmpty() bool {
+	return len(c.Statuses) == 0 && len(c.ResolvedByUser) == 0 && len(c.Versions) == 0 &&
+		len(c.VersionStatuses) == 0 && len(c.AliasVersionStatuses) == 0 && len(c.Durations) == 0 && len(c.States) == 0 && c.StateWorkflows == 0
+}
+
+// Add adds other to the counts, dropping counts that become zero.
+func (c *WorkflowStatsCounts) Add(other WorkflowStatsCounts) {
+	addCounts(c.Statuses, other.Statuses)
+	addCounts(c.ResolvedByUser, other.ResolvedByUser)
+	addCounts(c.Versions, other.Versions)
+	addCounts(c.VersionStatuses, other.VersionStatuses)
+	addCounts(c.AliasVersionStatuses, other.AliasVersionStatuses)
+	addCounts(c.States, other.States)
+	c.StateWorkflows += other.StateWorkflows
+	for bucket, n := range other.Durations {
+		c.Durations[bucket] += n
+		if c.Durations[bucket] == 0 {
+			delete(c.Durations, bucket)
+		}
+	}
+}
+
+func addCounts(counts, other map[string]int64) {
+	for key, n := range other {
+		counts[key] += n
+		if counts[key] == 0 {
+			delete(counts, key)
+		}
+	}
+}
+
+// count adds n to the counts for a workflow.
+func (c *WorkflowStatsCounts) count(workflow *models.Workflow, n int64) {
+	c.Add(WorkflowStatsCounts{
+		Statuses:       map[string]int64{string(workflow.Status): n},
+		ResolvedByUser: map[string]int64{strconv.FormatBool(workflow.ResolvedByUser): n},
+		Versions:       map[string]int64{strconv.FormatInt(workflow.WorkflowDefinition.Version, 10): n},
+		VersionStatuses: map[string]int64{
+			VersionStatusKey(workflow.WorkflowDefinition.Version, workflow.Status): n,
+		},
+	})
+	if workflow.WorkflowDefinitionAlias != "" {
+		c.Add(WorkflowStatsCounts{AliasVersionStatuses: map[string]int64{
+			AliasVersionStatusKey(workflow.WorkflowDefinitionAlias, workflow.WorkflowDefinition.Version, workflow.Status): n,
+		}})
+	}
+	if workflowStopped(workflow) {
+		duration := time.Time(workflow.StoppedAt).Sub(time.Time(workflow.CreatedAt))
+		c.Add(WorkflowStatsCounts{Durations: map[int]int64{DurationBucket(duration): n}})
+	}
+}
+
+// workflowStopped only looks at the status of a workflow, unlike WorkflowIsDone, since the jobs of
+// the previous version of a workflow aren't always available to the store.
+func workflowStopped(workflow *models.Workflow) bool {
+	switch workflow.Status {
+	case models.WorkflowStatusCancelled, models.WorkflowStatusFailed, models.WorkflowStatusSucceeded:
+		return !time.Time(workflow.StoppedAt).IsZero()
+	}
+	return false
+}
+
+// WorkflowStatsChange returns the change to the counts of the definition of a workflow from
+// replacing previous with workflow in a store. previous is nil for a new workflow and workflow is
+// nil for a deleted one. It returns false if the counts don't change.
+func WorkflowStatsChange(previous, workflow *models.Workflow) (WorkflowStatsCounts, bool) {
+	current := workflow
+	if current == nil {
+		current = previous
+	}
+	if current == nil || current.WorkflowDefinition == nil {
+		return WorkflowStatsCounts{}, false
+	}
+
+	change := NewWorkflowStatsCounts(
+		current.WorkflowDefinition.Name,
+		WorkflowStatsPeriodStart(time.Time(current.CreatedAt)),
+	)
+	if previous != nil && previous.WorkflowDefinition != nil {
+		change.count(previous, -1)
+	}
+	if workflow != nil {
+		change.count(workflow, 1)
+	}
+	return change, !change.IsEmpty()
+}
+
+// DurationBucket returns the bucket of the duration histogram that a duration is counted in.
+// Durations under a second are counted in the first bucket.
+func DurationBucket(d time.Duration) int {
+	if d < time.Second {
+		return 0
+	}
+	return int(math.Floor(math.Log2(d.Seconds()) * durationBucketsPerDoubling))
+}
+
+// durationBucketSeconds returns the middle of a bucket of the duration histogram, in seconds.
+func durationBucketSeconds(bucket int) float64 {
+	seconds := math.Pow(2, (float64(bucket)+0.5)/durationBucketsPerDoubling)
+	return math.Round(seconds*1000) / 1000
+}
+
+// NewDurationPercentiles computes the percentiles of the durations in a duration histogram.
+func NewDurationPercentiles(histogram map[int]int64) *models.DurationPercentiles {
+	buckets := []int{}
+	var count int64
+	for bucket, n := range histogram {
+		if n > 0 {
+			buckets = append(buckets, bucket)
+			count += n
+		}
+	}
+	sort.Ints(buckets)
+
+	percentiles := &models.DurationPercentiles{Count: count}
+	if count == 0 {
+		return percentiles
+	}
+	percentile := func(p float64) float64 {
+		rank := int64(math.Ceil(p * float64(count)))
+		var seen int64
+		for _, bucket := range buckets {
+			seen += histogram[bucket]
+			if seen >= rank {
+				return durationBucketSeconds(bucket)
+			}
+		}
+		return durationBucketSeconds(buckets[len(buckets)-1])
+	}
+	percentiles.P50 = percentile(0.50)
+	percentiles.P95 = percentile(0.95)
+	percentiles.P99 = percentile(0.99)
+	return percentiles
+}
+
+// NewWorkflowStats sums the counts of the periods of a time window.
+func NewWorkflowStats(workflowDefinitionName string, createdAfter, createdBefore time.Time, periods []WorkflowStatsCounts) models.WorkflowStats {
+	total := NewWorkflowStatsCounts(workflowDefinitionName, createdAfter)
+	for _, period := range periods {
+		total.Add(period)
+	}
+
+	stats := models.WorkflowStats{
+		WorkflowDefinitionName: workflowDefinitionName,
+		CreatedAfter:           strfmt.DateTime(createdAfter),
+		CreatedBefore:          strfmt.DateTime(createdBefore),
+		StatusCounts:           total.Statuses,
+		ResolvedByUserCounts:   total.ResolvedByUser,
+		VersionCounts:          total.Versions,
+		Durations:              NewDurationPercentiles(total.Durations),
+	}
+	for _, n := range total.Statuses {
+		stats.Total += n
+	}
+	return stats
+}
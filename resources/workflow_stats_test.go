@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowStatsChange(t *testing.T) {
+	createdAt := time.Date(2018, 3, 1, 10, 30, 0, 0, time.UTC)
+	running := &models.Workflow{WorkflowSummary: models.WorkflowSummary{
+		CreatedAt:          strfmt.DateTime(createdAt),
+		Status:             models.WorkflowStatusRunning,
+		WorkflowDefinition: &models.WorkflowDefinition{Name: "wf", Version: 2},
+	}}
+
+	t.Log("New workflows are counted in the period they were created in")
+	change, ok := WorkflowStatsChange(nil, running)
+	assert.True(t, ok)
+	assert.Equal(t, WorkflowStatsCounts{
+		WorkflowDefinitionName: "wf",
+		Period:                 time.Date(2018, 3, 1, 10, 0, 0, 0, time.UTC),
+		Statuses:               map[string]int64{"running": 1},
+		ResolvedByUser:         map[string]int64{"false": 1},
+		Versions:               map[string]int64{"2": 1},
+		VersionStatuses:        map[string]int64{"2:running": 1},
+		AliasVersionStatuses:   map[string]int64{},
+		Durations:              map[int]int64{},
+		States:                 map[string]int64{},
+	}, change)
+
+	t.Log("Updates that don't change the status don't change the counts")
+	_, ok = WorkflowStatsChange(running, running)
+	assert.False(t, ok)
+
+	t.Log("Stopped workflows move to their new status and count their duration")
+	succeeded := CopyWorkflow(*running)
+	succeeded.Status = models.WorkflowStatusSucceeded
+	succeeded.ResolvedByUser = true
+	succeeded.StoppedAt = strfmt.DateTime(createdAt.Add(time.Minute))
+	change, ok = WorkflowStatsChange(running, &succeeded)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int64{"running": -1, "succeeded": 1}, change.Statuses)
+	assert.Equal(t, map[string]int64{"false": -1, "true": 1}, change.ResolvedByUser)
+	assert.Empty(t, change.Versions)
+	assert.Equal(t, map[string]int64{"2:running": -1, "2:succeeded": 1}, change.VersionStatuses)
+	assert.Equal(t, map[int]int64{DurationBucket(time.Minute): 1}, change.Durations)
+
+	t.Log("Workflows started through an alias are also counted by alias")
+	aliased := CopyWorkflow(succeeded)
+	aliased.WorkflowDefinitionAlias = "stable"
+	change, ok = WorkflowStatsChange(nil, &aliased)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int64{"stable:2:succeeded": 1}, change.AliasVersionStatuses)
+
+	t.Log("Deleted workflows are no longer counted")
+	change, ok = WorkflowStatsChange(&succeeded, nil)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int64{"succeeded": -1}, change.Statuses)
+	assert.Equal(t, map[int]int64{DurationBucket(time.Minute): -1}, change.Durations)
+}
+
+func TestNewDurationPercentiles(t *testing.T) {
+	assert.Equal(t, &models.DurationPercentiles{}, NewDurationPercentiles(map[int]int64{}))
+
+	histogram := map[int]int64{}
+	for i := 1; i <= 100; i++ {
+		histogram[DurationBucket(time.Duration(i)*time.Minute)]++
+	}
+	percentiles := NewDurationPercentiles(histogram)
+	assert.Equal(t, int64(100), percentiles.Count)
+	for _, p := range []struct {
+		expected time.Duration
+		actual   float64
+	}{
+		{50 * time.Minute, percentiles.P50},
+		{95 * time.Minute, percentiles.P95},
+		{99 * time.Minute, percentiles.P99},
+	} {
+		// buckets are 19% wide
+		assert.InEpsilon(t, p.expected.Seconds(), p.actual, 0.1)
+	}
+}
+
+func TestNewWorkflowStats(t *testing.T) {
+	from := time.Date(2018, 3, 1, 10, 0, 0, 0, time.UTC)
+	first := NewWorkflowStatsCounts("wf", from)
+	first.Statuses = map[string]int64{"running": 1, "failed": 2}
+	first.Versions = map[string]int64{"1": 3}
+	second := NewWorkflowStatsCounts("wf", from.Add(time.Hour))
+	second.Statuses = map[string]int64{"failed": 1}
+	second.Versions = map[string]int64{"2": 1}
+	second.Durations = map[int]int64{DurationBucket(time.Minute): 1}
+
+	stats := NewWorkflowStats("wf", from, from.Add(2*time.Hour), []WorkflowStatsCounts{first, second})
+	assert.Equal(t, int64(4), stats.Total)
+	assert.Equal(t, map[string]int64{"running": 1, "failed": 3}, stats.StatusCounts)
+	assert.Equal(t, map[string]int64{"1": 3, "2": 1}, stats.VersionCounts)
+	assert.Equal(t, int64(1), stats.Durations.Count)
+	assert.InEpsilon(t, 60, stats.Durations.P50, 0.1)
+}
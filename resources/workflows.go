@@ -21,6 +21,7 @@ func NewWorkflow(wfd *models.WorkflowDefinition, input string, namespace string,
 			Namespace:          namespace,
 			Queue:              queue,
 			Input:              input,
+			EffectiveInput:     input,
 			Tags:               tags,
 			ResolvedByUser:     false,
 			Retries:            []string{},
@@ -0,0 +1,12 @@
+package blob
+
+import "context"
+
+// Store persists blobs of data that are too large to keep in the main store, e.g. the jobs of
+// long-running workflows. Keys are slash-separated paths.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns a models.NotFound error if nothing is stored under the key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,52 @@
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/Clever/workflow-manager/store"
+)
+
+// FileSystem is a blob Store that keeps each blob in a file under a root directory.
+// It is meant for local development and tests.
+type FileSystem struct {
+	root string
+}
+
+// NewFileSystem creates a FileSystem blob Store rooted at the given directory.
+func NewFileSystem(root string) FileSystem {
+	return FileSystem{root: root}
+}
+
+func (f FileSystem) path(key string) string {
+	return filepath.Join(f.root, filepath.FromSlash(key))
+}
+
+// Put writes data to the file for key, overwriting any existing blob.
+func (f FileSystem) Put(ctx context.Context, key string, data []byte) error {
+	path := f.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Get reads the file for key.
+func (f FileSystem) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := ioutil.ReadFile(f.path(key))
+	if os.IsNotExist(err) {
+		return nil, store.NewNotFound(key)
+	}
+	return data, err
+}
+
+// Delete removes the file for key.
+func (f FileSystem) Delete(ctx context.Context, key string) error {
+	err := os.Remove(f.path(key))
+	if os.IsNotExist(err) {
+		return store.NewNotFound(key)
+	}
+	return err
+}
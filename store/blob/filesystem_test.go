@@ -0,0 +1,39 @@
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestFileSystem(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "workflow-manager-blobs")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	f := NewFileSystem(root)
+
+	_, err = f.Get(ctx, "workflows/id/jobs")
+	require.IsType(t, models.NotFound{}, err)
+
+	require.NoError(t, f.Put(ctx, "workflows/id/jobs", []byte(`[{"id":"job"}]`)))
+	data, err := f.Get(ctx, "workflows/id/jobs")
+	require.NoError(t, err)
+	require.Equal(t, `[{"id":"job"}]`, string(data))
+
+	t.Log("Put overwrites existing blobs")
+	require.NoError(t, f.Put(ctx, "workflows/id/jobs", []byte(`[]`)))
+	data, err = f.Get(ctx, "workflows/id/jobs")
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+
+	require.NoError(t, f.Delete(ctx, "workflows/id/jobs"))
+	_, err = f.Get(ctx, "workflows/id/jobs")
+	require.IsType(t, models.NotFound{}, err)
+	require.IsType(t, models.NotFound{}, f.Delete(ctx, "workflows/id/jobs"))
+}
@@ -0,0 +1,71 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/Clever/workflow-manager/store"
+)
+
+// S3 is a blob Store that keeps each blob in an object of an S3 bucket.
+type S3 struct {
+	s3api  s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3 creates an S3 blob Store. Objects are written to the bucket under the given key prefix.
+func NewS3(s3api s3iface.S3API, bucket, prefix string) S3 {
+	return S3{
+		s3api:  s3api,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (s S3) key(key string) *string {
+	return aws.String(path.Join(s.prefix, key))
+}
+
+// Put writes data to the object for key, overwriting any existing blob.
+func (s S3) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.s3api.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.key(key),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+// Get reads the object for key.
+func (s S3) Get(ctx context.Context, key string) ([]byte, error) {
+	out, err := s.s3api.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.key(key),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, store.NewNotFound(key)
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	return ioutil.ReadAll(out.Body)
+}
+
+// Delete removes the object for key.
+func (s S3) Delete(ctx context.Context, key string) error {
+	_, err := s.s3api.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.key(key),
+	})
+	return err
+}
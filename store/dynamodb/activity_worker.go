@@ -0,0 +1,87 @@
+package dynamodb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/go-openapi/strfmt"
+)
+
+// ActivityWorkerTTL is how long a worker is remembered after it was last seen.
+const ActivityWorkerTTL = 7 * 24 * time.Hour // 7 days
+
+// ddbActivityWorkerPrimaryKey represents the primary key of the activity-workers table.
+// The workers of a StateResource share a hash key so that they can be listed with one query.
+type ddbActivityWorkerPrimaryKey struct {
+	StateResource string `dynamodbav:"stateResource"`
+	Name          string `dynamodbav:"name"`
+}
+
+func activityWorkerStateResourceKey(namespace, stateResourceName string) string {
+	return fmt.Sprintf("%s--%s", namespace, stateResourceName)
+}
+
+func (pk ddbActivityWorkerPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("stateResource"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbActivityWorkerPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("stateResource"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// ddbActivityWorker stores the counts of a worker as top-level attributes so that they can be
+// incremented in place.
+type ddbActivityWorker struct {
+	ddbActivityWorkerPrimaryKey
+	Namespace         string    `dynamodbav:"namespace"`
+	StateResourceName string    `dynamodbav:"stateResourceName"`
+	LastSeen          time.Time `dynamodbav:"lastSeen,unixtime"`
+	Succeeded         int64     `dynamodbav:"succeeded"`
+	Failed            int64     `dynamodbav:"failed"`
+}
+
+// EncodeActivityWorkerKey encodes the primary key of an ActivityWorker into a dynamo attribute map
+func EncodeActivityWorkerKey(worker models.ActivityWorker) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbActivityWorkerPrimaryKey{
+		StateResource: activityWorkerStateResourceKey(worker.Namespace, worker.StateResourceName),
+		Name:          worker.Name,
+	})
+}
+
+// DecodeActivityWorker translates an ActivityWorker stored in dynamodb to an ActivityWorker object
+func DecodeActivityWorker(m map[string]*dynamodb.AttributeValue) (models.ActivityWorker, error) {
+	var res ddbActivityWorker
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.ActivityWorker{}, err
+	}
+	return models.ActivityWorker{
+		Namespace:         res.Namespace,
+		StateResourceName: res.StateResourceName,
+		Name:              res.Name,
+		LastSeen:          strfmt.DateTime(res.LastSeen),
+		Succeeded:         res.Succeeded,
+		Failed:            res.Failed,
+	}, nil
+}
@@ -2,13 +2,19 @@ package dynamodb
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
 	"github.com/Clever/workflow-manager/gen-go/server/db"
 	"github.com/Clever/workflow-manager/resources"
 	"github.com/Clever/workflow-manager/store"
+	"github.com/Clever/workflow-manager/store/blob"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
@@ -22,15 +28,31 @@ import (
 // AWS ValidationException message when item is > 400KB
 const errMessageItemTooLarge = "Item size has exceeded the maximum allowed size"
 
+// AWS ValidationException message when a query uses an index the table doesn't have
+const errMessageMissingIndex = "The table does not have the specified index"
+
+// maxItemSize is the largest item dynamo stores
+const maxItemSize = 400 * 1024
+
+// maxWorkflowQueryReads is the most queries that GetWorkflows makes to fill a page
+const maxWorkflowQueryReads = 10
+
 type DynamoDB struct {
 	ddb         dynamodbiface.DynamoDBAPI
 	tableConfig TableConfig
 
 	// Future is the autogenerated dynamo client, which is slowly introduced here
 	Future db.Interface
+
+	// Blobs stores the jobs, input and output of workflows that are too large for a dynamo item.
+	// If it is nil, the jobs of these workflows are dropped instead.
+	// Blobs are deleted along with their workflow, but not when dynamo expires the workflow, so
+	// the blob store should expire them after WorkflowTTL, e.g. with an S3 lifecycle rule.
+	Blobs blob.Store
 }
 
 type TableConfig struct {
+	PrefixNamespaceConfigs    string
 	PrefixStateResources      string
 	PrefixWorkflowDefinitions string
 	PrefixWorkflows           string
@@ -56,16 +78,52 @@ func (d DynamoDB) workflowDefinitionsTable() string {
 	return fmt.Sprintf("%s-workflow-definitions", d.tableConfig.PrefixWorkflowDefinitions)
 }
 
+// workflowDefinitionVersionsTable returns the name of the table that stores the last version number
+// given to every WorkflowDefinition
+func (d DynamoDB) workflowDefinitionVersionsTable() string {
+	return fmt.Sprintf("%s-workflow-definition-versions", d.tableConfig.PrefixWorkflowDefinitions)
+}
+
+// workflowDefinitionAliasesTable returns the name of the table that stores the aliases of WorkflowDefinitions
+func (d DynamoDB) workflowDefinitionAliasesTable() string {
+	return fmt.Sprintf("%s-workflow-definition-aliases", d.tableConfig.PrefixWorkflowDefinitions)
+}
+
+// workflowDefinitionTemplatesTable returns the name of the table that stores WorkflowDefinitionTemplates
+func (d DynamoDB) workflowDefinitionTemplatesTable() string {
+	return fmt.Sprintf("%s-workflow-definition-templates", d.tableConfig.PrefixWorkflowDefinitions)
+}
+
 // workflowsTable returns the name of the table that stores workflows.
 func (d DynamoDB) workflowsTable() string {
 	return fmt.Sprintf("%s-workflows", d.tableConfig.PrefixWorkflows)
 }
 
+// workflowStatsTable returns the name of the table that stores the counts of the workflows of each definition.
+func (d DynamoDB) workflowStatsTable() string {
+	return fmt.Sprintf("%s-workflow-stats", d.tableConfig.PrefixWorkflows)
+}
+
 // stateResourcesTable returns the name of the table that stores stateResources.
 func (d DynamoDB) stateResourcesTable() string {
 	return fmt.Sprintf("%s-state-resources", d.tableConfig.PrefixStateResources)
 }
 
+// activityWorkersTable returns the name of the table that stores the workers of activity stateResources.
+func (d DynamoDB) activityWorkersTable() string {
+	return fmt.Sprintf("%s-activity-workers", d.tableConfig.PrefixStateResources)
+}
+
+// namespacesTable returns the name of the table that stores registered namespaces.
+func (d DynamoDB) namespacesTable() string {
+	return fmt.Sprintf("%s-namespaces", d.tableConfig.PrefixNamespaceConfigs)
+}
+
+// namespaceConfigsTable returns the name of the table that stores namespaceConfigs.
+func (d DynamoDB) namespaceConfigsTable() string {
+	return fmt.Sprintf("%s-namespace-configs", d.tableConfig.PrefixNamespaceConfigs)
+}
+
 // dynamoItemsToWorkflowDefinitions takes the Items from a Query or Scan result and decodes it into an array of workflow definitions
 func (d DynamoDB) dynamoItemsToWorkflowDefinitions(items []map[string]*dynamodb.AttributeValue) ([]models.WorkflowDefinition, error) {
 	workflowDefinitions := []models.WorkflowDefinition{}
@@ -137,6 +195,19 @@ func (d DynamoDB) InitTables(ctx context.Context, setupWorkflowsTTL bool) error
 		return err
 	}
 
+	// create workflow-definition-versions table from name -> last version number
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkflowDefinitionVersions{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkflowDefinitionVersions{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowDefinitionVersionsTable()),
+	}); err != nil {
+		return err
+	}
+
 	// create workflows table from workflow ID -> workflow object
 	workflowAttributeDefinitions := []*dynamodb.AttributeDefinition{}
 	for _, ads := range [][]*dynamodb.AttributeDefinition{
@@ -144,38 +215,67 @@ func (d DynamoDB) InitTables(ctx context.Context, setupWorkflowsTTL bool) error
 		(ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{}.AttributeDefinitions()),
 		(ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt{}.AttributeDefinitions()),
 		(ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt{}.AttributeDefinitions()),
+		(ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{}.AttributeDefinitions()),
+		(ddbWorkflowSecondaryKeyNamespaceCreatedAt{}.AttributeDefinitions()),
 	} {
 		workflowAttributeDefinitions = append(workflowAttributeDefinitions, ads...)
 	}
 	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
-		AttributeDefinitions: workflowAttributeDefinitions,
-		KeySchema:            ddbWorkflowPrimaryKey{}.KeySchema(),
-		GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndex{
-			{
-				IndexName: aws.String(ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{}.Name()),
-				KeySchema: ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{}.KeySchema(),
-				Projection: &dynamodb.Projection{
-					ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
-				},
-				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
-					ReadCapacityUnits:  aws.Int64(1),
-					WriteCapacityUnits: aws.Int64(1),
-				},
+		AttributeDefinitions:   workflowAttributeDefinitions,
+		KeySchema:              ddbWorkflowPrimaryKey{}.KeySchema(),
+		GlobalSecondaryIndexes: workflowSecondaryIndexes(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowsTable()),
+	}); err != nil {
+		return err
+	}
+	if setupWorkflowsTTL {
+		if _, err := d.ddb.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(d.workflowsTable()),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: ddbWorkflowTTL{}.AttributeDefinition().AttributeName,
+				Enabled:       aws.Bool(true),
 			},
-			{
-				IndexName: aws.String(ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt{}.Name()),
-				KeySchema: ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt{}.KeySchema(),
-				Projection: &dynamodb.Projection{
-					ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
-				},
-				ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
-					ReadCapacityUnits:  aws.Int64(1),
-					WriteCapacityUnits: aws.Int64(1),
-				},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// create workflow-stats table from workflowDefinitionName, period -> workflow counts
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkflowStatsPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkflowStatsPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowStatsTable()),
+	}); err != nil {
+		return err
+	}
+	if setupWorkflowsTTL {
+		if _, err := d.ddb.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(d.workflowStatsTable()),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: ddbWorkflowTTL{}.AttributeDefinition().AttributeName,
+				Enabled:       aws.Bool(true),
 			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// create state-resources table from stateResource.{name, namespace} -> stateResource object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbStateResourcePrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbStateResourcePrimaryKey{}.KeySchema(),
+		GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndex{
 			{
-				IndexName: aws.String(ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt{}.Name()),
-				KeySchema: ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt{}.KeySchema(),
+				IndexName: aws.String(ddbStateResourceSecondaryKeyNamespaceName{}.Name()),
+				KeySchema: ddbStateResourceSecondaryKeyNamespaceName{}.KeySchema(),
 				Projection: &dynamodb.Projection{
 					ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
 				},
@@ -189,13 +289,26 @@ func (d DynamoDB) InitTables(ctx context.Context, setupWorkflowsTTL bool) error
 			ReadCapacityUnits:  aws.Int64(1),
 			WriteCapacityUnits: aws.Int64(1),
 		},
-		TableName: aws.String(d.workflowsTable()),
+		TableName: aws.String(d.stateResourcesTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create activity-workers table from stateResource, worker name -> activityWorker counts
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbActivityWorkerPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbActivityWorkerPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.activityWorkersTable()),
 	}); err != nil {
 		return err
 	}
 	if setupWorkflowsTTL {
 		if _, err := d.ddb.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
-			TableName: aws.String(d.workflowsTable()),
+			TableName: aws.String(d.activityWorkersTable()),
 			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
 				AttributeName: ddbWorkflowTTL{}.AttributeDefinition().AttributeName,
 				Enabled:       aws.Bool(true),
@@ -205,15 +318,54 @@ func (d DynamoDB) InitTables(ctx context.Context, setupWorkflowsTTL bool) error
 		}
 	}
 
-	// create state-resources table from stateResource.{name, namespace} -> stateResource object
+	// create namespaces table from name -> namespace object
 	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
-		AttributeDefinitions: ddbStateResourcePrimaryKey{}.AttributeDefinitions(),
-		KeySchema:            ddbStateResourcePrimaryKey{}.KeySchema(),
+		AttributeDefinitions: ddbNamespacePrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbNamespacePrimaryKey{}.KeySchema(),
 		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
 			ReadCapacityUnits:  aws.Int64(1),
 			WriteCapacityUnits: aws.Int64(1),
 		},
-		TableName: aws.String(d.stateResourcesTable()),
+		TableName: aws.String(d.namespacesTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create namespace-configs table from namespace -> namespaceConfig object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbNamespaceConfigPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbNamespaceConfigPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.namespaceConfigsTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create workflow-definition-aliases table from name, alias -> workflowDefinitionAlias object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkflowDefinitionAliasPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkflowDefinitionAliasPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create workflow-definition-templates table from name -> workflowDefinitionTemplate object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkflowDefinitionTemplatePrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkflowDefinitionTemplatePrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowDefinitionTemplatesTable()),
 	}); err != nil {
 		return err
 	}
@@ -221,8 +373,194 @@ func (d DynamoDB) InitTables(ctx context.Context, setupWorkflowsTTL bool) error
 	return nil
 }
 
+// workflowSecondaryIndexes are the global secondary indexes of the workflows table. InitTables
+// only declares them when it creates the table, so indexes added later have to be added to
+// existing tables separately; CheckWorkflowIndexes reports the ones that are missing.
+func workflowSecondaryIndexes() []*dynamodb.GlobalSecondaryIndex {
+	return []*dynamodb.GlobalSecondaryIndex{
+		{
+			IndexName: aws.String(ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{}.Name()),
+			KeySchema: ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{}.KeySchema(),
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(1),
+				WriteCapacityUnits: aws.Int64(1),
+			},
+		},
+		{
+			IndexName: aws.String(ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt{}.Name()),
+			KeySchema: ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt{}.KeySchema(),
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(1),
+				WriteCapacityUnits: aws.Int64(1),
+			},
+		},
+		{
+			IndexName: aws.String(ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt{}.Name()),
+			KeySchema: ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt{}.KeySchema(),
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(1),
+				WriteCapacityUnits: aws.Int64(1),
+			},
+		},
+		{
+			IndexName: aws.String(ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{}.Name()),
+			KeySchema: ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{}.KeySchema(),
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(1),
+				WriteCapacityUnits: aws.Int64(1),
+			},
+		},
+		{
+			IndexName: aws.String(ddbWorkflowSecondaryKeyNamespaceCreatedAt{}.Name()),
+			KeySchema: ddbWorkflowSecondaryKeyNamespaceCreatedAt{}.KeySchema(),
+			Projection: &dynamodb.Projection{
+				ProjectionType: aws.String(dynamodb.ProjectionTypeAll),
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(1),
+				WriteCapacityUnits: aws.Int64(1),
+			},
+		},
+	}
+}
+
+// CheckWorkflowIndexes returns an error naming the global secondary indexes of the workflows
+// table that it doesn't have, e.g. because they were added after the table was created.
+func (d DynamoDB) CheckWorkflowIndexes(ctx context.Context) error {
+	out, err := d.ddb.DescribeTableWithContext(ctx, &dynamodb.DescribeTableInput{
+		TableName: aws.String(d.workflowsTable()),
+	})
+	if err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for _, index := range out.Table.GlobalSecondaryIndexes {
+		existing[aws.StringValue(index.IndexName)] = true
+	}
+	missing := []string{}
+	for _, index := range workflowSecondaryIndexes() {
+		if !existing[aws.StringValue(index.IndexName)] {
+			missing = append(missing, aws.StringValue(index.IndexName))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("table %s is missing indexes %s", d.workflowsTable(), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// workflowBackfilledIndexKeys are the attributes of the keys of the indexes that were added to
+// the workflows table after it held workflows, which workflows written before then don't have.
+var workflowBackfilledIndexKeys = []string{
+	aws.StringValue(ddbWorkflowSecondaryKeyNamespaceCreatedAt{}.AttributeDefinitions()[0].AttributeName),
+	aws.StringValue(ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{}.AttributeDefinitions()[0].AttributeName),
+}
+
+// BackfillWorkflowIndexKeys sets the keys of the indexes in workflowBackfilledIndexKeys on the
+// workflows that don't have them, so that the indexes include workflows written before they were
+// added. It returns the number of workflows it updated.
+func (d DynamoDB) BackfillWorkflowIndexKeys(ctx context.Context) (int, error) {
+	names := map[string]*string{}
+	conditions := []string{}
+	for i, key := range workflowBackfilledIndexKeys {
+		name := fmt.Sprintf("#K%d", i)
+		names[name] = aws.String(key)
+		conditions = append(conditions, fmt.Sprintf("attribute_not_exists(%s)", name))
+	}
+
+	updated := 0
+	var updateErr error
+	err := d.ddb.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		TableName:                aws.String(d.workflowsTable()),
+		FilterExpression:         aws.String(strings.Join(conditions, " OR ")),
+		ExpressionAttributeNames: names,
+	}, func(out *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			ok, err := d.backfillWorkflowIndexKeys(ctx, item)
+			if err != nil {
+				updateErr = err
+				return false
+			}
+			if ok {
+				updated++
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return updated, err
+	}
+	return updated, updateErr
+}
+
+// backfillWorkflowIndexKeys sets the index keys that a workflow item is missing. Workflows that
+// were deleted or written again with their keys since they were read are left alone.
+func (d DynamoDB) backfillWorkflowIndexKeys(ctx context.Context, item map[string]*dynamodb.AttributeValue) (bool, error) {
+	workflow, err := DecodeWorkflow(item)
+	if err != nil {
+		return false, err
+	}
+	if workflow.WorkflowDefinition == nil {
+		return false, nil
+	}
+	encoded, err := EncodeWorkflow(workflow)
+	if err != nil {
+		return false, err
+	}
+
+	names := map[string]*string{"#ID": aws.String("id")}
+	values := map[string]*dynamodb.AttributeValue{}
+	sets := []string{}
+	conditions := []string{"attribute_exists(#ID)"}
+	for i, key := range workflowBackfilledIndexKeys {
+		// keys that are empty, e.g. the namespace of workflows started without one, are omitted
+		if item[key] != nil || encoded[key] == nil {
+			continue
+		}
+		name := fmt.Sprintf("#K%d", i)
+		value := fmt.Sprintf(":k%d", i)
+		names[name] = aws.String(key)
+		values[value] = encoded[key]
+		sets = append(sets, fmt.Sprintf("%s = %s", name, value))
+		conditions = append(conditions, fmt.Sprintf("attribute_not_exists(%s)", name))
+	}
+	if len(sets) == 0 {
+		return false, nil
+	}
+
+	if _, err := d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(d.workflowsTable()),
+		Key:                       map[string]*dynamodb.AttributeValue{"id": item["id"]},
+		UpdateExpression:          aws.String("SET " + strings.Join(sets, ", ")),
+		ConditionExpression:       aws.String(strings.Join(conditions, " AND ")),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	}); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return false, nil
+			}
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // SaveWorkflowDefinition saves a workflow definition.
-// If the workflow already exists, it will return a store.ConflictError.
+// If the workflow already exists, it will return a store.ConflictError, and if its version was given
+// out before, a models.Conflict.
 func (d DynamoDB) SaveWorkflowDefinition(ctx context.Context, def models.WorkflowDefinition) error {
 	def.CreatedAt = strfmt.DateTime(time.Now())
 
@@ -248,6 +586,17 @@ func (d DynamoDB) SaveWorkflowDefinition(ctx context.Context, def models.Workflo
 		}
 		return err
 	}
+	if err := d.reserveWorkflowDefinitionVersion(ctx, def.Name, def.Version); err != nil {
+		// the version was deleted, but its number was given out before
+		if delErr := d.Future.DeleteWorkflowDefinition(ctx, def.Name, def.Version); delErr != nil {
+			log.ErrorD("save-workflow-definition", logger.M{
+				"name":    def.Name,
+				"version": def.Version,
+				"error":   delErr.Error(),
+			})
+		}
+		return err
+	}
 	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
 		TableName: aws.String(d.latestWorkflowDefinitionsTable()),
 		Item:      data,
@@ -257,17 +606,26 @@ func (d DynamoDB) SaveWorkflowDefinition(ctx context.Context, def models.Workflo
 }
 
 // UpdateWorkflowDefinition updates an existing workflow definition.
-// The version will be set to the version following the latest definition.
+// The version will be set to the version following the last version given to the definition,
+// even if that version was deleted.
 // The workflow definition returned contains this new version number.
 func (d DynamoDB) UpdateWorkflowDefinition(ctx context.Context, def models.WorkflowDefinition) (models.WorkflowDefinition, error) {
-	// TODO we should change this to use the latest-workflows table with a projection expressions
-	latest, err := d.LatestWorkflowDefinition(ctx, def.Name) // TODO: only need version here, can optimize query
+	lastVersion, ok, err := d.lastWorkflowDefinitionVersion(ctx, def.Name)
 	if err != nil {
 		return def, err
 	}
+	// definitions saved before version numbers were recorded only have their latest version
+	latest, err := d.LatestWorkflowDefinition(ctx, def.Name)
+	if err == nil {
+		if !ok || latest.Version > lastVersion {
+			lastVersion = latest.Version
+		}
+	} else if _, notFound := err.(models.NotFound); !notFound || !ok {
+		return def, err
+	}
 
-	// TODO: this isn't thread safe...
-	newVersion := resources.NewWorkflowDefinitionVersion(&def, int(latest.Version+1))
+	// concurrent updates that pick the same version conflict when reserving it
+	newVersion := resources.NewWorkflowDefinitionVersion(&def, int(lastVersion+1))
 	if err := d.SaveWorkflowDefinition(ctx, *newVersion); err != nil {
 		return def, err
 	}
@@ -276,7 +634,8 @@ func (d DynamoDB) UpdateWorkflowDefinition(ctx context.Context, def models.Workf
 	return d.GetWorkflowDefinition(ctx, newVersion.Name, int(newVersion.Version))
 }
 
-// GetWorkflowDefinitions returns the latest version of all stored workflow definitions
+// GetWorkflowDefinitions returns the latest version of all stored workflow definitions that
+// aren't archived
 func (d DynamoDB) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
 	// Scan returns the entire table
 	results, err := d.ddb.ScanWithContext(ctx, &dynamodb.ScanInput{
@@ -286,7 +645,18 @@ func (d DynamoDB) GetWorkflowDefinitions(ctx context.Context) ([]models.Workflow
 	if err != nil {
 		return []models.WorkflowDefinition{}, err
 	}
-	return d.dynamoItemsToWorkflowDefinitions(results.Items)
+	defs, err := d.dynamoItemsToWorkflowDefinitions(results.Items)
+	if err != nil {
+		return []models.WorkflowDefinition{}, err
+	}
+
+	workflowDefinitions := []models.WorkflowDefinition{}
+	for _, def := range defs {
+		if !def.Archived {
+			workflowDefinitions = append(workflowDefinitions, def)
+		}
+	}
+	return workflowDefinitions, nil
 }
 
 // GetWorkflowDefinitionVersions gets all versions of a workflow definition
@@ -354,77 +724,805 @@ func (d DynamoDB) LatestWorkflowDefinition(ctx context.Context, name string) (mo
 	return wf, nil
 }
 
-// SaveStateResource creates or updates a StateResource in dynamo
-// always overwrite old resource in store
-func (d DynamoDB) SaveStateResource(ctx context.Context, stateResource models.StateResource) error {
-	data, err := EncodeStateResource(stateResource)
+// ArchiveWorkflowDefinition archives or restores every version of a workflow definition,
+// including the copy in the latest workflow definitions table.
+func (d DynamoDB) ArchiveWorkflowDefinition(ctx context.Context, name string, archived bool) error {
+	versions, err := d.GetWorkflowDefinitionVersions(ctx, name)
 	if err != nil {
 		return err
 	}
 
-	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
-		TableName: aws.String(d.stateResourcesTable()),
-		Item:      data,
-	})
-
-	return err
+	var archivedAt *strfmt.DateTime
+	if archived {
+		now := strfmt.DateTime(time.Now())
+		archivedAt = &now
+	}
+	latest := versions[0]
+	for _, def := range versions {
+		def.Archived = archived
+		def.ArchivedAt = archivedAt
+		if err := d.putWorkflowDefinition(ctx, d.workflowDefinitionsTable(), def); err != nil {
+			return err
+		}
+		if def.Version >= latest.Version {
+			latest = def
+		}
+	}
+	return d.putWorkflowDefinition(ctx, d.latestWorkflowDefinitionsTable(), latest)
 }
 
-// GetStateResource gets the StateResource matching the name and namespace.
-func (d DynamoDB) GetStateResource(ctx context.Context, name, namespace string) (models.StateResource, error) {
-	key, err := dynamodbattribute.MarshalMap(ddbStateResourcePrimaryKey{
-		Name:      name,
-		Namespace: namespace,
-	})
-	if err != nil {
-		return models.StateResource{}, err
+// DeleteWorkflowDefinition deletes a version of a workflow definition that no workflows use. The
+// latest workflow definitions table then points at the highest remaining version, or forgets the
+// definition once no versions are left. Its version number isn't given out again.
+func (d DynamoDB) DeleteWorkflowDefinition(ctx context.Context, name string, version int) error {
+	if _, err := d.GetWorkflowDefinition(ctx, name, version); err != nil {
+		return err
 	}
-	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
-		Key:            key,
-		TableName:      aws.String(d.stateResourcesTable()),
-		ConsistentRead: aws.Bool(true),
-	})
+	inUse, err := d.workflowsUseDefinition(ctx, name, version)
 	if err != nil {
-		return models.StateResource{}, err
+		return err
+	}
+	if inUse {
+		return store.NewInUse(name, version)
 	}
 
-	if len(res.Item) == 0 {
-		return models.StateResource{}, store.NewNotFound(fmt.Sprintf("%s--%s", namespace, name))
+	// record the version of definitions saved before version numbers were, so it isn't reused
+	if err := d.reserveWorkflowDefinitionVersion(ctx, name, int64(version)); err != nil {
+		if _, ok := err.(models.Conflict); !ok {
+			return err
+		}
+	}
+	if err := d.Future.DeleteWorkflowDefinition(ctx, name, int64(version)); err != nil {
+		return err
 	}
 
-	stateResource, err := DecodeStateResource(res.Item)
-	if err != nil {
-		return models.StateResource{}, err
+	versions, err := d.GetWorkflowDefinitionVersions(ctx, name)
+	if _, ok := err.(models.NotFound); ok {
+		key, err := dynamodbattribute.MarshalMap(map[string]string{"name": name})
+		if err != nil {
+			return err
+		}
+		_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+			Key:       key,
+			TableName: aws.String(d.latestWorkflowDefinitionsTable()),
+		})
+		return err
+	} else if err != nil {
+		return err
 	}
 
-	return stateResource, nil
+	latest := versions[0]
+	for _, def := range versions {
+		if def.Version > latest.Version {
+			latest = def
+		}
+	}
+	return d.putWorkflowDefinition(ctx, d.latestWorkflowDefinitionsTable(), latest)
 }
 
-// DeleteStateResource removes an existing StateResource matching the name and namespace
-func (d DynamoDB) DeleteStateResource(ctx context.Context, name, namespace string) error {
-	// TODO: maybe we want to mark for deletion instead?
+// reserveWorkflowDefinitionVersion records version as the last version given to a workflow
+// definition. It returns a models.Conflict if this or a later version was given out before.
+func (d DynamoDB) reserveWorkflowDefinitionVersion(ctx context.Context, name string, version int64) error {
+	_, err := d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(d.workflowDefinitionVersionsTable()),
+		Key: map[string]*dynamodb.AttributeValue{
+			"name": &dynamodb.AttributeValue{S: aws.String(name)},
+		},
+		ExpressionAttributeNames: map[string]*string{
+			"#L": aws.String("lastVersion"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":version": &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(version, 10))},
+		},
+		UpdateExpression:    aws.String("SET #L = :version"),
+		ConditionExpression: aws.String("attribute_not_exists(#L) OR #L < :version"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewVersionUsed(name, version)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// lastWorkflowDefinitionVersion returns the last version given to a workflow definition, and
+// whether one was recorded.
+func (d DynamoDB) lastWorkflowDefinitionVersion(ctx context.Context, name string) (int64, bool, error) {
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(d.workflowDefinitionVersionsTable()),
+		Key: map[string]*dynamodb.AttributeValue{
+			"name": &dynamodb.AttributeValue{S: aws.String(name)},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if len(res.Item) == 0 {
+		return 0, false, nil
+	}
+	var versions ddbWorkflowDefinitionVersions
+	if err := dynamodbattribute.UnmarshalMap(res.Item, &versions); err != nil {
+		return 0, false, err
+	}
+	return versions.LastVersion, true, nil
+}
+
+// workflowsUseDefinition checks whether any workflow was started with a version of a workflow
+// definition.
+func (d DynamoDB) workflowsUseDefinition(ctx context.Context, name string, version int) (bool, error) {
+	query, err := ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{
+		WorkflowDefinitionName: name,
+	}.ConstructQuery(false)
+	if err != nil {
+		return false, err
+	}
+	query.TableName = aws.String(d.workflowsTable())
+	query.ProjectionExpression = aws.String("#ID")
+	query.FilterExpression = aws.String("#WF.#WD.#V = :version")
+	query.ExpressionAttributeNames["#ID"] = aws.String("id")
+	query.ExpressionAttributeNames["#WF"] = aws.String("Workflow")
+	query.ExpressionAttributeNames["#WD"] = aws.String("workflowDefinition")
+	query.ExpressionAttributeNames["#V"] = aws.String("version")
+	query.ExpressionAttributeValues[":version"] = &dynamodb.AttributeValue{
+		N: aws.String(fmt.Sprintf("%d", version)),
+	}
+
+	inUse := false
+	err = d.ddb.QueryPagesWithContext(ctx, query, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		inUse = len(page.Items) > 0
+		return !inUse
+	})
+	return inUse, err
+}
+
+// putWorkflowDefinition overwrites a workflow definition in one of the workflow definition tables.
+func (d DynamoDB) putWorkflowDefinition(ctx context.Context, table string, def models.WorkflowDefinition) error {
+	data, err := EncodeWorkflowDefinition(def)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item:      data,
+	})
+	return err
+}
+
+// SaveWorkflowDefinitionAlias creates or updates an alias of a workflow definition in dynamo
+func (d DynamoDB) SaveWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error {
+	alias.LastUpdated = strfmt.DateTime(time.Now())
+
+	data, err := EncodeWorkflowDefinitionAlias(alias)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		Item:      data,
+	})
+
+	return err
+}
+
+// UpdateWorkflowDefinitionAlias saves an alias of a workflow definition if it wasn't saved again
+// since it was read, which is checked on the time it was last updated.
+func (d DynamoDB) UpdateWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error {
+	readAt := time.Time(alias.LastUpdated).UnixNano()
+	alias.LastUpdated = strfmt.DateTime(time.Now())
+
+	data, err := EncodeWorkflowDefinitionAlias(alias)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		Item:      data,
+		ExpressionAttributeNames: map[string]*string{
+			"#A": aws.String("alias"),
+			"#U": aws.String("updatedAt"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":readAt": &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(readAt, 10))},
+		},
+		// aliases saved before updatedAt was added can be updated once without the check
+		ConditionExpression: aws.String("attribute_exists(#A) AND (attribute_not_exists(#U) OR #U = :readAt)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewAliasChanged(alias.Name, alias.Alias)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetWorkflowDefinitionAlias gets an alias of a workflow definition.
+func (d DynamoDB) GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionAliasPrimaryKey{
+		Name:  name,
+		Alias: alias,
+	})
+	if err != nil {
+		return models.WorkflowDefinitionAlias{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.workflowDefinitionAliasesTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.WorkflowDefinitionAlias{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.WorkflowDefinitionAlias{}, store.NewNotFound(fmt.Sprintf("%s alias %s", name, alias))
+	}
+
+	return DecodeWorkflowDefinitionAlias(res.Item)
+}
+
+// GetWorkflowDefinitionAliases returns all aliases of a workflow definition.
+func (d DynamoDB) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	aliases := []models.WorkflowDefinitionAlias{}
+	var decodeErr error
+	err := d.ddb.QueryPagesWithContext(ctx, &dynamodb.QueryInput{
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#N": aws.String("name"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":name": &dynamodb.AttributeValue{
+				S: aws.String(name),
+			},
+		},
+		KeyConditionExpression: aws.String("#N = :name"),
+		ConsistentRead:         aws.Bool(true),
+	}, func(out *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			alias, err := DecodeWorkflowDefinitionAlias(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			aliases = append(aliases, alias)
+		}
+		return true
+	})
+	if err != nil {
+		return []models.WorkflowDefinitionAlias{}, err
+	}
+	if decodeErr != nil {
+		return []models.WorkflowDefinitionAlias{}, decodeErr
+	}
+
+	return aliases, nil
+}
+
+// DeleteWorkflowDefinitionAlias removes an alias of a workflow definition
+func (d DynamoDB) DeleteWorkflowDefinitionAlias(ctx context.Context, name, alias string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionAliasPrimaryKey{
+		Name:  name,
+		Alias: alias,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		Key:       key,
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#A": aws.String("alias"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#A)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(fmt.Sprintf("%s alias %s", name, alias))
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SaveWorkflowDefinitionTemplate creates or updates a WorkflowDefinitionTemplate in dynamo
+func (d DynamoDB) SaveWorkflowDefinitionTemplate(ctx context.Context, template models.WorkflowDefinitionTemplate) error {
+	template.LastUpdated = strfmt.DateTime(time.Now())
+
+	data, err := EncodeWorkflowDefinitionTemplate(template)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workflowDefinitionTemplatesTable()),
+		Item:      data,
+	})
+
+	return err
+}
+
+// GetWorkflowDefinitionTemplate gets a WorkflowDefinitionTemplate by name.
+func (d DynamoDB) GetWorkflowDefinitionTemplate(ctx context.Context, name string) (models.WorkflowDefinitionTemplate, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionTemplatePrimaryKey{
+		Name: name,
+	})
+	if err != nil {
+		return models.WorkflowDefinitionTemplate{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.workflowDefinitionTemplatesTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.WorkflowDefinitionTemplate{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.WorkflowDefinitionTemplate{}, store.NewNotFound(fmt.Sprintf("template %s", name))
+	}
+
+	return DecodeWorkflowDefinitionTemplate(res.Item)
+}
+
+// GetWorkflowDefinitionTemplates returns all WorkflowDefinitionTemplates.
+func (d DynamoDB) GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error) {
+	templates := []models.WorkflowDefinitionTemplate{}
+	var decodeErr error
+	err := d.ddb.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(d.workflowDefinitionTemplatesTable()),
+	}, func(out *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			template, err := DecodeWorkflowDefinitionTemplate(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			templates = append(templates, template)
+		}
+		return true
+	})
+	if err != nil {
+		return []models.WorkflowDefinitionTemplate{}, err
+	}
+	if decodeErr != nil {
+		return []models.WorkflowDefinitionTemplate{}, decodeErr
+	}
+
+	return templates, nil
+}
+
+// SaveStateResource creates or updates a StateResource in dynamo
+// always overwrite old resource in store
+func (d DynamoDB) SaveStateResource(ctx context.Context, stateResource models.StateResource) error {
+	data, err := EncodeStateResource(stateResource)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.stateResourcesTable()),
+		Item:      data,
+	})
+
+	return err
+}
+
+// GetStateResource gets the StateResource matching the name and namespace.
+func (d DynamoDB) GetStateResource(ctx context.Context, name, namespace string) (models.StateResource, error) {
 	key, err := dynamodbattribute.MarshalMap(ddbStateResourcePrimaryKey{
 		Name:      name,
 		Namespace: namespace,
 	})
+	if err != nil {
+		return models.StateResource{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.stateResourcesTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.StateResource{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.StateResource{}, store.NewNotFound(fmt.Sprintf("%s--%s", namespace, name))
+	}
+
+	stateResource, err := DecodeStateResource(res.Item)
+	if err != nil {
+		return models.StateResource{}, err
+	}
+
+	return stateResource, nil
+}
+
+// GetStateResources returns a page of StateResources, only those of a namespace if it isn't empty.
+func (d DynamoDB) GetStateResources(ctx context.Context, namespace string, limit int64, pageToken string) ([]models.StateResource, string, error) {
+	stateResources := []models.StateResource{}
+	nextPageToken := ""
+
+	pageKey, err := ParsePageKey(pageToken)
+	if err != nil {
+		return stateResources, nextPageToken, store.NewInvalidPageTokenError(err)
+	}
+	var startKey map[string]*dynamodb.AttributeValue
+	if pageKey != nil {
+		startKey = map[string]*dynamodb.AttributeValue(*pageKey)
+	}
+
+	var items []map[string]*dynamodb.AttributeValue
+	var lastEvaluatedKey map[string]*dynamodb.AttributeValue
+	if namespace != "" {
+		query := ddbStateResourceSecondaryKeyNamespaceName{}.ConstructQuery(namespace)
+		query.TableName = aws.String(d.stateResourcesTable())
+		query.Limit = aws.Int64(limit)
+		query.ExclusiveStartKey = startKey
+		res, err := d.ddb.QueryWithContext(ctx, query)
+		if err != nil {
+			return stateResources, nextPageToken, err
+		}
+		items, lastEvaluatedKey = res.Items, res.LastEvaluatedKey
+	} else {
+		res, err := d.ddb.ScanWithContext(ctx, &dynamodb.ScanInput{
+			TableName:         aws.String(d.stateResourcesTable()),
+			Limit:             aws.Int64(limit),
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return stateResources, nextPageToken, err
+		}
+		items, lastEvaluatedKey = res.Items, res.LastEvaluatedKey
+	}
+
+	for _, item := range items {
+		stateResource, err := DecodeStateResource(item)
+		if err != nil {
+			return stateResources, nextPageToken, err
+		}
+		stateResources = append(stateResources, stateResource)
+	}
+
+	nextPageKey := NewPageKey(lastEvaluatedKey)
+	if nextPageKey != nil {
+		nextPageToken, err = nextPageKey.ToJSON()
+		if err != nil {
+			return stateResources, nextPageToken, err
+		}
+	}
+
+	return stateResources, nextPageToken, nil
+}
+
+// DeleteStateResource removes an existing StateResource matching the name and namespace
+func (d DynamoDB) DeleteStateResource(ctx context.Context, name, namespace string) error {
+	// TODO: maybe we want to mark for deletion instead?
+	key, err := dynamodbattribute.MarshalMap(ddbStateResourcePrimaryKey{
+		Name:      name,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		Key:       key,
+		TableName: aws.String(d.stateResourcesTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#N": aws.String("name"),
+			"#S": aws.String("namespace"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#N) AND attribute_exists(#S)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(
+					fmt.Sprintf("name: %s, namespace: %s", name, namespace))
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RecordActivityWorker adds the task counts of a worker to those already recorded for it, and
+// moves its lastSeen time forward.
+func (d DynamoDB) RecordActivityWorker(ctx context.Context, worker models.ActivityWorker) error {
+	key, err := EncodeActivityWorkerKey(worker)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(d.activityWorkersTable()),
+		Key:       key,
+		ExpressionAttributeNames: map[string]*string{
+			"#NS": aws.String("namespace"),
+			"#SR": aws.String("stateResourceName"),
+			"#S":  aws.String("succeeded"),
+			"#F":  aws.String("failed"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":namespace":         &dynamodb.AttributeValue{S: aws.String(worker.Namespace)},
+			":stateResourceName": &dynamodb.AttributeValue{S: aws.String(worker.StateResourceName)},
+			":succeeded":         &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(worker.Succeeded, 10))},
+			":failed":            &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(worker.Failed, 10))},
+		},
+		UpdateExpression: aws.String("SET #NS = :namespace, #SR = :stateResourceName ADD #S :succeeded, #F :failed"),
+	})
+	if err != nil {
+		return err
+	}
+
+	// workflows aren't refreshed in the order their tasks ran in, so only move lastSeen forward
+	lastSeen := time.Time(worker.LastSeen)
+	_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(d.activityWorkersTable()),
+		Key:       key,
+		ExpressionAttributeNames: map[string]*string{
+			"#L": aws.String("lastSeen"),
+			"#T": ddbWorkflowTTL{}.AttributeDefinition().AttributeName,
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":lastSeen": &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(lastSeen.Unix(), 10))},
+			":ttl":      &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(lastSeen.Add(ActivityWorkerTTL).Unix(), 10))},
+		},
+		UpdateExpression:    aws.String("SET #L = :lastSeen, #T = :ttl"),
+		ConditionExpression: aws.String("attribute_not_exists(#L) OR #L < :lastSeen"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// GetActivityWorkers returns the workers of an activity stateResource, most recently seen first
+func (d DynamoDB) GetActivityWorkers(ctx context.Context, namespace, stateResourceName string) ([]models.ActivityWorker, error) {
+	workers := []models.ActivityWorker{}
+	var decodeErr error
+	err := d.ddb.QueryPagesWithContext(ctx, &dynamodb.QueryInput{
+		TableName: aws.String(d.activityWorkersTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#SR": aws.String("stateResource"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":stateResource": &dynamodb.AttributeValue{
+				S: aws.String(activityWorkerStateResourceKey(namespace, stateResourceName)),
+			},
+		},
+		KeyConditionExpression: aws.String("#SR = :stateResource"),
+	}, func(out *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			worker, err := DecodeActivityWorker(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			workers = append(workers, worker)
+		}
+		return true
+	})
+	if err != nil {
+		return []models.ActivityWorker{}, err
+	}
+	if decodeErr != nil {
+		return []models.ActivityWorker{}, decodeErr
+	}
+
+	sort.Sort(store.ByLastSeen(workers))
+	return workers, nil
+}
+
+// SaveNamespace registers or updates a Namespace in dynamo
+func (d DynamoDB) SaveNamespace(ctx context.Context, namespace models.Namespace) error {
+	namespace.LastUpdated = strfmt.DateTime(time.Now())
+
+	data, err := EncodeNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.namespacesTable()),
+		Item:      data,
+	})
+
+	return err
+}
+
+// GetNamespace gets a registered Namespace.
+func (d DynamoDB) GetNamespace(ctx context.Context, name string) (models.Namespace, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbNamespacePrimaryKey{
+		Name: name,
+	})
+	if err != nil {
+		return models.Namespace{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.namespacesTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.Namespace{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.Namespace{}, store.NewNotFound(fmt.Sprintf("namespace %s", name))
+	}
+
+	return DecodeNamespace(res.Item)
+}
+
+// GetNamespaces returns all registered Namespaces.
+func (d DynamoDB) GetNamespaces(ctx context.Context) ([]models.Namespace, error) {
+	namespaces := []models.Namespace{}
+	var decodeErr error
+	err := d.ddb.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(d.namespacesTable()),
+	}, func(out *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			namespace, err := DecodeNamespace(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			namespaces = append(namespaces, namespace)
+		}
+		return true
+	})
+	if err != nil {
+		return []models.Namespace{}, err
+	}
+	if decodeErr != nil {
+		return []models.Namespace{}, decodeErr
+	}
+
+	return namespaces, nil
+}
+
+// DeleteNamespace removes a registered Namespace
+func (d DynamoDB) DeleteNamespace(ctx context.Context, name string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbNamespacePrimaryKey{
+		Name: name,
+	})
 	if err != nil {
 		return err
 	}
 
 	_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
 		Key:       key,
-		TableName: aws.String(d.stateResourcesTable()),
+		TableName: aws.String(d.namespacesTable()),
 		ExpressionAttributeNames: map[string]*string{
 			"#N": aws.String("name"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#N)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(fmt.Sprintf("namespace %s", name))
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SaveNamespaceConfig creates or updates the NamespaceConfig of a namespace in dynamo
+func (d DynamoDB) SaveNamespaceConfig(ctx context.Context, config models.NamespaceConfig) error {
+	config.LastUpdated = strfmt.DateTime(time.Now())
+
+	data, err := EncodeNamespaceConfig(config)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.namespaceConfigsTable()),
+		Item:      data,
+	})
+
+	return err
+}
+
+// GetNamespaceConfig gets the NamespaceConfig of a namespace.
+func (d DynamoDB) GetNamespaceConfig(ctx context.Context, namespace string) (models.NamespaceConfig, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbNamespaceConfigPrimaryKey{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return models.NamespaceConfig{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.namespaceConfigsTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.NamespaceConfig{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.NamespaceConfig{}, store.NewNotFound(namespace)
+	}
+
+	return DecodeNamespaceConfig(res.Item)
+}
+
+// GetNamespaceConfigs returns the NamespaceConfigs of all namespaces.
+func (d DynamoDB) GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error) {
+	configs := []models.NamespaceConfig{}
+	var decodeErr error
+	err := d.ddb.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(d.namespaceConfigsTable()),
+	}, func(out *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			config, err := DecodeNamespaceConfig(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			configs = append(configs, config)
+		}
+		return true
+	})
+	if err != nil {
+		return []models.NamespaceConfig{}, err
+	}
+	if decodeErr != nil {
+		return []models.NamespaceConfig{}, decodeErr
+	}
+
+	return configs, nil
+}
+
+// DeleteNamespaceConfig removes the NamespaceConfig of a namespace
+func (d DynamoDB) DeleteNamespaceConfig(ctx context.Context, namespace string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbNamespaceConfigPrimaryKey{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		Key:       key,
+		TableName: aws.String(d.namespaceConfigsTable()),
+		ExpressionAttributeNames: map[string]*string{
 			"#S": aws.String("namespace"),
 		},
-		ConditionExpression: aws.String("attribute_exists(#N) AND attribute_exists(#S)"),
+		ConditionExpression: aws.String("attribute_exists(#S)"),
 	})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
-				return store.NewNotFound(
-					fmt.Sprintf("name: %s, namespace: %s", name, namespace))
+				return store.NewNotFound(namespace)
 			}
 		}
 		return err
@@ -456,8 +1554,10 @@ func (d DynamoDB) SaveWorkflow(ctx context.Context, workflow models.Workflow) er
 				return store.NewConflict(workflow.ID)
 			}
 		}
+		return err
 	}
-	return err
+	d.recordWorkflowStats(ctx, nil, &workflow)
+	return nil
 }
 
 func (d DynamoDB) UpdateWorkflow(ctx context.Context, workflow models.Workflow) error {
@@ -467,13 +1567,18 @@ func (d DynamoDB) UpdateWorkflow(ctx context.Context, workflow models.Workflow)
 	if err != nil {
 		return err
 	}
-	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+	// offload workflows that won't fit rather than waiting for dynamo to reject them
+	if d.Blobs != nil && itemSize(data) > maxItemSize {
+		return d.updateOffloadedWorkflow(ctx, workflow)
+	}
+	out, err := d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
 		TableName: aws.String(d.workflowsTable()),
 		Item:      data,
 		ExpressionAttributeNames: map[string]*string{
 			"#I": aws.String("id"),
 		},
 		ConditionExpression: aws.String("attribute_exists(#I)"),
+		ReturnValues:        aws.String(dynamodb.ReturnValueAllOld),
 	})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -488,21 +1593,250 @@ func (d DynamoDB) UpdateWorkflow(ctx context.Context, workflow models.Workflow)
 						"name":      workflow.WorkflowDefinition.Name,
 						"namespace": workflow.Namespace,
 					})
-					// try again without jobs
+					if d.Blobs != nil {
+						return d.updateOffloadedWorkflow(ctx, workflow)
+					}
+					// try again with shorter failure causes, and then without jobs
 					wfCopy := resources.CopyWorkflow(workflow)
-					wfCopy.Jobs = nil
+					if !truncateJobCauses(&wfCopy) {
+						wfCopy.Jobs = nil
+					}
 					return d.UpdateWorkflow(ctx, wfCopy)
 				}
 			}
 		}
+		return err
 	}
-	return err
+	d.deleteReplacedWorkflowBlobs(ctx, out.Attributes, nil)
+	d.recordReplacedWorkflowStats(ctx, out.Attributes, &workflow)
+	return nil
+}
+
+// updateOffloadedWorkflow writes the jobs, input and output of a workflow to the blob store
+// and saves the rest of the workflow to dynamo with references to them. Blobs are keyed by
+// their content, so parts that haven't changed since the last update aren't written again.
+func (d DynamoDB) updateOffloadedWorkflow(ctx context.Context, workflow models.Workflow) error {
+	previous, err := d.workflowBlobKeys(ctx, workflow.ID)
+	if err != nil {
+		return err
+	}
+	put := func(part string, data []byte, previousKey string) (string, error) {
+		key := workflowBlobKey(workflow.ID, part, data)
+		if key == previousKey {
+			return key, nil
+		}
+		return key, d.Blobs.Put(ctx, key, data)
+	}
+
+	wfCopy := resources.CopyWorkflow(workflow)
+	blobKeys := &ddbWorkflowBlobKeys{}
+	if len(wfCopy.Jobs) > 0 {
+		jobs, err := json.Marshal(wfCopy.Jobs)
+		if err != nil {
+			return err
+		}
+		if blobKeys.Jobs, err = put("jobs", jobs, previous.Jobs); err != nil {
+			return err
+		}
+		wfCopy.Jobs = nil
+	}
+	if wfCopy.Input != "" {
+		if blobKeys.Input, err = put("input", []byte(wfCopy.Input), previous.Input); err != nil {
+			return err
+		}
+		wfCopy.Input = ""
+	}
+	if wfCopy.EffectiveInput != "" {
+		if blobKeys.EffectiveInput, err = put("effective-input", []byte(wfCopy.EffectiveInput), previous.EffectiveInput); err != nil {
+			return err
+		}
+		wfCopy.EffectiveInput = ""
+	}
+	if wfCopy.Output != "" {
+		if blobKeys.Output, err = put("output", []byte(wfCopy.Output), previous.Output); err != nil {
+			return err
+		}
+		wfCopy.Output = ""
+	}
+
+	data, err := encodeWorkflowWithBlobKeys(wfCopy, blobKeys)
+	if err != nil {
+		return err
+	}
+	out, err := d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workflowsTable()),
+		Item:      data,
+		ExpressionAttributeNames: map[string]*string{
+			"#I": aws.String("id"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#I)"),
+		ReturnValues:        aws.String(dynamodb.ReturnValueAllOld),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(workflow.ID)
+			}
+		}
+		return err
+	}
+	d.deleteReplacedWorkflowBlobs(ctx, out.Attributes, blobKeys)
+	d.recordReplacedWorkflowStats(ctx, out.Attributes, &workflow)
+	return nil
+}
+
+// workflowBlobKeys reads the references to the offloaded parts of a stored workflow.
+func (d DynamoDB) workflowBlobKeys(ctx context.Context, workflowID string) (ddbWorkflowBlobKeys, error) {
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(d.workflowsTable()),
+		Key: map[string]*dynamodb.AttributeValue{
+			"id": &dynamodb.AttributeValue{
+				S: aws.String(workflowID),
+			},
+		},
+		ProjectionExpression: aws.String("blobKeys"),
+		ConsistentRead:       aws.Bool(true),
+	})
+	if err != nil {
+		return ddbWorkflowBlobKeys{}, err
+	}
+	var blobKeys ddbWorkflowBlobKeys
+	if av, ok := res.Item["blobKeys"]; ok {
+		if err := dynamodbattribute.Unmarshal(av, &blobKeys); err != nil {
+			return ddbWorkflowBlobKeys{}, err
+		}
+	}
+	return blobKeys, nil
+}
+
+// deleteReplacedWorkflowBlobs deletes the blobs referenced by a workflow stored in item, as
+// returned by a write, that are no longer referenced by blobKeys. Failures are logged, since
+// the write has already succeeded.
+func (d DynamoDB) deleteReplacedWorkflowBlobs(ctx context.Context, item map[string]*dynamodb.AttributeValue, blobKeys *ddbWorkflowBlobKeys) {
+	av, ok := item["blobKeys"]
+	if !ok || d.Blobs == nil {
+		return
+	}
+	var previous ddbWorkflowBlobKeys
+	if err := dynamodbattribute.Unmarshal(av, &previous); err != nil {
+		log.ErrorD("delete-workflow-blobs", logger.M{"error": err.Error()})
+		return
+	}
+	if blobKeys == nil {
+		blobKeys = &ddbWorkflowBlobKeys{}
+	}
+	for _, keys := range [][2]string{
+		{previous.Jobs, blobKeys.Jobs},
+		{previous.Input, blobKeys.Input},
+		{previous.EffectiveInput, blobKeys.EffectiveInput},
+		{previous.Output, blobKeys.Output},
+	} {
+		if keys[0] == "" || keys[0] == keys[1] {
+			continue
+		}
+		if err := d.Blobs.Delete(ctx, keys[0]); err != nil {
+			if _, ok := err.(models.NotFound); !ok {
+				log.ErrorD("delete-workflow-blobs", logger.M{"key": keys[0], "error": err.Error()})
+			}
+		}
+	}
+}
+
+// loadOffloadedWorkflow reads the parts of a workflow that were written to the blob store back
+// into the workflow. If summaryOnly is true, only the inputs are loaded.
+func (d DynamoDB) loadOffloadedWorkflow(ctx context.Context, workflow *models.Workflow, blobKeys *ddbWorkflowBlobKeys, summaryOnly bool) error {
+	if blobKeys == nil {
+		return nil
+	}
+	if d.Blobs == nil {
+		return fmt.Errorf("workflow %s has data in the blob store, but no blob store is configured", workflow.ID)
+	}
+
+	if blobKeys.Input != "" {
+		input, err := d.Blobs.Get(ctx, blobKeys.Input)
+		if err != nil {
+			return err
+		}
+		workflow.Input = string(input)
+	}
+	if blobKeys.EffectiveInput != "" {
+		effectiveInput, err := d.Blobs.Get(ctx, blobKeys.EffectiveInput)
+		if err != nil {
+			return err
+		}
+		workflow.EffectiveInput = string(effectiveInput)
+	}
+	if summaryOnly {
+		return nil
+	}
+	if blobKeys.Output != "" {
+		output, err := d.Blobs.Get(ctx, blobKeys.Output)
+		if err != nil {
+			return err
+		}
+		workflow.Output = string(output)
+	}
+	if blobKeys.Jobs != "" {
+		jobs, err := d.Blobs.Get(ctx, blobKeys.Jobs)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(jobs, &workflow.Jobs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workflowBlobKey returns the key that a part of a workflow with the given content is stored
+// under in the blob store.
+func workflowBlobKey(workflowID, part string, data []byte) string {
+	return fmt.Sprintf("workflows/%s/%s/%x", workflowID, part, sha1.Sum(data))
+}
+
+// itemSize estimates the size dynamo counts for an item: the length of its attribute names
+// and values, plus a few bytes for each element of a list or map.
+func itemSize(item map[string]*dynamodb.AttributeValue) int {
+	size := 0
+	for name, av := range item {
+		size += len(name) + attributeValueSize(av)
+	}
+	return size
+}
+
+func attributeValueSize(av *dynamodb.AttributeValue) int {
+	if av == nil {
+		return 0
+	}
+	size := len(aws.StringValue(av.S)) + len(aws.StringValue(av.N)) + len(av.B)
+	if av.BOOL != nil || av.NULL != nil {
+		size++
+	}
+	for _, s := range av.SS {
+		size += len(aws.StringValue(s))
+	}
+	for _, n := range av.NS {
+		size += len(aws.StringValue(n))
+	}
+	for _, b := range av.BS {
+		size += len(b)
+	}
+	if av.M != nil {
+		size += 3 + itemSize(av.M)
+	}
+	if av.L != nil {
+		size += 3
+		for _, elem := range av.L {
+			size += 1 + attributeValueSize(elem)
+		}
+	}
+	return size
 }
 
 // DeleteWorkflow should only be used in cases where the Workflow has failed to start
 // and we need to remove it for cleanup. This removes the Workflow record from DynamoDB
 func (d DynamoDB) DeleteWorkflowByID(ctx context.Context, workflowID string) error {
-	_, err := d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+	out, err := d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
 		TableName: aws.String(d.workflowsTable()),
 		Key: map[string]*dynamodb.AttributeValue{
 			"id": &dynamodb.AttributeValue{
@@ -513,6 +1847,7 @@ func (d DynamoDB) DeleteWorkflowByID(ctx context.Context, workflowID string) err
 			"#I": aws.String("id"),
 		},
 		ConditionExpression: aws.String("attribute_exists(#I)"),
+		ReturnValues:        aws.String(dynamodb.ReturnValueAllOld),
 	})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -520,8 +1855,143 @@ func (d DynamoDB) DeleteWorkflowByID(ctx context.Context, workflowID string) err
 				return store.NewNotFound(workflowID)
 			}
 		}
+		return err
+	}
+	d.deleteReplacedWorkflowBlobs(ctx, out.Attributes, nil)
+	d.recordReplacedWorkflowStats(ctx, out.Attributes, nil)
+	return nil
+}
+
+// recordReplacedWorkflowStats records the change to the workflow counts from replacing the workflow
+// stored in item, as returned by a write, with workflow.
+func (d DynamoDB) recordReplacedWorkflowStats(ctx context.Context, item map[string]*dynamodb.AttributeValue, workflow *models.Workflow) {
+	var previous *models.Workflow
+	if len(item) > 0 {
+		decoded, err := DecodeWorkflow(item)
+		if err != nil {
+			log.ErrorD("record-workflow-stats", logger.M{"error": err.Error()})
+			return
+		}
+		previous = &decoded
+	}
+	d.recordWorkflowStats(ctx, previous, workflow)
+}
+
+// recordWorkflowStats adds the change to the workflow counts from replacing previous with workflow
+// to the counts of the period the workflow was created in. The counts are informational, so
+// failures are logged rather than failing the write of the workflow.
+func (d DynamoDB) recordWorkflowStats(ctx context.Context, previous, workflow *models.Workflow) {
+	change, ok := resources.WorkflowStatsChange(previous, workflow)
+	if !ok {
+		return
+	}
+	if err := d.AddWorkflowStatsCounts(ctx, change); err != nil {
+		log.ErrorD("record-workflow-stats", logger.M{
+			"name":   change.WorkflowDefinitionName,
+			"period": change.Period.Format(time.RFC3339),
+			"error":  err.Error(),
+		})
 	}
-	return err
+}
+
+// AddWorkflowStatsCounts adds counts to the counts of their period in place, so that concurrent
+// changes to the counts of a period don't overwrite each other.
+func (d DynamoDB) AddWorkflowStatsCounts(ctx context.Context, counts resources.WorkflowStatsCounts) error {
+	key, err := EncodeWorkflowStatsKey(counts)
+	if err != nil {
+		return err
+	}
+	for _, update := range workflowStatsUpdateExpressions(counts) {
+		if _, err := d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(d.workflowStatsTable()),
+			Key:                       key,
+			ExpressionAttributeNames:  update.names,
+			ExpressionAttributeValues: update.values,
+			UpdateExpression:          aws.String(update.expression),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarkWorkflowStateStatsRecorded sets the StateStatsRecorded flag of a workflow, unless it's
+// already set. Later writes of the workflow keep the flag since they write the workflow it's set on.
+func (d DynamoDB) MarkWorkflowStateStatsRecorded(ctx context.Context, workflowID string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowPrimaryKey{
+		ID: workflowID,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:        aws.String(d.workflowsTable()),
+		Key:              key,
+		UpdateExpression: aws.String("SET #W.#R = :true"),
+		// the flag is omitted from workflows until it's set
+		ConditionExpression: aws.String("attribute_exists(#ID) AND attribute_not_exists(#W.#R)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#ID": aws.String("id"),
+			"#W":  aws.String("Workflow"),
+			"#R":  aws.String("stateStatsRecorded"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":true": &dynamodb.AttributeValue{BOOL: aws.Bool(true)},
+		},
+	}); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewStateStatsRecorded(workflowID)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// GetWorkflowStatsCounts returns the counts of the workflows of a definition for the periods of
+// a time window, sorted by period.
+func (d DynamoDB) GetWorkflowStatsCounts(
+	ctx context.Context, workflowDefinitionName string, from, to time.Time,
+) ([]resources.WorkflowStatsCounts, error) {
+	periods := []resources.WorkflowStatsCounts{}
+	if !from.Before(to) {
+		return periods, nil
+	}
+	var decodeErr error
+	err := d.ddb.QueryPagesWithContext(ctx, &dynamodb.QueryInput{
+		TableName: aws.String(d.workflowStatsTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#N": aws.String("workflowDefinitionName"),
+			"#P": aws.String("period"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":name": &dynamodb.AttributeValue{S: aws.String(workflowDefinitionName)},
+			":from": &dynamodb.AttributeValue{
+				N: aws.String(strconv.FormatInt(resources.WorkflowStatsPeriodStart(from).Unix(), 10)),
+			},
+			// periods are stored by their start, so the last one starts before to
+			":to": &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(to.Add(-time.Nanosecond).Unix(), 10))},
+		},
+		KeyConditionExpression: aws.String("#N = :name AND #P BETWEEN :from AND :to"),
+	}, func(out *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range out.Items {
+			counts, err := DecodeWorkflowStatsCounts(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			periods = append(periods, counts)
+		}
+		return true
+	})
+	if err != nil {
+		return []resources.WorkflowStatsCounts{}, err
+	}
+	if decodeErr != nil {
+		return []resources.WorkflowStatsCounts{}, decodeErr
+	}
+	return periods, nil
 }
 
 // GetWorkflowByID
@@ -545,15 +2015,20 @@ func (d DynamoDB) GetWorkflowByID(ctx context.Context, id string) (models.Workfl
 		return models.Workflow{}, store.NewNotFound(id)
 	}
 
-	workflow, err := DecodeWorkflow(res.Item)
+	workflow, blobKeys, err := decodeWorkflowWithBlobKeys(res.Item)
 	if err != nil {
 		return models.Workflow{}, err
 	}
+	if err := d.loadOffloadedWorkflow(ctx, &workflow, blobKeys, false); err != nil {
+		return models.Workflow{}, err
+	}
 
 	return workflow, nil
 }
 
 // GetWorkflows returns all workflows matching the given query.
+// Workflows are read from the index that covers the most of the query, and the rest of it is
+// applied as a filter, so the index may be read until enough workflows match to fill a page.
 func (d DynamoDB) GetWorkflows(ctx context.Context, query *models.WorkflowQuery) ([]models.Workflow, string, error) {
 	var workflows []models.Workflow
 	nextPageToken := ""
@@ -561,31 +2036,50 @@ func (d DynamoDB) GetWorkflows(ctx context.Context, query *models.WorkflowQuery)
 
 	var dbQuery *dynamodb.QueryInput
 	var err error
+	definitionNameIsSet := aws.StringValue(query.WorkflowDefinitionName) != ""
 	statusIsSet := query.Status != ""
 	resolvedByUserIsSet := query.ResolvedByUserWrapper != nil && query.ResolvedByUserWrapper.IsSet
-	// status should never be nonempty when ResolvedByUser.IsSet is true, based on handler.
-	if statusIsSet && resolvedByUserIsSet {
-		return workflows, nextPageToken, store.NewInvalidQueryStructureError("query cannot contain Status when ResolvedByUser value is set.")
-	}
 
-	if statusIsSet {
+	// filters holds the parts of the query that aren't covered by the key of the index
+	filters := *query
+	if !definitionNameIsSet {
+		// query across all workflow definitions in a namespace
+		if query.Namespace == "" {
+			return workflows, nextPageToken, store.NewInvalidQueryStructureError("query must contain WorkflowDefinitionName or Namespace.")
+		}
+		dbQuery, err = ddbWorkflowSecondaryKeyNamespaceCreatedAt{}.ConstructQuery(query)
+		filters.Namespace = ""
+	} else if statusIsSet && resolvedByUserIsSet {
+		// if query includes both status and a ResolvedByUser value that is set, query by both
+		dbQuery, err = ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{}.ConstructQuery(query)
+		filters.WorkflowDefinitionName = nil
+		filters.Status = ""
+		filters.ResolvedByUserWrapper = nil
+	} else if statusIsSet {
 		// if query includes status, query by status
 		dbQuery, err = ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt{}.ConstructQuery(query)
+		filters.WorkflowDefinitionName = nil
+		filters.Status = ""
 	} else if resolvedByUserIsSet {
 		// otherwise, if query includes a ResolvedByUser value that is set, query with the ResolvedByUser value
 		dbQuery, err = ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt{}.ConstructQuery(query)
+		filters.WorkflowDefinitionName = nil
+		filters.ResolvedByUserWrapper = nil
 	} else {
 		// otherwise, query on just the workflow definition name
 		dbQuery, err = ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt{
 			WorkflowDefinitionName: aws.StringValue(query.WorkflowDefinitionName),
 		}.ConstructQuery(summaryOnly)
+		filters.WorkflowDefinitionName = nil
 	}
 	if err != nil {
 		return workflows, nextPageToken, err
 	}
+	if err := addWorkflowQueryFilters(dbQuery, filters); err != nil {
+		return workflows, nextPageToken, err
+	}
 
 	dbQuery.TableName = aws.String(d.workflowsTable())
-	dbQuery.Limit = aws.Int64(query.Limit)
 	dbQuery.ScanIndexForward = aws.Bool(query.OldestFirst)
 
 	pageKey, err := ParsePageKey(query.PageToken)
@@ -596,29 +2090,50 @@ func (d DynamoDB) GetWorkflows(ctx context.Context, query *models.WorkflowQuery)
 		dbQuery.SetExclusiveStartKey(map[string]*dynamodb.AttributeValue(*pageKey))
 	}
 
-	res, err := d.ddb.QueryWithContext(ctx, dbQuery)
-	if err != nil {
-		return workflows, nextPageToken, err
-	}
-
-	for _, item := range res.Items {
-		workflow, err := DecodeWorkflow(item)
+	// Limit is the number of items read before filtering, so reading no more than the number of
+	// workflows still missing from the page means the last evaluated key is the end of the page.
+	// Filters can drop most of the items read, so rather than reading until the page is full, a
+	// page ends early after maxWorkflowQueryReads queries and the page token continues from there.
+	for reads := 1; ; reads++ {
+		dbQuery.Limit = aws.Int64(query.Limit - int64(len(workflows)))
+		res, err := d.ddb.QueryWithContext(ctx, dbQuery)
 		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ValidationException" &&
+				strings.HasPrefix(awsErr.Message(), errMessageMissingIndex) {
+				// indexes added after the table was created have to be added to it separately
+				log.ErrorD("missing-workflow-index", logger.M{
+					"table": d.workflowsTable(),
+					"index": aws.StringValue(dbQuery.IndexName),
+				})
+				return workflows, nextPageToken, fmt.Errorf(
+					"table %s is missing index %s", d.workflowsTable(), aws.StringValue(dbQuery.IndexName),
+				)
+			}
 			return workflows, nextPageToken, err
 		}
 
-		workflows = append(workflows, workflow)
-	}
+		for _, item := range res.Items {
+			workflow, blobKeys, err := decodeWorkflowWithBlobKeys(item)
+			if err != nil {
+				return workflows, nextPageToken, err
+			}
+			if err := d.loadOffloadedWorkflow(ctx, &workflow, blobKeys, summaryOnly); err != nil {
+				return workflows, nextPageToken, err
+			}
 
-	nextPageKey := NewPageKey(res.LastEvaluatedKey)
-	if nextPageKey != nil {
-		nextPageToken, err = nextPageKey.ToJSON()
-		if err != nil {
+			workflows = append(workflows, workflow)
+		}
+
+		if len(res.LastEvaluatedKey) == 0 {
+			return workflows, nextPageToken, nil
+		}
+		if int64(len(workflows)) >= query.Limit || reads >= maxWorkflowQueryReads {
+			nextPageKey := NewPageKey(res.LastEvaluatedKey)
+			nextPageToken, err = nextPageKey.ToJSON()
 			return workflows, nextPageToken, err
 		}
+		dbQuery.SetExclusiveStartKey(res.LastEvaluatedKey)
 	}
-
-	return workflows, nextPageToken, nil
 }
 
 type byLastUpdatedTime []models.Workflow
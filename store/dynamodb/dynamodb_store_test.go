@@ -2,20 +2,188 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/Clever/workflow-manager/gen-go/models"
 	dynamodbgen "github.com/Clever/workflow-manager/gen-go/server/db/dynamodb"
+	"github.com/Clever/workflow-manager/resources"
 	"github.com/Clever/workflow-manager/store"
+	"github.com/Clever/workflow-manager/store/blob"
 	"github.com/Clever/workflow-manager/store/tests"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDynamoDBStore(t *testing.T) {
+	blobRoot, err := ioutil.TempDir("", "workflow-manager-test-blobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(blobRoot)
+
+	tests.RunStoreTests(t, func() store.Store {
+		return newTestStore(t, blob.NewFileSystem(blobRoot))
+	})
+}
+
+func TestOffloadedWorkflowBlobs(t *testing.T) {
+	ctx := context.Background()
+	blobRoot, err := ioutil.TempDir("", "workflow-manager-test-blobs")
+	require.NoError(t, err)
+	defer os.RemoveAll(blobRoot)
+	blobs := &countingBlobs{Store: blob.NewFileSystem(blobRoot)}
+	s := newTestStore(t, blobs)
+
+	wf := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, s.SaveWorkflowDefinition(ctx, *wf))
+	workflow := resources.NewWorkflow(wf, `["input"]`, "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, s.SaveWorkflow(ctx, *workflow))
+
+	t.Log("Workflows too large for dynamo are offloaded without a rejected write")
+	for i := 0; i < 4000; i++ {
+		text := fmt.Sprintf("%d-test-id", i)
+		workflow.Jobs = append(workflow.Jobs, &models.Job{
+			Container:    "test container",
+			ID:           text,
+			Name:         text,
+			Output:       text,
+			Status:       models.JobStatusCreated,
+			StatusReason: text,
+		})
+	}
+	require.NoError(t, s.UpdateWorkflow(ctx, *workflow))
+	assert.Equal(t, 2, blobs.puts) // jobs and input
+
+	t.Log("Unchanged parts aren't written again")
+	require.NoError(t, s.UpdateWorkflow(ctx, *workflow))
+	assert.Equal(t, 2, blobs.puts)
+
+	t.Log("Replaced parts are deleted")
+	workflow.Jobs[0].Status = models.JobStatusSucceeded
+	require.NoError(t, s.UpdateWorkflow(ctx, *workflow))
+	assert.Equal(t, 3, blobs.puts)
+	assert.Equal(t, 1, blobs.deletes)
+
+	t.Log("Blobs are deleted with their workflow")
+	require.NoError(t, s.DeleteWorkflowByID(ctx, workflow.ID))
+	assert.Equal(t, 3, blobs.deletes)
+	files := 0
+	require.NoError(t, filepath.Walk(blobRoot, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files++
+		}
+		return err
+	}))
+	assert.Zero(t, files)
+}
+
+func TestItemSize(t *testing.T) {
+	workflow := models.Workflow{WorkflowSummary: models.WorkflowSummary{ID: "id", Input: strings.Repeat("x", 1000)}}
+	item, err := EncodeWorkflow(workflow)
+	require.NoError(t, err)
+	size := itemSize(item)
+	assert.True(t, size > 1000)
+	assert.True(t, size < 2*1000)
+
+	workflow.Input = strings.Repeat("x", maxItemSize)
+	item, err = EncodeWorkflow(workflow)
+	require.NoError(t, err)
+	assert.True(t, itemSize(item) > maxItemSize)
+}
+
+func TestGetWorkflowsReadsAreBounded(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, nil)
+	wf := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, s.SaveWorkflowDefinition(ctx, *wf))
+
+	match := resources.NewWorkflow(wf, "{}", "namespace", "match", map[string]interface{}{})
+	require.NoError(t, s.SaveWorkflow(ctx, *match))
+	for i := 0; i < maxWorkflowQueryReads+2; i++ {
+		workflow := resources.NewWorkflow(wf, "{}", "namespace", "other", map[string]interface{}{})
+		require.NoError(t, s.SaveWorkflow(ctx, *workflow))
+	}
+
+	t.Log("Pages whose workflows are mostly filtered out end early with a page token")
+	query := &models.WorkflowQuery{Namespace: "namespace", Queue: "match", Limit: 1}
+	workflows, nextPageToken, err := s.GetWorkflows(ctx, query)
+	require.NoError(t, err)
+	assert.Empty(t, workflows)
+	require.NotEmpty(t, nextPageToken)
+
+	query.PageToken = nextPageToken
+	workflows, _, err = s.GetWorkflows(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, workflows, 1)
+	assert.Equal(t, match.ID, workflows[0].ID)
+}
+
+func TestBackfillWorkflowIndexKeys(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t, nil)
+	require.NoError(t, s.CheckWorkflowIndexes(ctx))
+
+	wf := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, s.SaveWorkflowDefinition(ctx, *wf))
+	workflow := resources.NewWorkflow(wf, "{}", "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, s.SaveWorkflow(ctx, *workflow))
+
+	t.Log("Workflows written before the namespace index was added aren't in it")
+	_, err := s.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(s.workflowsTable()),
+		Key:                      map[string]*dynamodb.AttributeValue{"id": {S: aws.String(workflow.ID)}},
+		UpdateExpression:         aws.String("REMOVE #NS"),
+		ExpressionAttributeNames: map[string]*string{"#NS": aws.String("_gsi-ns")},
+	})
+	require.NoError(t, err)
+	query := &models.WorkflowQuery{Namespace: "namespace", Limit: 10}
+	workflows, _, err := s.GetWorkflows(ctx, query)
+	require.NoError(t, err)
+	assert.Empty(t, workflows)
+
+	t.Log("Until their keys are backfilled")
+	updated, err := s.BackfillWorkflowIndexKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	workflows, _, err = s.GetWorkflows(ctx, query)
+	require.NoError(t, err)
+	require.Len(t, workflows, 1)
+	assert.Equal(t, workflow.ID, workflows[0].ID)
+
+	updated, err = s.BackfillWorkflowIndexKeys(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+}
+
+// countingBlobs counts the writes and deletes of a blob store.
+type countingBlobs struct {
+	blob.Store
+	puts    int
+	deletes int
+}
+
+func (c *countingBlobs) Put(ctx context.Context, key string, data []byte) error {
+	c.puts++
+	return c.Store.Put(ctx, key, data)
+}
+
+func (c *countingBlobs) Delete(ctx context.Context, key string) error {
+	c.deletes++
+	return c.Store.Delete(ctx, key)
+}
+
+// newTestStore creates the tables of a store in dynamodb local, deleting any left over from
+// earlier tests.
+func newTestStore(t *testing.T, blobs blob.Store) DynamoDB {
 	svc := dynamodb.New(session.Must(session.NewSessionWithOptions(session.Options{
 		Config: aws.Config{
 			Region:      aws.String("doesntmatter"),
@@ -24,34 +192,34 @@ func TestDynamoDBStore(t *testing.T) {
 		},
 	})))
 
-	tests.RunStoreTests(t, func() store.Store {
-		prefix := "workflow-manager-test"
-		listTablesOutput, err := svc.ListTables(&dynamodb.ListTablesInput{})
-		if err != nil {
-			t.Fatal(err)
-		}
-		for _, tableName := range listTablesOutput.TableNames {
-			if strings.HasPrefix(*tableName, prefix) {
-				svc.DeleteTable(&dynamodb.DeleteTableInput{
-					TableName: tableName,
-				})
-			}
-		}
-		s := New(svc, TableConfig{
-			PrefixStateResources:      prefix,
-			PrefixWorkflowDefinitions: prefix,
-			PrefixWorkflows:           prefix,
-		})
-		if s.Future, err = dynamodbgen.New(dynamodbgen.Config{
-			DynamoDBAPI:   svc,
-			DefaultPrefix: prefix,
-		}); err != nil {
-			t.Fatal(err)
-		}
-		// InitTables(false) since dynamodb local doesn't support TTLs
-		if err := s.InitTables(context.Background(), false); err != nil {
-			t.Fatal(err)
+	prefix := "workflow-manager-test"
+	listTablesOutput, err := svc.ListTables(&dynamodb.ListTablesInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tableName := range listTablesOutput.TableNames {
+		if strings.HasPrefix(*tableName, prefix) {
+			svc.DeleteTable(&dynamodb.DeleteTableInput{
+				TableName: tableName,
+			})
 		}
-		return s
+	}
+	s := New(svc, TableConfig{
+		PrefixNamespaceConfigs:    prefix,
+		PrefixStateResources:      prefix,
+		PrefixWorkflowDefinitions: prefix,
+		PrefixWorkflows:           prefix,
 	})
+	if s.Future, err = dynamodbgen.New(dynamodbgen.Config{
+		DynamoDBAPI:   svc,
+		DefaultPrefix: prefix,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	s.Blobs = blobs
+	// InitTables(false) since dynamodb local doesn't support TTLs
+	if err := s.InitTables(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+	return s
 }
@@ -0,0 +1,55 @@
+package dynamodb
+
+import (
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ddbNamespacePrimaryKey represents the primary key of the namespaces table.
+type ddbNamespacePrimaryKey struct {
+	Name string `dynamodbav:"name"`
+}
+
+func (pk ddbNamespacePrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbNamespacePrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
+
+type ddbNamespace struct {
+	ddbNamespacePrimaryKey
+	Namespace models.Namespace
+}
+
+// EncodeNamespace encodes a Namespace into a dynamo attribute map
+func EncodeNamespace(namespace models.Namespace) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbNamespace{
+		ddbNamespacePrimaryKey: ddbNamespacePrimaryKey{
+			Name: namespace.Name,
+		},
+		Namespace: namespace,
+	})
+}
+
+// DecodeNamespace translates a Namespace stored in dynamodb to a Namespace object
+func DecodeNamespace(m map[string]*dynamodb.AttributeValue) (models.Namespace, error) {
+	var res ddbNamespace
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.Namespace{}, err
+	}
+	return res.Namespace, nil
+}
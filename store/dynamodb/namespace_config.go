@@ -0,0 +1,55 @@
+package dynamodb
+
+import (
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ddbNamespaceConfigPrimaryKey represents the primary key of the namespace-configs table.
+type ddbNamespaceConfigPrimaryKey struct {
+	Namespace string `dynamodbav:"namespace"`
+}
+
+func (pk ddbNamespaceConfigPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("namespace"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbNamespaceConfigPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("namespace"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
+
+type ddbNamespaceConfig struct {
+	ddbNamespaceConfigPrimaryKey
+	NamespaceConfig models.NamespaceConfig
+}
+
+// EncodeNamespaceConfig encodes a NamespaceConfig into a dynamo attribute map
+func EncodeNamespaceConfig(config models.NamespaceConfig) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbNamespaceConfig{
+		ddbNamespaceConfigPrimaryKey: ddbNamespaceConfigPrimaryKey{
+			Namespace: config.Namespace,
+		},
+		NamespaceConfig: config,
+	})
+}
+
+// DecodeNamespaceConfig translates a NamespaceConfig stored in dynamodb to a NamespaceConfig object
+func DecodeNamespaceConfig(m map[string]*dynamodb.AttributeValue) (models.NamespaceConfig, error) {
+	var res ddbNamespaceConfig
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.NamespaceConfig{}, err
+	}
+	return res.NamespaceConfig, nil
+}
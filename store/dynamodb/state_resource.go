@@ -39,6 +39,42 @@ func (pk ddbStateResourcePrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
 	}
 }
 
+// ddbStateResourceSecondaryKeyNamespaceName is a global secondary index for listing the
+// StateResources of a namespace, sorted by name. It indexes the attributes of the primary key.
+type ddbStateResourceSecondaryKeyNamespaceName struct{}
+
+func (sk ddbStateResourceSecondaryKeyNamespaceName) Name() string {
+	return "namespace-name"
+}
+
+func (sk ddbStateResourceSecondaryKeyNamespaceName) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("namespace"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+func (sk ddbStateResourceSecondaryKeyNamespaceName) ConstructQuery(namespace string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		IndexName: aws.String(sk.Name()),
+		ExpressionAttributeNames: map[string]*string{
+			"#S": aws.String("namespace"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":namespace": &dynamodb.AttributeValue{
+				S: aws.String(namespace),
+			},
+		},
+		KeyConditionExpression: aws.String("#S = :namespace"),
+	}
+}
+
 type ddbStateResource struct {
 	ddbStateResourcePrimaryKey
 	StateResource models.StateResource
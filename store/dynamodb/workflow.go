@@ -2,6 +2,7 @@ package dynamodb
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -19,6 +20,7 @@ var SummaryKeys = []string{
 	"Workflow.createdAt",
 	"Workflow.id",
 	"Workflow.#I", // input
+	"Workflow.effectiveInput",
 	"Workflow.lastUpdated",
 	"Workflow.queue",
 	"Workflow.namespace",
@@ -30,6 +32,9 @@ var SummaryKeys = []string{
 
 	"Workflow.workflowDefinition.#N",
 	"Workflow.workflowDefinition.version",
+
+	"blobKeys.#I", // input, if it was offloaded to the blob store
+	"blobKeys.effectiveInput",
 }
 
 const WorkflowTTL = 30 * 24 * time.Hour // 30 days
@@ -48,12 +53,29 @@ type ddbWorkflow struct {
 	ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt
 	ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt
 	ddbWorkflowSecondaryKeyDefinitionResolvedByUserCreatedAt
+	ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt
+	ddbWorkflowSecondaryKeyNamespaceCreatedAt
 	ddbWorkflowTTL
 	Workflow models.Workflow
+	BlobKeys *ddbWorkflowBlobKeys `dynamodbav:"blobKeys,omitempty"`
+}
+
+// ddbWorkflowBlobKeys references the parts of a workflow that were too large to store in dynamo
+// and were written to the blob store instead.
+type ddbWorkflowBlobKeys struct {
+	Jobs           string `dynamodbav:"jobs,omitempty"`
+	Input          string `dynamodbav:"input,omitempty"`
+	EffectiveInput string `dynamodbav:"effectiveInput,omitempty"`
+	Output         string `dynamodbav:"output,omitempty"`
 }
 
 // EncodeWorkflow encodes a Workflow as a dynamo attribute map.
 func EncodeWorkflow(workflow models.Workflow) (map[string]*dynamodb.AttributeValue, error) {
+	return encodeWorkflowWithBlobKeys(workflow, nil)
+}
+
+// encodeWorkflowWithBlobKeys encodes a Workflow along with references to its offloaded parts.
+func encodeWorkflowWithBlobKeys(workflow models.Workflow, blobKeys *ddbWorkflowBlobKeys) (map[string]*dynamodb.AttributeValue, error) {
 	return dynamodbattribute.MarshalMap(ddbWorkflow{
 		ddbWorkflowPrimaryKey: ddbWorkflowPrimaryKey{
 			ID: workflow.ID,
@@ -74,20 +96,38 @@ func EncodeWorkflow(workflow models.Workflow) (map[string]*dynamodb.AttributeVal
 				bool(workflow.ResolvedByUser),
 			),
 		},
+		ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt: ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{
+			DefinitionStatusResolvedByUserTriple: ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt{}.getDefinitionStatusResolvedByUserTriple(
+				workflow.WorkflowDefinition.Name,
+				string(workflow.Status),
+				bool(workflow.ResolvedByUser),
+			),
+		},
+		ddbWorkflowSecondaryKeyNamespaceCreatedAt: ddbWorkflowSecondaryKeyNamespaceCreatedAt{
+			Namespace: workflow.Namespace,
+		},
 		ddbWorkflowTTL: ddbWorkflowTTL{
 			TTL: strfmt.DateTime(time.Time(workflow.CreatedAt).Add(WorkflowTTL)),
 		},
 		Workflow: workflow,
+		BlobKeys: blobKeys,
 	})
 }
 
 // DecodeWorkflow translates a workflow stored in dynamodb to a Workflow object.
+// Parts of the workflow that were offloaded to the blob store are left empty.
 func DecodeWorkflow(m map[string]*dynamodb.AttributeValue) (models.Workflow, error) {
+	workflow, _, err := decodeWorkflowWithBlobKeys(m)
+	return workflow, err
+}
+
+// decodeWorkflowWithBlobKeys decodes a Workflow along with references to its offloaded parts.
+func decodeWorkflowWithBlobKeys(m map[string]*dynamodb.AttributeValue) (models.Workflow, *ddbWorkflowBlobKeys, error) {
 	var dj ddbWorkflow
 	if err := dynamodbattribute.UnmarshalMap(m, &dj); err != nil {
-		return models.Workflow{}, err
+		return models.Workflow{}, nil, err
 	}
-	return dj.Workflow, nil
+	return dj.Workflow, dj.BlobKeys, nil
 }
 
 // ddbWorkflowPrimaryKey represents the primary + global secondary keys of the workflows table.
@@ -320,6 +360,241 @@ func (sk ddbWorkflowSecondaryKeyDefinitionStatusCreatedAt) KeySchema() []*dynamo
 	}
 }
 
+// ===============================
+
+// ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt is a global secondary index for
+// querying workflows by definition name, status and ResolvedByUser, sorted by creation time,
+// e.g. for the failed workflows of a definition that no one has resolved yet.
+type ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt struct {
+	DefinitionStatusResolvedByUserTriple string `dynamodbav:"_gsi-wn-and-status-and-resolvedbyuser,omitempty"`
+	// NOTE: _gsi-ca is already serialized by ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt.
+}
+
+func (sk ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt) Name() string {
+	return "workflownameandstatusandresolvedbyuser-createdat"
+}
+
+func (sk ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("_gsi-wn-and-status-and-resolvedbyuser"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (sk ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt) getDefinitionStatusResolvedByUserTriple(
+	definitionName string,
+	status string,
+	resolvedByUser bool,
+) string {
+	return fmt.Sprintf("%s:%s:%t", definitionName, status, resolvedByUser)
+}
+
+func (sk ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt) ConstructQuery(
+	query *models.WorkflowQuery,
+) (*dynamodb.QueryInput, error) {
+	if query.Status == "" || query.ResolvedByUserWrapper == nil || !query.ResolvedByUserWrapper.IsSet {
+		return nil, fmt.Errorf("workflow status and 'resolved by user' (true/false) filters are required for %s index", sk.Name())
+	}
+
+	queryInput := &dynamodb.QueryInput{
+		IndexName: aws.String(sk.Name()),
+		ExpressionAttributeNames: map[string]*string{
+			"#WSR": aws.String("_gsi-wn-and-status-and-resolvedbyuser"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":workflowNameAndStatusAndResolvedByUser": &dynamodb.AttributeValue{
+				S: aws.String(
+					sk.getDefinitionStatusResolvedByUserTriple(
+						aws.StringValue(query.WorkflowDefinitionName),
+						string(query.Status),
+						bool(query.ResolvedByUserWrapper.Value)),
+				),
+			},
+		},
+		KeyConditionExpression: aws.String("#WSR = :workflowNameAndStatusAndResolvedByUser"),
+	}
+
+	if aws.BoolValue(query.SummaryOnly) {
+		onlySummaryFields(queryInput)
+	}
+
+	return queryInput, nil
+}
+
+func (sk ddbWorkflowSecondaryKeyDefinitionStatusResolvedByUserCreatedAt) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("_gsi-wn-and-status-and-resolvedbyuser"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("_gsi-ca"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// ===============================
+
+// ddbWorkflowSecondaryKeyNamespaceCreatedAt is a global secondary index for querying the
+// workflows of every definition in a namespace, sorted by creation time.
+type ddbWorkflowSecondaryKeyNamespaceCreatedAt struct {
+	Namespace string `dynamodbav:"_gsi-ns,omitempty"`
+	// NOTE: _gsi-ca is already serialized by ddbWorkflowSecondaryKeyWorkflowDefinitionCreatedAt.
+}
+
+func (sk ddbWorkflowSecondaryKeyNamespaceCreatedAt) Name() string {
+	return "namespace-createdat"
+}
+
+func (sk ddbWorkflowSecondaryKeyNamespaceCreatedAt) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("_gsi-ns"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (sk ddbWorkflowSecondaryKeyNamespaceCreatedAt) ConstructQuery(
+	query *models.WorkflowQuery,
+) (*dynamodb.QueryInput, error) {
+	if query.Namespace == "" {
+		return nil, fmt.Errorf("workflow namespace filter is required for %s index", sk.Name())
+	}
+
+	queryInput := &dynamodb.QueryInput{
+		IndexName: aws.String(sk.Name()),
+		ExpressionAttributeNames: map[string]*string{
+			"#NS": aws.String("_gsi-ns"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":namespace": &dynamodb.AttributeValue{
+				S: aws.String(query.Namespace),
+			},
+		},
+		KeyConditionExpression: aws.String("#NS = :namespace"),
+	}
+
+	if aws.BoolValue(query.SummaryOnly) {
+		onlySummaryFields(queryInput)
+	}
+
+	return queryInput, nil
+}
+
+func (sk ddbWorkflowSecondaryKeyNamespaceCreatedAt) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("_gsi-ns"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("_gsi-ca"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// addWorkflowQueryFilters adds the parts of a WorkflowQuery that aren't part of the key of the
+// index being queried. The createdAt range is a condition on _gsi-ca, the sort key of every
+// workflow index, and the other fields that are set become a filter expression.
+func addWorkflowQueryFilters(queryInput *dynamodb.QueryInput, filters models.WorkflowQuery) error {
+	names := queryInput.ExpressionAttributeNames
+	values := queryInput.ExpressionAttributeValues
+	names["#WF"] = aws.String("Workflow")
+	conditions := []string{}
+
+	createdAfterIsSet := !time.Time(filters.CreatedAfter).IsZero()
+	createdBeforeIsSet := !time.Time(filters.CreatedBefore).IsZero()
+	if createdAfterIsSet || createdBeforeIsSet {
+		names["#CA"] = aws.String("_gsi-ca")
+		var keyCondition string
+		if createdAfterIsSet && createdBeforeIsSet {
+			// BETWEEN includes both ends, so the end of the range is excluded by the filter
+			keyCondition = "#CA BETWEEN :createdAfter AND :createdBefore"
+			names["#FCA"] = aws.String("createdAt")
+			conditions = append(conditions, "#WF.#FCA < :createdBefore")
+		} else if createdAfterIsSet {
+			keyCondition = "#CA >= :createdAfter"
+		} else {
+			keyCondition = "#CA < :createdBefore"
+		}
+		queryInput.KeyConditionExpression = aws.String(
+			aws.StringValue(queryInput.KeyConditionExpression) + " AND " + keyCondition,
+		)
+		for name, bound := range map[string]strfmt.DateTime{
+			":createdAfter":  filters.CreatedAfter,
+			":createdBefore": filters.CreatedBefore,
+		} {
+			if time.Time(bound).IsZero() {
+				continue
+			}
+			av, err := dynamodbattribute.Marshal(bound)
+			if err != nil {
+				return fmt.Errorf("could not marshal %s: %s", name, err)
+			}
+			values[name] = av
+		}
+	}
+
+	if name := aws.StringValue(filters.WorkflowDefinitionName); name != "" {
+		names["#FWD"] = aws.String("workflowDefinition")
+		names["#FWDN"] = aws.String("name")
+		values[":filterWorkflowDefinitionName"] = &dynamodb.AttributeValue{S: aws.String(name)}
+		conditions = append(conditions, "#WF.#FWD.#FWDN = :filterWorkflowDefinitionName")
+	}
+	if filters.Namespace != "" {
+		names["#FNS"] = aws.String("namespace")
+		values[":filterNamespace"] = &dynamodb.AttributeValue{S: aws.String(filters.Namespace)}
+		conditions = append(conditions, "#WF.#FNS = :filterNamespace")
+	}
+	if filters.Queue != "" {
+		names["#FQ"] = aws.String("queue")
+		values[":filterQueue"] = &dynamodb.AttributeValue{S: aws.String(filters.Queue)}
+		conditions = append(conditions, "#WF.#FQ = :filterQueue")
+	}
+	if filters.Status != "" {
+		names["#FS"] = aws.String("status")
+		values[":filterStatus"] = &dynamodb.AttributeValue{S: aws.String(string(filters.Status))}
+		conditions = append(conditions, "#WF.#FS = :filterStatus")
+	}
+	if filters.ResolvedByUserWrapper != nil && filters.ResolvedByUserWrapper.IsSet {
+		names["#FR"] = aws.String("resolvedByUser")
+		values[":filterResolvedByUser"] = &dynamodb.AttributeValue{BOOL: aws.Bool(true)}
+		if filters.ResolvedByUserWrapper.Value {
+			conditions = append(conditions, "#WF.#FR = :filterResolvedByUser")
+		} else {
+			// resolvedByUser is omitted from workflows that weren't resolved
+			conditions = append(conditions, "(attribute_not_exists(#WF.#FR) OR #WF.#FR <> :filterResolvedByUser)")
+		}
+	}
+
+	tagKeys := []string{}
+	for key := range filters.Tags {
+		tagKeys = append(tagKeys, key)
+	}
+	sort.Strings(tagKeys)
+	if len(tagKeys) > 0 {
+		names["#FT"] = aws.String("tags")
+	}
+	for i, key := range tagKeys {
+		name, value := fmt.Sprintf("#FT%d", i), fmt.Sprintf(":filterTag%d", i)
+		names[name] = aws.String(key)
+		values[value] = &dynamodb.AttributeValue{S: aws.String(filters.Tags[key])}
+		conditions = append(conditions, fmt.Sprintf("#WF.#FT.%s = %s", name, value))
+	}
+
+	if len(conditions) > 0 {
+		queryInput.FilterExpression = aws.String(strings.Join(conditions, " AND "))
+	} else {
+		delete(names, "#WF")
+	}
+	return nil
+}
+
 // ddbWorkflowTTL is the time at which the workflow will get TTL'd by dynamo.
 type ddbWorkflowTTL struct {
 	TTL strfmt.DateTime `dynamodbav:"_ttl,unixtime"` // must be unix time to work with dynamodb builtin TTL support
@@ -338,3 +613,32 @@ func onlySummaryFields(queryInput *dynamodb.QueryInput) {
 		queryInput.ExpressionAttributeNames[name] = exp
 	}
 }
+
+// maxTruncatedCauseLength is the number of bytes kept from the end of the failure causes of the
+// jobs of a workflow that is too large to store in dynamo without a blob store.
+const maxTruncatedCauseLength = 1024
+
+// truncateJobCauses keeps the end of the failure causes of the jobs and attempts of a workflow,
+// where stack traces usually put the error. It returns false if no cause was shortened.
+func truncateJobCauses(workflow *models.Workflow) bool {
+	truncate := func(cause *string) bool {
+		if len(*cause) <= maxTruncatedCauseLength {
+			return false
+		}
+		*cause = (*cause)[len(*cause)-maxTruncatedCauseLength:]
+		return true
+	}
+
+	truncated := false
+	for _, job := range workflow.Jobs {
+		if truncate(&job.Cause) {
+			truncated = true
+		}
+		for _, attempt := range job.Attempts {
+			if truncate(&attempt.Cause) {
+				truncated = true
+			}
+		}
+	}
+	return truncated
+}
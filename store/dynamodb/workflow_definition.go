@@ -2,6 +2,7 @@ package dynamodb
 
 import (
 	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 )
@@ -40,3 +41,28 @@ func DecodeWorkflowDefinition(m map[string]*dynamodb.AttributeValue, out *models
 	*out = ddbWorkflowDefinition.WorkflowDefinition
 	return nil
 }
+
+// ddbWorkflowDefinitionVersions records the last version number given to a workflow definition,
+// so that the numbers of deleted versions aren't given out again.
+type ddbWorkflowDefinitionVersions struct {
+	Name        string `dynamodbav:"name"`
+	LastVersion int64  `dynamodbav:"lastVersion"`
+}
+
+func (v ddbWorkflowDefinitionVersions) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (v ddbWorkflowDefinitionVersions) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package dynamodb
+
+import (
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/go-openapi/strfmt"
+)
+
+// ddbWorkflowDefinitionAliasPrimaryKey represents the primary key of the workflow definition
+// aliases table: the aliases of a workflow definition share its name as their hash key.
+type ddbWorkflowDefinitionAliasPrimaryKey struct {
+	Name  string `dynamodbav:"name"`
+	Alias string `dynamodbav:"alias"`
+}
+
+func (pk ddbWorkflowDefinitionAliasPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+		{
+			AttributeName: aws.String("alias"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbWorkflowDefinitionAliasPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("alias"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+type ddbWorkflowDefinitionAlias struct {
+	ddbWorkflowDefinitionAliasPrimaryKey
+	WorkflowDefinitionAlias models.WorkflowDefinitionAlias
+	// UpdatedAt is the LastUpdated time of the alias in nanoseconds, kept at the top level so that
+	// updates can be conditioned on it.
+	UpdatedAt int64 `dynamodbav:"updatedAt"`
+}
+
+// EncodeWorkflowDefinitionAlias encodes a WorkflowDefinitionAlias into a dynamo attribute map
+func EncodeWorkflowDefinitionAlias(alias models.WorkflowDefinitionAlias) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbWorkflowDefinitionAlias{
+		ddbWorkflowDefinitionAliasPrimaryKey: ddbWorkflowDefinitionAliasPrimaryKey{
+			Name:  alias.Name,
+			Alias: alias.Alias,
+		},
+		WorkflowDefinitionAlias: alias,
+		UpdatedAt:               time.Time(alias.LastUpdated).UnixNano(),
+	})
+}
+
+// DecodeWorkflowDefinitionAlias translates a WorkflowDefinitionAlias stored in dynamodb to a
+// WorkflowDefinitionAlias object
+func DecodeWorkflowDefinitionAlias(m map[string]*dynamodb.AttributeValue) (models.WorkflowDefinitionAlias, error) {
+	var res ddbWorkflowDefinitionAlias
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.WorkflowDefinitionAlias{}, err
+	}
+	if res.UpdatedAt != 0 {
+		res.WorkflowDefinitionAlias.LastUpdated = strfmt.DateTime(time.Unix(0, res.UpdatedAt))
+	}
+	return res.WorkflowDefinitionAlias, nil
+}
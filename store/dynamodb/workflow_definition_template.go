@@ -0,0 +1,56 @@
+package dynamodb
+
+import (
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ddbWorkflowDefinitionTemplatePrimaryKey represents the primary key of the workflow definition templates table.
+type ddbWorkflowDefinitionTemplatePrimaryKey struct {
+	Name string `dynamodbav:"name"`
+}
+
+func (pk ddbWorkflowDefinitionTemplatePrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbWorkflowDefinitionTemplatePrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
+
+type ddbWorkflowDefinitionTemplate struct {
+	ddbWorkflowDefinitionTemplatePrimaryKey
+	WorkflowDefinitionTemplate models.WorkflowDefinitionTemplate
+}
+
+// EncodeWorkflowDefinitionTemplate encodes a WorkflowDefinitionTemplate into a dynamo attribute map
+func EncodeWorkflowDefinitionTemplate(template models.WorkflowDefinitionTemplate) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbWorkflowDefinitionTemplate{
+		ddbWorkflowDefinitionTemplatePrimaryKey: ddbWorkflowDefinitionTemplatePrimaryKey{
+			Name: template.Name,
+		},
+		WorkflowDefinitionTemplate: template,
+	})
+}
+
+// DecodeWorkflowDefinitionTemplate translates a WorkflowDefinitionTemplate stored in dynamodb to a
+// WorkflowDefinitionTemplate object
+func DecodeWorkflowDefinitionTemplate(m map[string]*dynamodb.AttributeValue) (models.WorkflowDefinitionTemplate, error) {
+	var res ddbWorkflowDefinitionTemplate
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.WorkflowDefinitionTemplate{}, err
+	}
+	return res.WorkflowDefinitionTemplate, nil
+}
@@ -0,0 +1,198 @@
+package dynamodb
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// Prefixes of the count attributes of a workflow-stats item, followed by the key they count.
+const (
+	workflowStatsStatusPrefix             = "status:"
+	workflowStatsResolvedByUserPrefix     = "resolvedByUser:"
+	workflowStatsVersionPrefix            = "version:"
+	workflowStatsVersionStatusPrefix      = "versionStatus:"
+	workflowStatsAliasVersionStatusPrefix = "aliasVersionStatus:"
+	workflowStatsDurationPrefix           = "duration:"
+	workflowStatsStatePrefix              = "state:"
+)
+
+// workflowStatsStateWorkflows is the attribute of a workflow-stats item that counts the workflows
+// whose jobs are counted by its state attributes.
+const workflowStatsStateWorkflows = "stateWorkflows"
+
+// ddbWorkflowStatsPrimaryKey represents the primary key of the workflow-stats table.
+// The periods of a workflow definition share a hash key so that a time window is one query.
+type ddbWorkflowStatsPrimaryKey struct {
+	WorkflowDefinitionName string    `dynamodbav:"workflowDefinitionName"`
+	Period                 time.Time `dynamodbav:"period,unixtime"`
+}
+
+func (pk ddbWorkflowStatsPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("workflowDefinitionName"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+		{
+			AttributeName: aws.String("period"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeN),
+		},
+	}
+}
+
+func (pk ddbWorkflowStatsPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("workflowDefinitionName"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("period"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// EncodeWorkflowStatsKey encodes the primary key of the counts of a period into a dynamo attribute map
+func EncodeWorkflowStatsKey(counts resources.WorkflowStatsCounts) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbWorkflowStatsPrimaryKey{
+		WorkflowDefinitionName: counts.WorkflowDefinitionName,
+		Period:                 counts.Period,
+	})
+}
+
+// workflowStatsAttributes flattens counts into top-level attributes so that each of them can be
+// incremented in place.
+func workflowStatsAttributes(counts resources.WorkflowStatsCounts) map[string]int64 {
+	attributes := map[string]int64{}
+	for status, n := range counts.Statuses {
+		attributes[workflowStatsStatusPrefix+status] = n
+	}
+	for resolvedByUser, n := range counts.ResolvedByUser {
+		attributes[workflowStatsResolvedByUserPrefix+resolvedByUser] = n
+	}
+	for version, n := range counts.Versions {
+		attributes[workflowStatsVersionPrefix+version] = n
+	}
+	for versionStatus, n := range counts.VersionStatuses {
+		attributes[workflowStatsVersionStatusPrefix+versionStatus] = n
+	}
+	for aliasVersionStatus, n := range counts.AliasVersionStatuses {
+		attributes[workflowStatsAliasVersionStatusPrefix+aliasVersionStatus] = n
+	}
+	for bucket, n := range counts.Durations {
+		attributes[workflowStatsDurationPrefix+strconv.Itoa(bucket)] = n
+	}
+	for state, n := range counts.States {
+		attributes[workflowStatsStatePrefix+state] = n
+	}
+	if counts.StateWorkflows != 0 {
+		attributes[workflowStatsStateWorkflows] = counts.StateWorkflows
+	}
+	return attributes
+}
+
+// workflowStatsAttributesPerUpdate is the most count attributes added by one update, which keeps
+// updates with the counts of every state of a workflow within the size limits of expressions.
+const workflowStatsAttributesPerUpdate = 100
+
+// workflowStatsUpdate is an update expression of an item of the workflow-stats table, along with
+// its attribute names and values.
+type workflowStatsUpdate struct {
+	expression string
+	names      map[string]*string
+	values     map[string]*dynamodb.AttributeValue
+}
+
+// workflowStatsUpdateExpressions builds the updates that add the change in counts to an item of
+// the workflow-stats table and set its TTL.
+func workflowStatsUpdateExpressions(change resources.WorkflowStatsCounts) []workflowStatsUpdate {
+	attributes := workflowStatsAttributes(change)
+	keys := []string{}
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	updates := []workflowStatsUpdate{}
+	for start := 0; start < len(keys); start += workflowStatsAttributesPerUpdate {
+		end := start + workflowStatsAttributesPerUpdate
+		if end > len(keys) {
+			end = len(keys)
+		}
+		update := workflowStatsUpdate{
+			names: map[string]*string{
+				"#T": ddbWorkflowTTL{}.AttributeDefinition().AttributeName,
+			},
+			// the counts of a period are kept for as long as the workflows created in it
+			values: map[string]*dynamodb.AttributeValue{
+				":ttl": &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(change.Period.Add(WorkflowTTL).Unix(), 10))},
+			},
+		}
+		adds := []string{}
+		for i, key := range keys[start:end] {
+			name := fmt.Sprintf("#C%d", i)
+			value := fmt.Sprintf(":c%d", i)
+			update.names[name] = aws.String(key)
+			update.values[value] = &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(attributes[key], 10))}
+			adds = append(adds, fmt.Sprintf("%s %s", name, value))
+		}
+		update.expression = "SET #T = :ttl ADD " + strings.Join(adds, ", ")
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+// DecodeWorkflowStatsCounts translates the counts of a period stored in dynamodb to WorkflowStatsCounts
+func DecodeWorkflowStatsCounts(m map[string]*dynamodb.AttributeValue) (resources.WorkflowStatsCounts, error) {
+	var key ddbWorkflowStatsPrimaryKey
+	if err := dynamodbattribute.UnmarshalMap(m, &key); err != nil {
+		return resources.WorkflowStatsCounts{}, err
+	}
+
+	counts := resources.NewWorkflowStatsCounts(key.WorkflowDefinitionName, key.Period.UTC())
+	for attribute, value := range m {
+		if value.N == nil {
+			continue
+		}
+		n, err := strconv.ParseInt(*value.N, 10, 64)
+		if err != nil {
+			return resources.WorkflowStatsCounts{}, err
+		}
+		// counts that went back to zero are left in the item, but aren't returned
+		if n == 0 {
+			continue
+		}
+		switch {
+		case attribute == workflowStatsStateWorkflows:
+			counts.StateWorkflows = n
+		case strings.HasPrefix(attribute, workflowStatsStatusPrefix):
+			counts.Statuses[strings.TrimPrefix(attribute, workflowStatsStatusPrefix)] = n
+		case strings.HasPrefix(attribute, workflowStatsResolvedByUserPrefix):
+			counts.ResolvedByUser[strings.TrimPrefix(attribute, workflowStatsResolvedByUserPrefix)] = n
+		case strings.HasPrefix(attribute, workflowStatsVersionPrefix):
+			counts.Versions[strings.TrimPrefix(attribute, workflowStatsVersionPrefix)] = n
+		case strings.HasPrefix(attribute, workflowStatsVersionStatusPrefix):
+			counts.VersionStatuses[strings.TrimPrefix(attribute, workflowStatsVersionStatusPrefix)] = n
+		case strings.HasPrefix(attribute, workflowStatsAliasVersionStatusPrefix):
+			counts.AliasVersionStatuses[strings.TrimPrefix(attribute, workflowStatsAliasVersionStatusPrefix)] = n
+		case strings.HasPrefix(attribute, workflowStatsDurationPrefix):
+			bucket, err := strconv.Atoi(strings.TrimPrefix(attribute, workflowStatsDurationPrefix))
+			if err != nil {
+				return resources.WorkflowStatsCounts{}, err
+			}
+			counts.Durations[bucket] = n
+		case strings.HasPrefix(attribute, workflowStatsStatePrefix):
+			counts.States[strings.TrimPrefix(attribute, workflowStatsStatePrefix)] = n
+		}
+	}
+	return counts, nil
+}
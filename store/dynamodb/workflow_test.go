@@ -0,0 +1,26 @@
+package dynamodb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestTruncateJobCauses(t *testing.T) {
+	long := strings.Repeat("frame\n", 1000) + "KeyError: 'district'"
+	workflow := models.Workflow{Jobs: []*models.Job{
+		{Cause: "short"},
+		{Cause: long, Attempts: []*models.JobAttempt{{Cause: long}}},
+	}}
+
+	assert.True(t, truncateJobCauses(&workflow))
+	assert.Equal(t, "short", workflow.Jobs[0].Cause)
+	assert.Len(t, workflow.Jobs[1].Cause, maxTruncatedCauseLength)
+	assert.True(t, strings.HasSuffix(workflow.Jobs[1].Cause, "KeyError: 'district'"))
+	assert.Len(t, workflow.Jobs[1].Attempts[0].Cause, maxTruncatedCauseLength)
+
+	assert.False(t, truncateJobCauses(&workflow))
+}
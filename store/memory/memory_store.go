@@ -17,10 +17,17 @@ import (
 )
 
 type MemoryStore struct {
-	workflowDefinitions map[string][]models.WorkflowDefinition
-	workflows           map[string]models.Workflow
-	workflowsLocked     map[string]struct{}
-	stateResources      map[string]models.StateResource
+	workflowDefinitions         map[string][]models.WorkflowDefinition
+	workflowDefinitionVersions  map[string]int64
+	workflowDefinitionAliases   map[string]map[string]models.WorkflowDefinitionAlias
+	workflowDefinitionTemplates map[string]models.WorkflowDefinitionTemplate
+	workflows                   map[string]models.Workflow
+	workflowsLocked             map[string]struct{}
+	workflowStats               map[string]map[int64]resources.WorkflowStatsCounts
+	stateResources              map[string]models.StateResource
+	activityWorkers             map[string]map[string]models.ActivityWorker
+	namespaces                  map[string]models.Namespace
+	namespaceConfigs            map[string]models.NamespaceConfig
 }
 
 type ByCreatedAt []models.Workflow
@@ -33,10 +40,17 @@ func (a ByCreatedAt) Less(i, j int) bool {
 
 func New() MemoryStore {
 	return MemoryStore{
-		workflowDefinitions: map[string][]models.WorkflowDefinition{},
-		workflows:           map[string]models.Workflow{},
-		workflowsLocked:     map[string]struct{}{},
-		stateResources:      map[string]models.StateResource{},
+		workflowDefinitions:         map[string][]models.WorkflowDefinition{},
+		workflowDefinitionVersions:  map[string]int64{},
+		workflowDefinitionAliases:   map[string]map[string]models.WorkflowDefinitionAlias{},
+		workflowDefinitionTemplates: map[string]models.WorkflowDefinitionTemplate{},
+		workflows:                   map[string]models.Workflow{},
+		workflowsLocked:             map[string]struct{}{},
+		workflowStats:               map[string]map[int64]resources.WorkflowStatsCounts{},
+		stateResources:              map[string]models.StateResource{},
+		activityWorkers:             map[string]map[string]models.ActivityWorker{},
+		namespaces:                  map[string]models.Namespace{},
+		namespaceConfigs:            map[string]models.NamespaceConfig{},
 	}
 }
 
@@ -45,31 +59,42 @@ func (s MemoryStore) SaveWorkflowDefinition(ctx context.Context, def models.Work
 	if _, ok := s.workflowDefinitions[def.Name]; ok {
 		return store.NewConflict(def.Name)
 	}
+	if last, ok := s.workflowDefinitionVersions[def.Name]; ok && def.Version <= last {
+		return store.NewVersionUsed(def.Name, def.Version)
+	}
 	def.CreatedAt = strfmt.DateTime(time.Now())
 	s.workflowDefinitions[def.Name] = []models.WorkflowDefinition{def}
+	s.workflowDefinitionVersions[def.Name] = def.Version
 	return nil
 }
 
+// UpdateWorkflowDefinition saves a new version of a workflow definition, numbered after the last
+// version given to it, even if that version was deleted.
 func (s MemoryStore) UpdateWorkflowDefinition(ctx context.Context, def models.WorkflowDefinition) (models.WorkflowDefinition, error) {
-	last, err := s.LatestWorkflowDefinition(ctx, def.Name)
-	if err != nil {
-		return def, err
+	last, ok := s.workflowDefinitionVersions[def.Name]
+	if !ok {
+		return def, store.NewNotFound(def.Name)
 	}
 
-	newVersion := resources.NewWorkflowDefinitionVersion(&def, int(last.Version+1))
+	newVersion := resources.NewWorkflowDefinitionVersion(&def, int(last+1))
 	newVersion.CreatedAt = strfmt.DateTime(time.Now())
 	s.workflowDefinitions[def.Name] = append(s.workflowDefinitions[def.Name], *newVersion)
+	s.workflowDefinitionVersions[def.Name] = newVersion.Version
 
 	return *newVersion, nil
 }
 
-// GetWorkflowDefinitions returns the latest version of all stored workflow definitions
+// GetWorkflowDefinitions returns the latest version of all stored workflow definitions that
+// aren't archived
 func (s MemoryStore) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
 	workflowDefinitions := []models.WorkflowDefinition{}
 	// for each workflow definition
 	for _, versionedWorkflowDefinitions := range s.workflowDefinitions {
 		// for each version of a workflow definition
 		for _, workflow := range versionedWorkflowDefinitions {
+			if workflow.Archived {
+				continue
+			}
 			workflowDefinitions = append(workflowDefinitions, workflow)
 		}
 	}
@@ -88,23 +113,137 @@ func (s MemoryStore) GetWorkflowDefinitionVersions(ctx context.Context, name str
 }
 
 func (s MemoryStore) GetWorkflowDefinition(ctx context.Context, name string, version int) (models.WorkflowDefinition, error) {
-	if _, ok := s.workflowDefinitions[name]; !ok {
-		return models.WorkflowDefinition{}, db.ErrWorkflowDefinitionNotFound{Name: name, Version: int64(version)}
-	}
-
-	if len(s.workflowDefinitions[name]) < version {
-		return models.WorkflowDefinition{}, db.ErrWorkflowDefinitionNotFound{Name: name, Version: int64(version)}
+	// versions are looked up rather than indexed since deleted versions leave gaps
+	for _, def := range s.workflowDefinitions[name] {
+		if def.Version == int64(version) {
+			return def, nil
+		}
 	}
 
-	return s.workflowDefinitions[name][version], nil
+	return models.WorkflowDefinition{}, db.ErrWorkflowDefinitionNotFound{Name: name, Version: int64(version)}
 }
 
 func (s MemoryStore) LatestWorkflowDefinition(ctx context.Context, name string) (models.WorkflowDefinition, error) {
-	if _, ok := s.workflowDefinitions[name]; !ok {
+	versions, ok := s.workflowDefinitions[name]
+	if !ok {
 		return models.WorkflowDefinition{}, store.NewNotFound(name)
 	}
 
-	return s.GetWorkflowDefinition(ctx, name, len(s.workflowDefinitions[name])-1)
+	return versions[len(versions)-1], nil
+}
+
+// ArchiveWorkflowDefinition archives or restores every version of a workflow definition.
+func (s MemoryStore) ArchiveWorkflowDefinition(ctx context.Context, name string, archived bool) error {
+	versions, ok := s.workflowDefinitions[name]
+	if !ok {
+		return store.NewNotFound(name)
+	}
+
+	var archivedAt *strfmt.DateTime
+	if archived {
+		now := strfmt.DateTime(time.Now())
+		archivedAt = &now
+	}
+	for i := range versions {
+		versions[i].Archived = archived
+		versions[i].ArchivedAt = archivedAt
+	}
+	return nil
+}
+
+// DeleteWorkflowDefinition deletes a version of a workflow definition that no workflows use.
+// Its version number isn't given out again.
+func (s MemoryStore) DeleteWorkflowDefinition(ctx context.Context, name string, version int) error {
+	if _, err := s.GetWorkflowDefinition(ctx, name, version); err != nil {
+		return err
+	}
+	for _, workflow := range s.workflows {
+		if workflow.WorkflowDefinition.Name == name && workflow.WorkflowDefinition.Version == int64(version) {
+			return store.NewInUse(name, version)
+		}
+	}
+
+	remaining := []models.WorkflowDefinition{}
+	for _, def := range s.workflowDefinitions[name] {
+		if def.Version != int64(version) {
+			remaining = append(remaining, def)
+		}
+	}
+	if len(remaining) == 0 {
+		delete(s.workflowDefinitions, name)
+		return nil
+	}
+	s.workflowDefinitions[name] = remaining
+	return nil
+}
+
+func (s MemoryStore) SaveWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error {
+	alias.LastUpdated = strfmt.DateTime(time.Now())
+	if _, ok := s.workflowDefinitionAliases[alias.Name]; !ok {
+		s.workflowDefinitionAliases[alias.Name] = map[string]models.WorkflowDefinitionAlias{}
+	}
+	s.workflowDefinitionAliases[alias.Name][alias.Alias] = alias
+	return nil
+}
+
+// UpdateWorkflowDefinitionAlias saves an alias if it wasn't saved again since it was read.
+func (s MemoryStore) UpdateWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error {
+	existing, ok := s.workflowDefinitionAliases[alias.Name][alias.Alias]
+	if !ok || !time.Time(existing.LastUpdated).Equal(time.Time(alias.LastUpdated)) {
+		return store.NewAliasChanged(alias.Name, alias.Alias)
+	}
+	return s.SaveWorkflowDefinitionAlias(ctx, alias)
+}
+
+func (s MemoryStore) GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error) {
+	res, ok := s.workflowDefinitionAliases[name][alias]
+	if !ok {
+		return models.WorkflowDefinitionAlias{}, store.NewNotFound(fmt.Sprintf("%s alias %s", name, alias))
+	}
+
+	return res, nil
+}
+
+func (s MemoryStore) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	aliases := []models.WorkflowDefinitionAlias{}
+	for _, alias := range s.workflowDefinitionAliases[name] {
+		aliases = append(aliases, alias)
+	}
+
+	return aliases, nil
+}
+
+func (s MemoryStore) DeleteWorkflowDefinitionAlias(ctx context.Context, name, alias string) error {
+	if _, ok := s.workflowDefinitionAliases[name][alias]; !ok {
+		return store.NewNotFound(fmt.Sprintf("%s alias %s", name, alias))
+	}
+	delete(s.workflowDefinitionAliases[name], alias)
+
+	return nil
+}
+
+func (s MemoryStore) SaveWorkflowDefinitionTemplate(ctx context.Context, template models.WorkflowDefinitionTemplate) error {
+	template.LastUpdated = strfmt.DateTime(time.Now())
+	s.workflowDefinitionTemplates[template.Name] = template
+	return nil
+}
+
+func (s MemoryStore) GetWorkflowDefinitionTemplate(ctx context.Context, name string) (models.WorkflowDefinitionTemplate, error) {
+	template, ok := s.workflowDefinitionTemplates[name]
+	if !ok {
+		return models.WorkflowDefinitionTemplate{}, store.NewNotFound(fmt.Sprintf("template %s", name))
+	}
+
+	return template, nil
+}
+
+func (s MemoryStore) GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error) {
+	templates := []models.WorkflowDefinitionTemplate{}
+	for _, template := range s.workflowDefinitionTemplates {
+		templates = append(templates, template)
+	}
+
+	return templates, nil
 }
 
 func (s MemoryStore) SaveStateResource(ctx context.Context, res models.StateResource) error {
@@ -130,6 +269,36 @@ func (s MemoryStore) GetStateResource(ctx context.Context, name, namespace strin
 	return s.stateResources[resourceName], nil
 }
 
+func (s MemoryStore) GetStateResources(ctx context.Context, namespace string, limit int64, pageToken string) ([]models.StateResource, string, error) {
+	keys := []string{}
+	for key, res := range s.stateResources {
+		if namespace == "" || res.Namespace == namespace {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	// the page token is the key of the last StateResource of the previous page
+	rangeStart := sort.SearchStrings(keys, pageToken)
+	if rangeStart < len(keys) && keys[rangeStart] == pageToken {
+		rangeStart++
+	}
+	rangeEnd := rangeStart + int(limit)
+	if rangeEnd > len(keys) {
+		rangeEnd = len(keys)
+	}
+	nextPageToken := ""
+	if rangeEnd < len(keys) {
+		nextPageToken = keys[rangeEnd-1]
+	}
+
+	stateResources := []models.StateResource{}
+	for _, key := range keys[rangeStart:rangeEnd] {
+		stateResources = append(stateResources, s.stateResources[key])
+	}
+	return stateResources, nextPageToken, nil
+}
+
 func (s MemoryStore) DeleteStateResource(ctx context.Context, name, namespace string) error {
 	resourceName := name
 	if namespace != "" {
@@ -144,6 +313,98 @@ func (s MemoryStore) DeleteStateResource(ctx context.Context, name, namespace st
 	return nil
 }
 
+func (s MemoryStore) RecordActivityWorker(ctx context.Context, worker models.ActivityWorker) error {
+	resourceName := fmt.Sprintf("%s--%s", worker.Namespace, worker.StateResourceName)
+	if _, ok := s.activityWorkers[resourceName]; !ok {
+		s.activityWorkers[resourceName] = map[string]models.ActivityWorker{}
+	}
+
+	if recorded, ok := s.activityWorkers[resourceName][worker.Name]; ok {
+		worker.Succeeded += recorded.Succeeded
+		worker.Failed += recorded.Failed
+		if time.Time(recorded.LastSeen).After(time.Time(worker.LastSeen)) {
+			worker.LastSeen = recorded.LastSeen
+		}
+	}
+	s.activityWorkers[resourceName][worker.Name] = worker
+	return nil
+}
+
+func (s MemoryStore) GetActivityWorkers(ctx context.Context, namespace, stateResourceName string) ([]models.ActivityWorker, error) {
+	resourceName := fmt.Sprintf("%s--%s", namespace, stateResourceName)
+	workers := []models.ActivityWorker{}
+	for _, worker := range s.activityWorkers[resourceName] {
+		workers = append(workers, worker)
+	}
+	sort.Sort(store.ByLastSeen(workers))
+	return workers, nil
+}
+
+func (s MemoryStore) SaveNamespace(ctx context.Context, namespace models.Namespace) error {
+	namespace.LastUpdated = strfmt.DateTime(time.Now())
+	s.namespaces[namespace.Name] = namespace
+	return nil
+}
+
+func (s MemoryStore) GetNamespace(ctx context.Context, name string) (models.Namespace, error) {
+	namespace, ok := s.namespaces[name]
+	if !ok {
+		return models.Namespace{}, store.NewNotFound(fmt.Sprintf("namespace %s", name))
+	}
+
+	return namespace, nil
+}
+
+func (s MemoryStore) GetNamespaces(ctx context.Context) ([]models.Namespace, error) {
+	namespaces := []models.Namespace{}
+	for _, namespace := range s.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+
+	return namespaces, nil
+}
+
+func (s MemoryStore) DeleteNamespace(ctx context.Context, name string) error {
+	if _, ok := s.namespaces[name]; !ok {
+		return store.NewNotFound(fmt.Sprintf("namespace %s", name))
+	}
+	delete(s.namespaces, name)
+
+	return nil
+}
+
+func (s MemoryStore) SaveNamespaceConfig(ctx context.Context, config models.NamespaceConfig) error {
+	config.LastUpdated = strfmt.DateTime(time.Now())
+	s.namespaceConfigs[config.Namespace] = config
+	return nil
+}
+
+func (s MemoryStore) GetNamespaceConfig(ctx context.Context, namespace string) (models.NamespaceConfig, error) {
+	if _, ok := s.namespaceConfigs[namespace]; !ok {
+		return models.NamespaceConfig{}, store.NewNotFound(namespace)
+	}
+
+	return s.namespaceConfigs[namespace], nil
+}
+
+func (s MemoryStore) GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error) {
+	configs := []models.NamespaceConfig{}
+	for _, config := range s.namespaceConfigs {
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
+func (s MemoryStore) DeleteNamespaceConfig(ctx context.Context, namespace string) error {
+	if _, ok := s.namespaceConfigs[namespace]; !ok {
+		return store.NewNotFound(namespace)
+	}
+	delete(s.namespaceConfigs, namespace)
+
+	return nil
+}
+
 func (s MemoryStore) SaveWorkflow(ctx context.Context, workflow models.Workflow) error {
 	if _, ok := s.workflows[workflow.ID]; ok {
 		return store.NewConflict(workflow.ID)
@@ -151,36 +412,95 @@ func (s MemoryStore) SaveWorkflow(ctx context.Context, workflow models.Workflow)
 	workflow.CreatedAt = strfmt.DateTime(time.Now())
 	workflow.LastUpdated = workflow.CreatedAt
 	s.workflows[workflow.ID] = workflow
+	s.addWorkflowStats(nil, &workflow)
 	return nil
 }
 
 func (s MemoryStore) UpdateWorkflow(ctx context.Context, workflow models.Workflow) error {
-	if _, ok := s.workflows[workflow.ID]; !ok {
+	previous, ok := s.workflows[workflow.ID]
+	if !ok {
 		return store.NewNotFound(workflow.ID)
 	}
 	workflow.LastUpdated = strfmt.DateTime(time.Now())
 	s.workflows[workflow.ID] = workflow
+	s.addWorkflowStats(&previous, &workflow)
+	return nil
+}
+
+func (s MemoryStore) MarkWorkflowStateStatsRecorded(ctx context.Context, workflowID string) error {
+	workflow, ok := s.workflows[workflowID]
+	if !ok {
+		return store.NewNotFound(workflowID)
+	}
+	if workflow.StateStatsRecorded {
+		return store.NewStateStatsRecorded(workflowID)
+	}
+	workflow.StateStatsRecorded = true
+	s.workflows[workflowID] = workflow
 	return nil
 }
 
 func (s MemoryStore) DeleteWorkflowByID(ctx context.Context, workflowID string) error {
-	if _, ok := s.workflows[workflowID]; !ok {
+	previous, ok := s.workflows[workflowID]
+	if !ok {
 		return store.NewNotFound(workflowID)
 	}
 	delete(s.workflows, workflowID)
+	s.addWorkflowStats(&previous, nil)
 	return nil
 }
 
+// addWorkflowStats updates the counts of a workflow definition for a saved, updated or deleted workflow.
+func (s MemoryStore) addWorkflowStats(previous, workflow *models.Workflow) {
+	change, ok := resources.WorkflowStatsChange(previous, workflow)
+	if !ok {
+		return
+	}
+	s.addWorkflowStatsCounts(change)
+}
+
+func (s MemoryStore) AddWorkflowStatsCounts(ctx context.Context, counts resources.WorkflowStatsCounts) error {
+	s.addWorkflowStatsCounts(counts)
+	return nil
+}
+
+// addWorkflowStatsCounts adds a change to the counts of its period.
+func (s MemoryStore) addWorkflowStatsCounts(change resources.WorkflowStatsCounts) {
+	if _, ok := s.workflowStats[change.WorkflowDefinitionName]; !ok {
+		s.workflowStats[change.WorkflowDefinitionName] = map[int64]resources.WorkflowStatsCounts{}
+	}
+	period := change.Period.Unix()
+	counts, ok := s.workflowStats[change.WorkflowDefinitionName][period]
+	if !ok {
+		counts = resources.NewWorkflowStatsCounts(change.WorkflowDefinitionName, change.Period)
+	}
+	counts.Add(change)
+	s.workflowStats[change.WorkflowDefinitionName][period] = counts
+}
+
+func (s MemoryStore) GetWorkflowStatsCounts(
+	ctx context.Context, workflowDefinitionName string, from, to time.Time,
+) ([]resources.WorkflowStatsCounts, error) {
+	periods := []resources.WorkflowStatsCounts{}
+	for _, counts := range s.workflowStats[workflowDefinitionName] {
+		if counts.Period.Before(resources.WorkflowStatsPeriodStart(from)) || !counts.Period.Before(to) {
+			continue
+		}
+		period := resources.NewWorkflowStatsCounts(workflowDefinitionName, counts.Period)
+		period.Add(counts)
+		periods = append(periods, period)
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].Period.Before(periods[j].Period) })
+	return periods, nil
+}
+
 func (s MemoryStore) GetWorkflows(ctx context.Context,
 	query *models.WorkflowQuery,
 ) ([]models.Workflow, string, error) {
 	workflows := []models.Workflow{}
 
-	statusIsSet := query.Status != ""
-	resolvedByUserIsSet := query.ResolvedByUserWrapper != nil && query.ResolvedByUserWrapper.IsSet
-	// status should never be nonempty when ResolvedByUser.IsSet is true, based on handler.
-	if statusIsSet && resolvedByUserIsSet {
-		return workflows, "", store.NewInvalidQueryStructureError("query cannot contain Status when ResolvedByUser value is set.")
+	if aws.StringValue(query.WorkflowDefinitionName) == "" && query.Namespace == "" {
+		return workflows, "", store.NewInvalidQueryStructureError("query must contain WorkflowDefinitionName or Namespace.")
 	}
 
 	for _, workflow := range s.workflows {
@@ -234,7 +554,29 @@ func (s MemoryStore) GetWorkflows(ctx context.Context,
 }
 
 func (s MemoryStore) matchesQuery(workflow models.Workflow, query *models.WorkflowQuery) bool {
-	if workflow.WorkflowDefinition.Name != aws.StringValue(query.WorkflowDefinitionName) {
+	if name := aws.StringValue(query.WorkflowDefinitionName); name != "" && workflow.WorkflowDefinition.Name != name {
+		return false
+	}
+
+	if query.Namespace != "" && workflow.Namespace != query.Namespace {
+		return false
+	}
+
+	if query.Queue != "" && workflow.Queue != query.Queue {
+		return false
+	}
+
+	for key, value := range query.Tags {
+		if workflow.Tags[key] != value {
+			return false
+		}
+	}
+
+	createdAt := time.Time(workflow.CreatedAt)
+	if !time.Time(query.CreatedAfter).IsZero() && createdAt.Before(time.Time(query.CreatedAfter)) {
+		return false
+	}
+	if !time.Time(query.CreatedBefore).IsZero() && !createdAt.Before(time.Time(query.CreatedBefore)) {
 		return false
 	}
 
@@ -3,8 +3,10 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
 )
 
 // Store defines the interface for persistence of Workflow Manager resources.
@@ -15,16 +17,54 @@ type Store interface {
 	GetWorkflowDefinitionVersions(ctx context.Context, name string) ([]models.WorkflowDefinition, error)
 	GetWorkflowDefinition(ctx context.Context, name string, version int) (models.WorkflowDefinition, error)
 	LatestWorkflowDefinition(ctx context.Context, name string) (models.WorkflowDefinition, error)
+	ArchiveWorkflowDefinition(ctx context.Context, name string, archived bool) error
+	DeleteWorkflowDefinition(ctx context.Context, name string, version int) error
+
+	SaveWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error
+	UpdateWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error
+	GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error)
+	GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error)
+	DeleteWorkflowDefinitionAlias(ctx context.Context, name, alias string) error
+
+	SaveWorkflowDefinitionTemplate(ctx context.Context, template models.WorkflowDefinitionTemplate) error
+	GetWorkflowDefinitionTemplate(ctx context.Context, name string) (models.WorkflowDefinitionTemplate, error)
+	GetWorkflowDefinitionTemplates(ctx context.Context) ([]models.WorkflowDefinitionTemplate, error)
 
 	SaveStateResource(ctx context.Context, res models.StateResource) error
 	GetStateResource(ctx context.Context, name, namespace string) (models.StateResource, error)
+	GetStateResources(ctx context.Context, namespace string, limit int64, pageToken string) ([]models.StateResource, string, error)
 	DeleteStateResource(ctx context.Context, name, namespace string) error
 
+	RecordActivityWorker(ctx context.Context, worker models.ActivityWorker) error
+	GetActivityWorkers(ctx context.Context, namespace, stateResourceName string) ([]models.ActivityWorker, error)
+
+	SaveNamespace(ctx context.Context, namespace models.Namespace) error
+	GetNamespace(ctx context.Context, name string) (models.Namespace, error)
+	GetNamespaces(ctx context.Context) ([]models.Namespace, error)
+	DeleteNamespace(ctx context.Context, name string) error
+
+	SaveNamespaceConfig(ctx context.Context, config models.NamespaceConfig) error
+	GetNamespaceConfig(ctx context.Context, namespace string) (models.NamespaceConfig, error)
+	GetNamespaceConfigs(ctx context.Context) ([]models.NamespaceConfig, error)
+	DeleteNamespaceConfig(ctx context.Context, namespace string) error
+
 	SaveWorkflow(ctx context.Context, workflow models.Workflow) error
 	DeleteWorkflowByID(ctx context.Context, workflowID string) error
 	UpdateWorkflow(ctx context.Context, workflow models.Workflow) error
 	GetWorkflowByID(ctx context.Context, id string) (models.Workflow, error)
 	GetWorkflows(ctx context.Context, query *models.WorkflowQuery) ([]models.Workflow, string, error)
+
+	// GetWorkflowStatsCounts returns the counts of the workflows of a definition for the periods
+	// of a time window, sorted by period. The counts are kept up to date as workflows are saved,
+	// updated and deleted.
+	GetWorkflowStatsCounts(ctx context.Context, workflowDefinitionName string, from, to time.Time) ([]resources.WorkflowStatsCounts, error)
+	// AddWorkflowStatsCounts adds counts that aren't kept up to date by writes of workflows, such
+	// as those of the jobs of a stopped workflow, to the counts of their period.
+	AddWorkflowStatsCounts(ctx context.Context, counts resources.WorkflowStatsCounts) error
+	// MarkWorkflowStateStatsRecorded records that the jobs of a stopped workflow were added to the
+	// stats of its states. It returns a models.Conflict if they already were, so that the jobs of a
+	// workflow are only counted once.
+	MarkWorkflowStateStatsRecorded(ctx context.Context, workflowID string) error
 }
 
 type ConflictError struct {
@@ -43,6 +83,27 @@ func NewNotFound(name string) models.NotFound {
 	return models.NotFound{Message: name}
 }
 
+// NewInUse returns the error for deleting a WorkflowDefinition version that workflows still use.
+func NewInUse(name string, version int) models.Conflict {
+	return models.Conflict{Message: fmt.Sprintf("workflows use %s version %d", name, version)}
+}
+
+// NewAliasChanged returns the error for updating an alias that changed since it was read.
+func NewAliasChanged(name, alias string) models.Conflict {
+	return models.Conflict{Message: fmt.Sprintf("%s alias %s changed, read it again", name, alias)}
+}
+
+// NewVersionUsed returns the error for saving a version of a WorkflowDefinition that was given
+// out before, e.g. to a version that was deleted since.
+func NewVersionUsed(name string, version int64) models.Conflict {
+	return models.Conflict{Message: fmt.Sprintf("%s version %d was used before", name, version)}
+}
+
+// NewStateStatsRecorded returns the error for recording the state stats of a workflow twice.
+func NewStateStatsRecorded(workflowID string) models.Conflict {
+	return models.Conflict{Message: fmt.Sprintf("state stats of workflow %s were already recorded", workflowID)}
+}
+
 // InvalidPageTokenError is returned for workflow queries that contain a malformed or invalid page
 // token.
 type InvalidPageTokenError struct {
@@ -76,3 +137,12 @@ func (e InvalidQueryStructureError) Error() string {
 func NewInvalidQueryStructureError(cause string) InvalidQueryStructureError {
 	return InvalidQueryStructureError{cause}
 }
+
+// ByLastSeen sorts ActivityWorkers from the most to the least recently seen.
+type ByLastSeen []models.ActivityWorker
+
+func (a ByLastSeen) Len() int      { return len(a) }
+func (a ByLastSeen) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a ByLastSeen) Less(i, j int) bool {
+	return time.Time(a[i].LastSeen).After(time.Time(a[j].LastSeen))
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -22,17 +25,29 @@ func RunStoreTests(t *testing.T, storeFactory func() store.Store) {
 	t.Run("UpdateWorkflowDefinition", UpdateWorkflowDefinition(storeFactory(), t))
 	t.Run("GetWorkflowDefinition", GetWorkflowDefinition(storeFactory(), t))
 	t.Run("SaveWorkflowDefinition", SaveWorkflowDefinition(storeFactory(), t))
+	t.Run("ArchiveWorkflowDefinition", ArchiveWorkflowDefinition(storeFactory(), t))
+	t.Run("DeleteWorkflowDefinition", DeleteWorkflowDefinition(storeFactory(), t))
+	t.Run("WorkflowDefinitionAliases", WorkflowDefinitionAliases(storeFactory(), t))
+	t.Run("WorkflowDefinitionTemplates", WorkflowDefinitionTemplates(storeFactory(), t))
 	t.Run("SaveStateResource", SaveStateResource(storeFactory(), t))
 	t.Run("GetStateResource", GetStateResource(storeFactory(), t))
 	t.Run("DeleteStateResource", DeleteStateResource(storeFactory(), t))
+	t.Run("GetStateResources", GetStateResources(storeFactory(), t))
+	t.Run("ActivityWorkers", ActivityWorkers(storeFactory(), t))
+	t.Run("Namespaces", Namespaces(storeFactory(), t))
+	t.Run("SaveNamespaceConfig", SaveNamespaceConfig(storeFactory(), t))
+	t.Run("GetNamespaceConfigs", GetNamespaceConfigs(storeFactory(), t))
+	t.Run("DeleteNamespaceConfig", DeleteNamespaceConfig(storeFactory(), t))
 	t.Run("SaveWorkflow", SaveWorkflow(storeFactory(), t))
 	t.Run("UpdateWorkflow", UpdateWorkflow(storeFactory(), t))
 	t.Run("UpdateLargeWorkflow", UpdateLargeWorkflow(storeFactory(), t))
 	t.Run("DeleteWorkflow", DeleteWorkflow(storeFactory(), t))
 	t.Run("GetWorkflowByID", GetWorkflowByID(storeFactory(), t))
 	t.Run("GetWorkflows", GetWorkflows(storeFactory(), t))
+	t.Run("GetWorkflowsFilters", GetWorkflowsFilters(storeFactory(), t))
 	t.Run("GetWorkflowsSummaryOnly", GetWorkflowsSummaryOnly(storeFactory(), t))
 	t.Run("GetWorkflowsPagination", GetWorkflowsPagination(storeFactory(), t))
+	t.Run("WorkflowStats", WorkflowStats(storeFactory(), t))
 }
 
 func UpdateWorkflowDefinition(s store.Store, t *testing.T) func(t *testing.T) {
@@ -118,6 +133,207 @@ func SaveWorkflowDefinition(s store.Store, t *testing.T) func(t *testing.T) {
 	}
 }
 
+func ArchiveWorkflowDefinition(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		require.Nil(t, s.SaveWorkflowDefinition(ctx, *wf))
+		wfupdated, err := s.UpdateWorkflowDefinition(ctx, *wf)
+		require.Nil(t, err)
+		other := resources.KitchenSinkWorkflowDefinition(t)
+		require.Nil(t, s.SaveWorkflowDefinition(ctx, *other))
+
+		require.Nil(t, s.ArchiveWorkflowDefinition(ctx, wf.Name, true))
+		wfs, err := s.GetWorkflowDefinitions(ctx)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(wfs))
+		require.Equal(t, other.Name, wfs[0].Name)
+
+		t.Log("Archived versions are still readable")
+		versions, err := s.GetWorkflowDefinitionVersions(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(versions))
+		for _, version := range versions {
+			require.True(t, version.Archived)
+			require.NotNil(t, version.ArchivedAt)
+		}
+		latest, err := s.LatestWorkflowDefinition(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Equal(t, wfupdated.Version, latest.Version)
+		require.True(t, latest.Archived)
+
+		t.Log("Restoring lists the definition again")
+		require.Nil(t, s.ArchiveWorkflowDefinition(ctx, wf.Name, false))
+		latest, err = s.LatestWorkflowDefinition(ctx, wf.Name)
+		require.Nil(t, err)
+		require.False(t, latest.Archived)
+		require.Nil(t, latest.ArchivedAt)
+		wfs, err = s.GetWorkflowDefinitions(ctx)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(wfs))
+
+		err = s.ArchiveWorkflowDefinition(ctx, "doesntexist", true)
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
+func DeleteWorkflowDefinition(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		require.Nil(t, s.SaveWorkflowDefinition(ctx, *wf))
+		wfupdated, err := s.UpdateWorkflowDefinition(ctx, *wf)
+		require.Nil(t, err)
+		workflow := resources.NewWorkflow(wf, `["input"]`, "namespace", "queue", map[string]interface{}{})
+		require.Nil(t, s.SaveWorkflow(ctx, *workflow))
+
+		t.Log("Versions with workflows can't be deleted")
+		err = s.DeleteWorkflowDefinition(ctx, wf.Name, int(wf.Version))
+		require.IsType(t, models.Conflict{}, err)
+
+		t.Log("Deleting the latest version makes the version before it the latest")
+		require.Nil(t, s.DeleteWorkflowDefinition(ctx, wf.Name, int(wfupdated.Version)))
+		latest, err := s.LatestWorkflowDefinition(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Equal(t, wf.Version, latest.Version)
+
+		require.Nil(t, s.DeleteWorkflowByID(ctx, workflow.ID))
+		require.Nil(t, s.DeleteWorkflowDefinition(ctx, wf.Name, int(wf.Version)))
+		_, err = s.GetWorkflowDefinition(ctx, wf.Name, int(wf.Version))
+		require.IsType(t, db.ErrWorkflowDefinitionNotFound{}, err)
+		err = s.DeleteWorkflowDefinition(ctx, wf.Name, int(wf.Version))
+		require.IsType(t, db.ErrWorkflowDefinitionNotFound{}, err)
+
+		t.Log("Deleting the last version removes the definition")
+		_, err = s.LatestWorkflowDefinition(ctx, wf.Name)
+		require.IsType(t, models.NotFound{}, err)
+		_, err = s.GetWorkflowDefinitionVersions(ctx, wf.Name)
+		require.IsType(t, models.NotFound{}, err)
+
+		t.Log("Version numbers aren't reused")
+		err = s.SaveWorkflowDefinition(ctx, *wf)
+		require.IsType(t, models.Conflict{}, err)
+		next, err := s.UpdateWorkflowDefinition(ctx, *wf)
+		require.Nil(t, err)
+		require.Equal(t, wfupdated.Version+1, next.Version)
+		latest, err = s.LatestWorkflowDefinition(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Equal(t, next.Version, latest.Version)
+	}
+}
+
+func WorkflowDefinitionAliases(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		aliases, err := s.GetWorkflowDefinitionAliases(ctx, "definition")
+		require.Nil(t, err)
+		require.Len(t, aliases, 0)
+
+		stable := models.WorkflowDefinitionAlias{
+			Name:    "definition",
+			Alias:   "stable",
+			Version: 1,
+		}
+		canary := models.WorkflowDefinitionAlias{
+			Name:          "definition",
+			Alias:         "canary",
+			Version:       1,
+			CanaryVersion: aws.Int64(2),
+			CanaryWeight:  aws.Int64(5),
+		}
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, stable))
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, canary))
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, models.WorkflowDefinitionAlias{
+			Name:    "other-definition",
+			Alias:   "stable",
+			Version: 3,
+		}))
+
+		saved, err := s.GetWorkflowDefinitionAlias(ctx, "definition", "canary")
+		require.Nil(t, err)
+		require.Equal(t, canary.Version, saved.Version)
+		require.Equal(t, *canary.CanaryVersion, *saved.CanaryVersion)
+		require.Equal(t, *canary.CanaryWeight, *saved.CanaryWeight)
+		require.WithinDuration(t, time.Time(saved.LastUpdated), time.Now(), 1*time.Second)
+
+		aliases, err = s.GetWorkflowDefinitionAliases(ctx, "definition")
+		require.Nil(t, err)
+		require.Len(t, aliases, 2)
+
+		t.Log("Saving an alias again moves it")
+		stable.Version = 2
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, stable))
+		saved, err = s.GetWorkflowDefinitionAlias(ctx, "definition", "stable")
+		require.Nil(t, err)
+		require.Equal(t, int64(2), saved.Version)
+
+		t.Log("Updates only apply to aliases that didn't change since they were read")
+		stale := saved
+		saved.Version = 3
+		require.Nil(t, s.UpdateWorkflowDefinitionAlias(ctx, saved))
+		stale.Version = 4
+		err = s.UpdateWorkflowDefinitionAlias(ctx, stale)
+		require.IsType(t, models.Conflict{}, err)
+		saved, err = s.GetWorkflowDefinitionAlias(ctx, "definition", "stable")
+		require.Nil(t, err)
+		require.Equal(t, int64(3), saved.Version)
+
+		require.Nil(t, s.DeleteWorkflowDefinitionAlias(ctx, "definition", "canary"))
+		_, err = s.GetWorkflowDefinitionAlias(ctx, "definition", "canary")
+		require.IsType(t, models.NotFound{}, err)
+		err = s.DeleteWorkflowDefinitionAlias(ctx, "definition", "canary")
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
+func WorkflowDefinitionTemplates(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		templates, err := s.GetWorkflowDefinitionTemplates(ctx)
+		require.Nil(t, err)
+		require.Len(t, templates, 0)
+		_, err = s.GetWorkflowDefinitionTemplate(ctx, "sync")
+		require.IsType(t, models.NotFound{}, err)
+
+		template := models.WorkflowDefinitionTemplate{
+			Name:    "sync",
+			Manager: models.ManagerStepFunctions,
+			Parameters: []*models.TemplateParameter{
+				{Name: aws.String("resource")},
+				{Name: aws.String("timeout"), Default: aws.String("60")},
+			},
+			StateMachine: `{"StartAt": "sync", "States": {"sync": {"Type": "Task", "Resource": "{{resource}}", "End": true}}}`,
+		}
+		require.Nil(t, s.SaveWorkflowDefinitionTemplate(ctx, template))
+		require.Nil(t, s.SaveWorkflowDefinitionTemplate(ctx, models.WorkflowDefinitionTemplate{
+			Name:         "other-template",
+			StateMachine: template.StateMachine,
+		}))
+
+		saved, err := s.GetWorkflowDefinitionTemplate(ctx, "sync")
+		require.Nil(t, err)
+		require.Equal(t, template.StateMachine, saved.StateMachine)
+		require.Len(t, saved.Parameters, 2)
+		require.Equal(t, "60", *saved.Parameters[1].Default)
+		require.WithinDuration(t, time.Time(saved.LastUpdated), time.Now(), 1*time.Second)
+
+		t.Log("Saving a template again updates it")
+		template.Version = 1
+		require.Nil(t, s.SaveWorkflowDefinitionTemplate(ctx, template))
+		saved, err = s.GetWorkflowDefinitionTemplate(ctx, "sync")
+		require.Nil(t, err)
+		require.Equal(t, int64(1), saved.Version)
+
+		templates, err = s.GetWorkflowDefinitionTemplates(ctx)
+		require.Nil(t, err)
+		require.Len(t, templates, 2)
+	}
+}
+
 func SaveStateResource(s store.Store, t *testing.T) func(t *testing.T) {
 	return func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -182,6 +398,194 @@ func DeleteStateResource(s store.Store, t *testing.T) func(t *testing.T) {
 	}
 }
 
+func GetStateResources(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		for _, sr := range []models.StateResource{
+			{Name: "a", Namespace: "staging"},
+			{Name: "b", Namespace: "staging"},
+			{Name: "c", Namespace: "staging"},
+			{Name: "a", Namespace: "production"},
+		} {
+			sr.Type = models.StateResourceTypeActivityARN
+			sr.URI = "arn:activity"
+			require.Nil(t, s.SaveStateResource(ctx, sr))
+		}
+
+		all, _, err := s.GetStateResources(ctx, "", 10, "")
+		require.Nil(t, err)
+		require.Len(t, all, 4)
+
+		t.Log("StateResources of a namespace are paged")
+		names := []string{}
+		pageToken := ""
+		for {
+			page, nextPageToken, err := s.GetStateResources(ctx, "staging", 2, pageToken)
+			require.Nil(t, err)
+			for _, sr := range page {
+				require.Equal(t, "staging", sr.Namespace)
+				names = append(names, sr.Name)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+		sort.Strings(names)
+		require.Equal(t, []string{"a", "b", "c"}, names)
+	}
+}
+
+func ActivityWorkers(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		workers, err := s.GetActivityWorkers(ctx, "staging", "echo")
+		require.Nil(t, err)
+		require.Len(t, workers, 0)
+
+		// lastSeen is stored with second precision
+		now := time.Now().Truncate(time.Second)
+		for _, worker := range []models.ActivityWorker{
+			{Name: "worker-1", LastSeen: strfmt.DateTime(now.Add(-time.Hour)), Succeeded: 1},
+			{Name: "worker-2", LastSeen: strfmt.DateTime(now), Failed: 1},
+			{Name: "worker-1", LastSeen: strfmt.DateTime(now.Add(-time.Minute)), Succeeded: 2, Failed: 1},
+			{Name: "worker-1", LastSeen: strfmt.DateTime(now.Add(-2 * time.Hour)), Succeeded: 1},
+		} {
+			worker.Namespace = "staging"
+			worker.StateResourceName = "echo"
+			require.Nil(t, s.RecordActivityWorker(ctx, worker))
+		}
+		require.Nil(t, s.RecordActivityWorker(ctx, models.ActivityWorker{
+			Namespace: "production", StateResourceName: "echo", Name: "worker-3", LastSeen: strfmt.DateTime(now),
+		}))
+
+		t.Log("Counts add up, lastSeen only moves forward and the most recent worker is first")
+		workers, err = s.GetActivityWorkers(ctx, "staging", "echo")
+		require.Nil(t, err)
+		require.Len(t, workers, 2)
+		require.Equal(t, "worker-2", workers[0].Name)
+		require.Equal(t, now.Unix(), time.Time(workers[0].LastSeen).Unix())
+		require.Equal(t, "worker-1", workers[1].Name)
+		require.Equal(t, now.Add(-time.Minute).Unix(), time.Time(workers[1].LastSeen).Unix())
+		require.Equal(t, int64(4), workers[1].Succeeded)
+		require.Equal(t, int64(1), workers[1].Failed)
+	}
+}
+
+func Namespaces(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := s.GetNamespace(ctx, "staging")
+		require.IsType(t, models.NotFound{}, err)
+
+		namespace := models.Namespace{
+			Name:               "staging",
+			Status:             models.NamespaceStatusActive,
+			AllowedDefinitions: []string{"definition"},
+		}
+		require.Nil(t, s.SaveNamespace(ctx, namespace))
+		require.Nil(t, s.SaveNamespace(ctx, models.Namespace{Name: "production"}))
+
+		saved, err := s.GetNamespace(ctx, "staging")
+		require.Nil(t, err)
+		require.Equal(t, namespace.Status, saved.Status)
+		require.Equal(t, namespace.AllowedDefinitions, saved.AllowedDefinitions)
+		require.WithinDuration(t, time.Time(saved.LastUpdated), time.Now(), 1*time.Second)
+
+		t.Log("Saving a namespace again updates it")
+		namespace.Status = models.NamespaceStatusFrozen
+		require.Nil(t, s.SaveNamespace(ctx, namespace))
+		saved, err = s.GetNamespace(ctx, "staging")
+		require.Nil(t, err)
+		require.Equal(t, models.NamespaceStatusFrozen, saved.Status)
+
+		namespaces, err := s.GetNamespaces(ctx)
+		require.Nil(t, err)
+		require.Len(t, namespaces, 2)
+
+		require.Nil(t, s.DeleteNamespace(ctx, "staging"))
+		_, err = s.GetNamespace(ctx, "staging")
+		require.IsType(t, models.NotFound{}, err)
+		err = s.DeleteNamespace(ctx, "staging")
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
+func SaveNamespaceConfig(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		config := models.NamespaceConfig{
+			Namespace: "namespace",
+			Region:    "us-east-1",
+			AccountID: "123456789012",
+			RoleARN:   "arn:aws:iam::123456789012:role/sfn",
+		}
+		require.Nil(t, s.SaveNamespaceConfig(ctx, config))
+		savedConfig, err := s.GetNamespaceConfig(ctx, config.Namespace)
+		require.Nil(t, err)
+		require.Equal(t, config.Region, savedConfig.Region)
+		require.Equal(t, config.AccountID, savedConfig.AccountID)
+		require.Equal(t, config.RoleARN, savedConfig.RoleARN)
+		require.WithinDuration(t, time.Time(savedConfig.LastUpdated), time.Now(), 1*time.Second)
+
+		// saving again overwrites the existing config
+		config.Region = "us-west-2"
+		require.Nil(t, s.SaveNamespaceConfig(ctx, config))
+		savedConfig, err = s.GetNamespaceConfig(ctx, config.Namespace)
+		require.Nil(t, err)
+		require.Equal(t, "us-west-2", savedConfig.Region)
+
+		_, err = s.GetNamespaceConfig(ctx, "doesntexist")
+		require.Error(t, err)
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
+func GetNamespaceConfigs(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		configs, err := s.GetNamespaceConfigs(ctx)
+		require.Nil(t, err)
+		require.Len(t, configs, 0)
+
+		for _, namespace := range []string{"staging", "production"} {
+			require.Nil(t, s.SaveNamespaceConfig(ctx, models.NamespaceConfig{
+				Namespace: namespace,
+				Region:    "us-west-2",
+			}))
+		}
+		configs, err = s.GetNamespaceConfigs(ctx)
+		require.Nil(t, err)
+		require.Len(t, configs, 2)
+	}
+}
+
+func DeleteNamespaceConfig(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		config := models.NamespaceConfig{
+			Namespace: "namespace",
+			Region:    "us-east-1",
+		}
+		require.Nil(t, s.SaveNamespaceConfig(ctx, config))
+		require.Nil(t, s.DeleteNamespaceConfig(ctx, config.Namespace))
+
+		_, err := s.GetNamespaceConfig(ctx, config.Namespace)
+		require.Error(t, err)
+		require.IsType(t, models.NotFound{}, err)
+
+		err = s.DeleteNamespaceConfig(ctx, config.Namespace)
+		require.Error(t, err)
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
 func SaveWorkflow(s store.Store, t *testing.T) func(t *testing.T) {
 	return func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -250,8 +654,10 @@ func UpdateLargeWorkflow(s store.Store, t *testing.T) func(t *testing.T) {
 		savedWorkflow, err := s.GetWorkflowByID(ctx, workflow.ID)
 		require.NoError(t, err)
 		require.Equal(t, savedWorkflow.Status, updatedWorkflow.Status)
-		// Large Workflows don't save Jobs in DynamoDB
-		//require.Equal(t, len(savedWorkflow.Jobs), len(updatedWorkflow.Jobs))
+		require.Equal(t, savedWorkflow.Input, updatedWorkflow.Input)
+		require.Equal(t, savedWorkflow.EffectiveInput, updatedWorkflow.EffectiveInput)
+		require.Equal(t, len(savedWorkflow.Jobs), len(updatedWorkflow.Jobs))
+		require.Equal(t, updatedWorkflow.Jobs[3999].Output, savedWorkflow.Jobs[3999].Output)
 	}
 }
 
@@ -423,7 +829,7 @@ func GetWorkflows(s store.Store, t *testing.T) func(t *testing.T) {
 		require.Equal(t, failedResolvedWorkflow.ID, workflows[0].ID)
 		require.Equal(t, runningResolvedWorkflow.ID, workflows[1].ID)
 
-		// Verify error for query that has both status and resolvedByUser filtering:
+		// Verify results for query with both status and resolvedByUser filtering:
 		workflows, _, err = s.GetWorkflows(ctx, &models.WorkflowQuery{
 			WorkflowDefinitionName: aws.String(definition.Name),
 			Status:                 models.WorkflowStatusRunning,
@@ -433,7 +839,128 @@ func GetWorkflows(s store.Store, t *testing.T) func(t *testing.T) {
 			},
 			Limit: 10,
 		})
-		require.Error(t, err)
+		require.NoError(t, err)
+		require.Len(t, workflows, 1)
+		require.Equal(t, runningResolvedWorkflow.ID, workflows[0].ID)
+
+		workflows, _, err = s.GetWorkflows(ctx, &models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(definition.Name),
+			Status:                 models.WorkflowStatusFailed,
+			ResolvedByUserWrapper: &models.ResolvedByUserWrapper{
+				IsSet: true,
+				Value: false,
+			},
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, workflows, 1)
+		require.Equal(t, failedWorkflow.ID, workflows[0].ID)
+
+		// Verify both filters across the definitions of a namespace:
+		workflows, _, err = s.GetWorkflows(ctx, &models.WorkflowQuery{
+			Namespace: "namespace",
+			Status:    models.WorkflowStatusRunning,
+			ResolvedByUserWrapper: &models.ResolvedByUserWrapper{
+				IsSet: true,
+				Value: false,
+			},
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, workflows, 2)
+		require.Equal(t, otherDefinitionWorkflow.ID, workflows[0].ID)
+		require.Equal(t, runningWorkflow.ID, workflows[1].ID)
+	}
+}
+
+func GetWorkflowsFilters(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		definition := resources.KitchenSinkWorkflowDefinition(t)
+		require.NoError(t, s.SaveWorkflowDefinition(ctx, *definition))
+		otherDefinition := resources.KitchenSinkWorkflowDefinition(t)
+		require.NoError(t, s.SaveWorkflowDefinition(ctx, *otherDefinition))
+
+		// saved in order, so that they are created one after the other
+		saved := []models.Workflow{}
+		for _, workflow := range []*models.Workflow{
+			resources.NewWorkflow(definition, `["input"]`, "filters", "default", map[string]interface{}{"team": "infra"}),
+			resources.NewWorkflow(otherDefinition, `["input"]`, "filters", "priority", map[string]interface{}{"team": "eng"}),
+			resources.NewWorkflow(definition, `["input"]`, "other-filters", "default", map[string]interface{}{"team": "infra"}),
+			resources.NewWorkflow(otherDefinition, `["input"]`, "filters", "default", map[string]interface{}{"team": "infra", "env": "test"}),
+		} {
+			workflow.Status = models.WorkflowStatusRunning
+			require.NoError(t, s.SaveWorkflow(ctx, *workflow))
+			savedWorkflow, err := s.GetWorkflowByID(ctx, workflow.ID)
+			require.NoError(t, err)
+			saved = append(saved, savedWorkflow)
+		}
+
+		ids := func(query models.WorkflowQuery) []string {
+			query.Limit = 1
+			ids := []string{}
+			for {
+				workflows, nextPageToken, err := s.GetWorkflows(ctx, &query)
+				require.NoError(t, err)
+				require.True(t, len(workflows) <= 1)
+				for _, workflow := range workflows {
+					ids = append(ids, workflow.ID)
+				}
+				if nextPageToken == "" {
+					return ids
+				}
+				require.Len(t, workflows, 1)
+				query.PageToken = nextPageToken
+			}
+		}
+
+		// workflows of every definition in a namespace
+		assert.Equal(t, []string{saved[3].ID, saved[1].ID, saved[0].ID}, ids(models.WorkflowQuery{Namespace: "filters"}))
+		assert.Equal(t, []string{saved[3].ID, saved[0].ID}, ids(models.WorkflowQuery{
+			Namespace: "filters",
+			Queue:     "default",
+		}))
+		assert.Equal(t, []string{saved[1].ID}, ids(models.WorkflowQuery{
+			Namespace: "filters",
+			Status:    models.WorkflowStatusRunning,
+			Tags:      map[string]string{"team": "eng"},
+		}))
+		assert.Equal(t, []string{saved[3].ID}, ids(models.WorkflowQuery{
+			Namespace: "filters",
+			Tags:      map[string]string{"team": "infra", "env": "test"},
+		}))
+
+		// workflows of a definition in a namespace
+		assert.Equal(t, []string{saved[0].ID}, ids(models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(definition.Name),
+			Namespace:              "filters",
+		}))
+
+		// createdAfter includes the start of the range and createdBefore excludes the end
+		assert.Equal(t, []string{saved[1].ID}, ids(models.WorkflowQuery{
+			Namespace:     "filters",
+			CreatedAfter:  saved[1].CreatedAt,
+			CreatedBefore: saved[3].CreatedAt,
+		}))
+		assert.Equal(t, []string{saved[1].ID, saved[3].ID}, ids(models.WorkflowQuery{
+			Namespace:    "filters",
+			CreatedAfter: saved[1].CreatedAt,
+			OldestFirst:  true,
+		}))
+		assert.Equal(t, []string{saved[2].ID}, ids(models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(definition.Name),
+			CreatedAfter:           saved[1].CreatedAt,
+			CreatedBefore:          saved[3].CreatedAt,
+		}))
+		assert.Equal(t, []string{saved[0].ID}, ids(models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(definition.Name),
+			CreatedBefore:          saved[1].CreatedAt,
+		}))
+
+		// queries must be scoped to a definition or a namespace
+		_, _, err := s.GetWorkflows(ctx, &models.WorkflowQuery{Limit: 10, Queue: "default"})
+		assert.IsType(t, store.InvalidQueryStructureError{}, err)
 	}
 }
 
@@ -574,3 +1101,83 @@ func GetWorkflowsPagination(s store.Store, t *testing.T) func(t *testing.T) {
 		assert.Len(t, workflows, 0)
 	}
 }
+
+func WorkflowStats(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		definition := resources.KitchenSinkWorkflowDefinition(t)
+		require.NoError(t, s.SaveWorkflowDefinition(ctx, *definition))
+
+		ids := []string{}
+		for i := 0; i < 3; i++ {
+			workflow := resources.NewWorkflow(definition, `["input"]`, "namespace", "queue", map[string]interface{}{})
+			workflow.Status = models.WorkflowStatusRunning
+			require.NoError(t, s.SaveWorkflow(ctx, *workflow))
+			ids = append(ids, workflow.ID)
+		}
+		now := time.Now()
+		counts := func() resources.WorkflowStatsCounts {
+			periods, err := s.GetWorkflowStatsCounts(ctx, definition.Name, now.Add(-time.Hour), now.Add(time.Hour))
+			require.NoError(t, err)
+			total := resources.NewWorkflowStatsCounts(definition.Name, time.Time{})
+			for _, period := range periods {
+				assert.Equal(t, definition.Name, period.WorkflowDefinitionName)
+				total.Add(period)
+			}
+			return total
+		}
+		assert.Equal(t, map[string]int64{"running": 3}, counts().Statuses)
+		assert.Equal(t, map[string]int64{"false": 3}, counts().ResolvedByUser)
+		assert.Equal(t, map[string]int64{strconv.FormatInt(definition.Version, 10): 3}, counts().Versions)
+
+		// stopping a workflow moves it to its new status and counts its duration
+		failed, err := s.GetWorkflowByID(ctx, ids[0])
+		require.NoError(t, err)
+		failed.Status = models.WorkflowStatusFailed
+		failed.StoppedAt = strfmt.DateTime(time.Time(failed.CreatedAt).Add(time.Minute))
+		require.NoError(t, s.UpdateWorkflow(ctx, failed))
+		failed.ResolvedByUser = true
+		require.NoError(t, s.UpdateWorkflow(ctx, failed))
+		assert.Equal(t, map[string]int64{"running": 2, "failed": 1}, counts().Statuses)
+		assert.Equal(t, map[string]int64{"false": 2, "true": 1}, counts().ResolvedByUser)
+		assert.Equal(t, map[string]int64{
+			resources.VersionStatusKey(definition.Version, models.WorkflowStatusRunning): 2,
+			resources.VersionStatusKey(definition.Version, models.WorkflowStatusFailed):  1,
+		}, counts().VersionStatuses)
+		assert.Equal(t, map[int]int64{resources.DurationBucket(time.Minute): 1}, counts().Durations)
+
+		// the jobs of stopped workflows are added to the counts of their period
+		change, ok := resources.StateStatsChange(failed)
+		require.True(t, ok)
+		change.States[resources.StateStatsKey(definition.Version, "jobs", "start-state")] = 2
+		require.NoError(t, s.AddWorkflowStatsCounts(ctx, change))
+		require.NoError(t, s.AddWorkflowStatsCounts(ctx, change))
+		assert.Equal(t, map[string]int64{
+			resources.StateStatsKey(definition.Version, "jobs", "start-state"): 4,
+		}, counts().States)
+		assert.Equal(t, int64(2), counts().StateWorkflows)
+		assert.Equal(t, map[string]int64{"running": 2, "failed": 1}, counts().Statuses)
+
+		// workflows are marked once when their jobs are counted, and keep the mark when written
+		require.NoError(t, s.MarkWorkflowStateStatsRecorded(ctx, failed.ID))
+		assert.IsType(t, models.Conflict{}, s.MarkWorkflowStateStatsRecorded(ctx, failed.ID))
+		failed, err = s.GetWorkflowByID(ctx, failed.ID)
+		require.NoError(t, err)
+		assert.True(t, failed.StateStatsRecorded)
+		require.NoError(t, s.UpdateWorkflow(ctx, failed))
+		assert.IsType(t, models.Conflict{}, s.MarkWorkflowStateStatsRecorded(ctx, failed.ID))
+
+		// deleted workflows are no longer counted
+		require.NoError(t, s.DeleteWorkflowByID(ctx, ids[1]))
+		assert.Equal(t, map[string]int64{"running": 1, "failed": 1}, counts().Statuses)
+
+		// other time windows and definitions have no counts
+		periods, err := s.GetWorkflowStatsCounts(ctx, definition.Name, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, periods)
+		periods, err = s.GetWorkflowStatsCounts(ctx, "unknown", now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Empty(t, periods)
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+const yamlContentType = "application/yaml"
+
+var yamlMediaTypes = map[string]bool{
+	"application/yaml":   true,
+	"application/x-yaml": true,
+	"text/yaml":          true,
+	"text/x-yaml":        true,
+}
+
+// yamlMiddleware lets clients author and read workflow definitions as YAML. Request bodies sent
+// as YAML are converted to JSON before they reach the handlers, so comments and anchors (e.g.
+// for shared Retry blocks) work and the result is the same as the JSON models. Successful
+// responses are converted to YAML for clients that prefer it in their Accept header.
+func yamlMiddleware(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/workflow-definitions") {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if isYAMLMediaType(r.Header.Get("Content-Type")) {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				writeYAMLError(w, http.StatusBadRequest, fmt.Sprintf("could not read body: %s", err))
+				return
+			}
+			jsonBody, err := yamlToJSON(body)
+			if err != nil {
+				writeYAMLError(w, http.StatusBadRequest, fmt.Sprintf("body is not valid YAML: %s", err))
+				return
+			}
+			r.Body = ioutil.NopCloser(bytes.NewReader(jsonBody))
+			r.ContentLength = int64(len(jsonBody))
+			r.Header.Set("Content-Type", "application/json")
+		}
+
+		if !prefersYAML(r.Header.Get("Accept")) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		buffered := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+		handler.ServeHTTP(buffered, r)
+		body := buffered.body.Bytes()
+		mediaType, _, _ := mime.ParseMediaType(buffered.header.Get("Content-Type"))
+		if buffered.status/100 == 2 && mediaType == "application/json" {
+			if yamlBody, err := jsonToYAML(body); err == nil {
+				body = yamlBody
+				buffered.header.Set("Content-Type", yamlContentType)
+				buffered.header.Del("Content-Length")
+			}
+		}
+
+		for key, values := range buffered.header {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(buffered.status)
+		w.Write(body)
+	})
+}
+
+// bufferedResponseWriter holds a response so that it can be converted before it is sent.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+func isYAMLMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && yamlMediaTypes[mediaType]
+}
+
+// prefersYAML reports whether an Accept header ranks a YAML media type at least as high as
+// JSON, which is the default.
+func prefersYAML(accept string) bool {
+	yamlQuality, jsonQuality := 0.0, 0.0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch {
+		case yamlMediaTypes[mediaType]:
+			if quality > yamlQuality {
+				yamlQuality = quality
+			}
+		case mediaType == "application/json" || mediaType == "application/*" || mediaType == "*/*":
+			if quality > jsonQuality {
+				jsonQuality = quality
+			}
+		}
+	}
+	return yamlQuality > 0 && yamlQuality >= jsonQuality
+}
+
+// yamlToJSON converts a YAML document to JSON, resolving anchors and merge keys.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	jsonValue, err := jsonCompatible(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonValue)
+}
+
+// jsonCompatible converts the maps decoded by the yaml package, which can have keys of any type,
+// to maps with string keys.
+func jsonCompatible(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		res := map[string]interface{}{}
+		for key, item := range v {
+			keyString, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("keys must be strings: %v", key)
+			}
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			res[keyString] = converted
+		}
+		return res, nil
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, item := range v {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = converted
+		}
+		return res, nil
+	default:
+		return v, nil
+	}
+}
+
+func jsonToYAML(data []byte) ([]byte, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(value)
+}
+
+// writeYAMLError responds with an error in the same format as the generated server.
+func writeYAMLError(w http.ResponseWriter, status int, message string) {
+	body, _ := json.Marshal(map[string]string{"message": message})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAMLMiddlewareRequests(t *testing.T) {
+	var received map[string]interface{}
+	handler := yamlMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	body := `
+# retry deploys, then give up
+retry: &retry
+  - ErrorEquals: ["States.ALL"]
+    MaxAttempts: 2
+name: yaml-definition
+stateMachine:
+  StartAt: start
+  States:
+    start:
+      Type: Task
+      Resource: worker
+      Retry: *retry
+      End: true
+`
+	req := httptest.NewRequest("POST", "/workflow-definitions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/yaml")
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	assert.Equal(t, "yaml-definition", received["name"])
+	states := received["stateMachine"].(map[string]interface{})["States"].(map[string]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"ErrorEquals": []interface{}{"States.ALL"}, "MaxAttempts": float64(2)},
+	}, states["start"].(map[string]interface{})["Retry"])
+
+	t.Log("Invalid YAML is a bad request")
+	req = httptest.NewRequest("POST", "/workflow-definitions", strings.NewReader("name: [unclosed"))
+	req.Header.Set("Content-Type", "application/x-yaml")
+	res = httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Contains(t, res.Body.String(), "body is not valid YAML")
+}
+
+func TestYAMLMiddlewareResponses(t *testing.T) {
+	handler := yamlMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "definition", "version": 2}`))
+	}))
+
+	for accept, yamlResponse := range map[string]bool{
+		"":                                       false,
+		"application/json":                       false,
+		"application/yaml":                       true,
+		"application/json;q=0.5, text/yaml":      true,
+		"application/yaml;q=0.5, */*":            false,
+		"application/json, application/x-yaml":   true,
+		"application/yaml;q=0, application/json": false,
+	} {
+		req := httptest.NewRequest("GET", "/workflow-definitions/definition/2", nil)
+		req.Header.Set("Accept", accept)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+
+		body, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		if yamlResponse {
+			assert.Equal(t, "application/yaml", res.Header().Get("Content-Type"), accept)
+			assert.Equal(t, "name: definition\nversion: 2\n", string(body), accept)
+		} else {
+			assert.Equal(t, "application/json", res.Header().Get("Content-Type"), accept)
+			assert.Equal(t, `{"name": "definition", "version": 2}`, string(body), accept)
+		}
+	}
+
+	t.Log("Other endpoints are left alone")
+	req := httptest.NewRequest("GET", "/workflows/id", nil)
+	req.Header.Set("Accept", "application/yaml")
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+}